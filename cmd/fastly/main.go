@@ -2,6 +2,7 @@
 package main
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/fastly/cli/pkg/app"
@@ -9,10 +10,24 @@ import (
 )
 
 func main() {
+	// A panic is recorded in the error log (with the stack trace) before the
+	// CLI crashes, so a bug can be diagnosed from the log.
+	defer func() {
+		if r := recover(); r != nil {
+			fsterr.Log.AddWithStack(fmt.Errorf("panic: %v", r))
+			_ = fsterr.PersistLog(os.Args)
+			panic(r)
+		}
+	}()
+
 	if err := app.Run(os.Args, os.Stdin); err != nil {
 		if skipExit := fsterr.Process(err, os.Args, os.Stdout); skipExit {
 			return
 		}
-		os.Exit(1)
+		os.Exit(fsterr.ExitCode(err))
 	}
+
+	// Entries can be recorded even when a command succeeds (e.g. a slow API
+	// request), so we persist them for reference.
+	_ = fsterr.PersistLog(os.Args)
 }
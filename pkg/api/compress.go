@@ -0,0 +1,70 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// CompressThreshold is the smallest request body (in bytes) that is gzipped
+// when --compress-requests is set. Smaller bodies gain little from it.
+const CompressThreshold = 16 * 1024
+
+// compressRequest gzips the body of req when it's at least the threshold. The
+// uncompressed body is returned (nil if req wasn't compressed) so the request
+// can be retried without compression.
+//
+// NOTE: req must be a clone as its body and headers are replaced.
+func compressRequest(req *http.Request, threshold int) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Encoding") != "" {
+		return nil, nil
+	}
+	// NOTE: A body with a known length that's too small isn't read at all.
+	if req.ContentLength > 0 && req.ContentLength < int64(threshold) {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	if len(body) < threshold {
+		setRequestBody(req, body)
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return nil, fmt.Errorf("error compressing request body: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("error compressing request body: %w", err)
+	}
+	setRequestBody(req, buf.Bytes())
+	req.Header.Set("Content-Encoding", "gzip")
+	return body, nil
+}
+
+// uncompressRequest restores the original body of a compressed request.
+func uncompressRequest(req *http.Request, body []byte) {
+	setRequestBody(req, body)
+	req.Header.Del("Content-Encoding")
+}
+
+// setRequestBody replaces the body of req (along with its length).
+func setRequestBody(req *http.Request, body []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+}
+
+// rejectedEncoding indicates if the API rejected a compressed request body.
+func rejectedEncoding(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusUnsupportedMediaType
+}
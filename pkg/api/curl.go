@@ -0,0 +1,101 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/text"
+)
+
+// sensitiveHeaders are the (canonical) request headers whose values are
+// redacted from a curl command.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Fastly-Key",
+}
+
+// WriteCurl writes a (redacted) curl command equivalent to req to w, so the
+// request can be reproduced manually (see --print-curl).
+//
+// The body is read in full and replaced, so the request can still be sent.
+func WriteCurl(w io.Writer, req *http.Request) error {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("error reading request body: %w", err)
+		}
+	}
+	fmt.Fprintln(w, text.RedactSecrets(Curl(req, body)))
+	return nil
+}
+
+// Curl returns a curl command equivalent to req, whose body is given
+// separately. The values of sensitive headers and body fields are redacted.
+//
+// NOTE: Headers are sorted so the command is stable.
+func Curl(req *http.Request, body []byte) string {
+	args := []string{"curl", "-X", req.Method, shellQuote(req.URL.String())}
+
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range req.Header[k] {
+			if isSensitiveHeader(k) {
+				v = Redacted
+			}
+			args = append(args, "-H", shellQuote(k+": "+v))
+		}
+	}
+
+	if len(body) > 0 {
+		args = append(args, "--data-raw", shellQuote(string(redactRequestBody(req.Header.Get("Content-Type"), body))))
+	}
+	return strings.Join(args, " ")
+}
+
+// redactRequestBody replaces the values of sensitive fields in a form-encoded
+// or JSON request body.
+func redactRequestBody(contentType string, body []byte) []byte {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "application/x-www-form-urlencoded" {
+		return RedactBody(body, false)
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return text.SensitiveValueRegexp.ReplaceAll(body, []byte("${1}"+Redacted))
+	}
+	for k := range values {
+		if text.IsSensitiveField(k) {
+			values.Set(k, Redacted)
+		}
+	}
+	return []byte(values.Encode())
+}
+
+func isSensitiveHeader(k string) bool {
+	for _, h := range sensitiveHeaders {
+		if strings.EqualFold(k, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -38,6 +38,7 @@ type Interface interface {
 	DeactivateVersion(*fastly.DeactivateVersionInput) (*fastly.Version, error)
 	LockVersion(*fastly.LockVersionInput) (*fastly.Version, error)
 	LatestVersion(*fastly.LatestVersionInput) (*fastly.Version, error)
+	ValidateVersion(*fastly.ValidateVersionInput) (bool, string, error)
 
 	CreateDomain(*fastly.CreateDomainInput) (*fastly.Domain, error)
 	ListDomains(*fastly.ListDomainsInput) ([]*fastly.Domain, error)
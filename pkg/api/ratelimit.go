@@ -0,0 +1,40 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+)
+
+// RateLimitRemainingHeader is the HTTP response header reporting the number of
+// modifying requests left before the Fastly API starts rate limiting.
+const RateLimitRemainingHeader = "Fastly-RateLimit-Remaining"
+
+// RateLimit records the most recent rate limit reported by the Fastly API.
+// It's safe for concurrent use.
+type RateLimit struct {
+	remaining atomic.Int64
+	seen      atomic.Bool
+}
+
+// Observe records the rate limit reported by the response (if any).
+func (r *RateLimit) Observe(resp *http.Response) {
+	if r == nil || resp == nil {
+		return
+	}
+	v, err := strconv.Atoi(resp.Header.Get(RateLimitRemainingHeader))
+	if err != nil {
+		return
+	}
+	r.remaining.Store(int64(v))
+	r.seen.Store(true)
+}
+
+// Remaining returns the number of modifying requests left, and false if the
+// API hasn't reported a rate limit yet.
+func (r *RateLimit) Remaining() (int, bool) {
+	if r == nil || !r.seen.Load() {
+		return 0, false
+	}
+	return int(r.remaining.Load()), true
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/internal/term"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Redacted replaces sensitive values in a raw API response.
+const Redacted = "REDACTED"
+
+// WriteRawResponse writes the redacted response body to w, preceded by a
+// header identifying the request.
+//
+// A JSON body is pretty-printed when w is a TTY and compacted otherwise. The
+// body is read in full and replaced, so the caller can still consume it.
+func WriteRawResponse(w io.Writer, req *http.Request, resp *http.Response) error {
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error reading raw response: %w", err)
+	}
+
+	fmt.Fprintf(w, "--- RAW RESPONSE: %s %s (%d) ---\n", req.Method, req.URL.Path, resp.StatusCode)
+	fmt.Fprintf(w, "%s\n", text.RedactSecrets(string(RedactBody(body, term.IsTerminal(w)))))
+	return nil
+}
+
+// RedactBody replaces the values of sensitive fields in the response body.
+//
+// NOTE: A JSON body is decoded and re-encoded, which means object keys are
+// sorted. Number precision is preserved.
+func RedactBody(body []byte, pretty bool) []byte {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return text.SensitiveValueRegexp.ReplaceAll(bytes.TrimSpace(body), []byte("${1}"+Redacted))
+	}
+
+	var (
+		out []byte
+		err error
+	)
+	if pretty {
+		out, err = json.MarshalIndent(Redact(doc), "", "  ")
+	} else {
+		out, err = json.Marshal(Redact(doc))
+	}
+	if err != nil {
+		return []byte(Redacted)
+	}
+	return out
+}
+
+// Redact walks a decoded JSON document replacing the values of sensitive
+// fields (see text.IsSensitiveField) with Redacted.
+func Redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if text.IsSensitiveField(k) && val != nil {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = Redact(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = Redact(val)
+		}
+	}
+	return v
+}
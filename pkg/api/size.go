@@ -0,0 +1,103 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+)
+
+// requestBodyLimits are the known maximum request body sizes (in bytes) of
+// Fastly API endpoints, keyed by the request paths they apply to.
+var requestBodyLimits = []struct {
+	path  *regexp.Regexp
+	limit int64
+}{
+	{regexp.MustCompile(`^/service/[^/]+/version/[^/]+/package$`), 100 * 1024 * 1024},
+	{regexp.MustCompile(`^/service/[^/]+/version/[^/]+/vcl(/[^/]+)?$`), 1024 * 1024},
+}
+
+// RequestBodyLimit returns the known maximum request body size (in bytes) of
+// the API endpoint at path. Zero is returned if the limit isn't known.
+func RequestBodyLimit(path string) int64 {
+	for _, l := range requestBodyLimits {
+		if l.path.MatchString(path) {
+			return l.limit
+		}
+	}
+	return 0
+}
+
+// BodySizes describes the body sizes (in bytes) of an API request and its
+// response.
+type BodySizes struct {
+	// Request is the size of the request body as sent (i.e. after any
+	// compression).
+	Request int64
+	// Response is the size of the response body that was read.
+	Response int64
+	// StatusCode is the response status (zero if no response was received).
+	StatusCode int
+}
+
+// countingReadCloser counts the bytes read from the underlying body.
+type countingReadCloser struct {
+	io.ReadCloser
+	n int64
+}
+
+// Read implements io.Reader.
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// reportingBody calls done with the number of bytes read once the body is
+// closed. done is called at most once.
+type reportingBody struct {
+	countingReadCloser
+	once sync.Once
+	done func(n int64)
+}
+
+// Close implements io.Closer.
+func (r *reportingBody) Close() error {
+	err := r.countingReadCloser.Close()
+	r.once.Do(func() { r.done(r.n) })
+	return err
+}
+
+// trackBodySizes measures the bodies of req (which must be a clone) and of the
+// response it returns, calling fn once the response body has been closed or
+// the request has failed.
+func trackBodySizes(req *http.Request, fn func(BodySizes, error)) func(*http.Response, error) {
+	var reqBody *countingReadCloser
+	if req.Body != nil && req.Body != http.NoBody {
+		reqBody = &countingReadCloser{ReadCloser: req.Body}
+		req.Body = reqBody
+	}
+	requestSize := func() int64 {
+		if reqBody == nil {
+			return 0
+		}
+		return reqBody.n
+	}
+
+	return func(resp *http.Response, err error) {
+		if err != nil || resp == nil || resp.Body == nil {
+			s := BodySizes{Request: requestSize()}
+			if resp != nil {
+				s.StatusCode = resp.StatusCode
+			}
+			fn(s, err)
+			return
+		}
+		resp.Body = &reportingBody{
+			countingReadCloser: countingReadCloser{ReadCloser: resp.Body},
+			done: func(n int64) {
+				fn(BodySizes{Request: requestSize(), Response: n, StatusCode: resp.StatusCode}, nil)
+			},
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// CorrelationIDHeader is the HTTP request header used to send the
+// per-invocation correlation ID to the Fastly API.
+const CorrelationIDHeader = "Fastly-Correlation-ID"
+
+// APIVersionHeader is the HTTP request header used to pin the version of the
+// Fastly API that requests are handled by.
+const APIVersionHeader = "Fastly-API-Version"
+
+// KnownAPIVersions are the Fastly API versions the CLI is known to work with.
+var KnownAPIVersions = []string{"1"}
+
+// IsKnownAPIVersion indicates if the given API version is one the CLI is
+// known to work with.
+func IsKnownAPIVersion(v string) bool {
+	return slices.Contains(KnownAPIVersions, v)
+}
+
+// NewCorrelationID returns a random (version 4) UUID that is used to tie a
+// single CLI invocation to the server-side logs of the requests it made.
+//
+// NOTE: An empty string is returned if the system's secure random number
+// generator fails, in which case no correlation ID is reported.
+func NewCorrelationID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant RFC 4122
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Transport is a http.RoundTripper that decorates every request sent to the
+// Fastly API before delegating to the Base transport.
+type Transport struct {
+	// Base is the underlying transport (http.DefaultTransport if nil).
+	Base http.RoundTripper
+	// Headers are set on every outgoing request.
+	Headers map[string]string
+	// SlowThreshold is how long a request can take before OnSlow is called.
+	// A zero value disables the check.
+	SlowThreshold time.Duration
+	// OnSlow is called when a request took longer than SlowThreshold.
+	OnSlow func(req *http.Request, elapsed time.Duration)
+	// Curl, if set, is where a (redacted) curl command equivalent to every
+	// request is written (see --print-curl).
+	Curl io.Writer
+	// RawResponse, if set, is where the redacted body of every response is
+	// written (see --raw-response).
+	RawResponse io.Writer
+	// CompressThreshold is the smallest request body (in bytes) that is sent
+	// gzipped (see --compress-requests). A zero value disables compression.
+	CompressThreshold int
+	// OnLargeRequest, if set, is called before sending a request whose body
+	// exceeds the known API limit (see RequestBodyLimit) of its endpoint.
+	OnLargeRequest func(req *http.Request, size, limit int64)
+	// OnBodySizes, if set, is called with the body sizes of every request
+	// once its response body has been closed (or the request failed).
+	OnBodySizes func(req *http.Request, sizes BodySizes, err error)
+	// RateLimit, if set, records the rate limit reported by every response.
+	RateLimit *RateLimit
+	// OnFailure, if set, is called when a request fails or its response has
+	// an error status (4xx or 5xx). resp is nil if the request failed.
+	OnFailure func(req *http.Request, resp *http.Response, err error)
+	// OnComplete, if set, is called with how long every request took (until
+	// its response headers were received). resp is nil if the request failed.
+	OnComplete func(req *http.Request, resp *http.Response, elapsed time.Duration, err error)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// NOTE: A RoundTripper must not modify the request it was given.
+	req = req.Clone(req.Context())
+	for k, v := range t.Headers {
+		if v != "" {
+			req.Header.Set(k, v)
+		}
+	}
+
+	if t.Curl != nil {
+		if err := WriteCurl(t.Curl, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if t.OnLargeRequest != nil {
+		if limit := RequestBodyLimit(req.URL.Path); limit > 0 && req.ContentLength > limit {
+			t.OnLargeRequest(req, req.ContentLength, limit)
+		}
+	}
+
+	var uncompressed []byte
+	if t.CompressThreshold > 0 {
+		var err error
+		if uncompressed, err = compressRequest(req, t.CompressThreshold); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.send(req)
+	// NOTE: If the API doesn't accept a compressed body the request is sent
+	// again uncompressed.
+	if err == nil && uncompressed != nil && rejectedEncoding(resp) {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		req = req.Clone(req.Context())
+		uncompressRequest(req, uncompressed)
+		resp, err = t.send(req)
+	}
+	elapsed := time.Since(start)
+	if t.SlowThreshold > 0 && elapsed > t.SlowThreshold && t.OnSlow != nil {
+		t.OnSlow(req, elapsed)
+	}
+	if t.OnComplete != nil {
+		t.OnComplete(req, resp, elapsed, err)
+	}
+	if err == nil {
+		t.RateLimit.Observe(resp)
+	}
+	if t.OnFailure != nil && (err != nil || resp.StatusCode >= http.StatusBadRequest) {
+		t.OnFailure(req, resp, err)
+	}
+	if err == nil && t.RawResponse != nil {
+		if err := WriteRawResponse(t.RawResponse, req, resp); err != nil {
+			// NOTE: A RoundTripper returns either a response or an error.
+			_ = resp.Body.Close()
+			return nil, err
+		}
+	}
+	return resp, err
+}
+
+// send delegates req to the Base transport, measuring the body sizes if
+// OnBodySizes is set.
+func (t *Transport) send(req *http.Request) (*http.Response, error) {
+	if t.OnBodySizes == nil {
+		return t.base().RoundTrip(req)
+	}
+	done := trackBodySizes(req, func(s BodySizes, err error) {
+		t.OnBodySizes(req, s, err)
+	})
+	resp, err := t.base().RoundTrip(req)
+	done(resp, err)
+	return resp, err
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
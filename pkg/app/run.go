@@ -21,6 +21,7 @@ import (
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/argparser"
 	"github.com/fastly/cli/pkg/auth"
+	"github.com/fastly/cli/pkg/backoff"
 	"github.com/fastly/cli/pkg/commands"
 	"github.com/fastly/cli/pkg/commands/compute"
 	"github.com/fastly/cli/pkg/commands/sso"
@@ -29,14 +30,18 @@ import (
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/env"
 	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/filesystem"
 	"github.com/fastly/cli/pkg/github"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/internal/term"
 	"github.com/fastly/cli/pkg/lookup"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/profile"
 	"github.com/fastly/cli/pkg/revision"
 	"github.com/fastly/cli/pkg/sync"
+	"github.com/fastly/cli/pkg/telemetry"
 	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/trace"
 )
 
 // Run kick starts the CLI application.
@@ -64,6 +69,18 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 		out io.Writer = sync.NewWriter(color.Output)
 	)
 
+	// Load any FASTLY_* variables from an --env-file before the environment is
+	// read (and before the Kingpin parser has executed) so they're honoured
+	// everywhere an environment variable is.
+	if path := envFileArg(args); path != "" {
+		if _, err := env.LoadFile(path); err != nil {
+			return nil, fsterr.RemediationError{
+				Inner:       err,
+				Remediation: "Check the --env-file path and that each line is in the KEY=VALUE format (values may be quoted).",
+			}
+		}
+	}
+
 	// Read relevant configuration options from the user's environment.
 	var e config.Environment
 	e.Read(env.Parse(os.Environ()))
@@ -107,12 +124,67 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 	// NOTE: We skip handling the error because not all commands relate to Compute.
 	_ = md.File.Read(manifest.Filename)
 
+	// Generate a per-invocation correlation ID so a CLI invocation can be tied
+	// to the server-side logs of the API requests it made.
+	correlationID := api.NewCorrelationID()
+	fsterr.CorrelationID = correlationID
+
+	// NOTE: The factory is only called once the flags have been parsed, so it
+	// can access the final global.Data values (e.g. --slow-threshold).
+	var data *global.Data
+
+	// NOTE: --raw-response (and the request size diagnostics) write to stderr
+	// so they don't interfere with the command output, and the writer is
+	// synchronised as requests can be made concurrently.
+	diagnosticOutput := sync.NewWriter(color.Error)
+
 	factory := func(token, endpoint string, debugMode bool) (api.Interface, error) {
 		client, err := fastly.NewClientForEndpoint(token, endpoint)
+		if err != nil {
+			return client, err
+		}
 		if debugMode {
 			client.DebugMode = true
 		}
-		return client, err
+		transport := &api.Transport{
+			Base: client.HTTPClient.Transport,
+			Headers: map[string]string{
+				api.APIVersionHeader:    apiVersion(data),
+				api.CorrelationIDHeader: correlationID,
+			},
+			SlowThreshold: data.Flags.SlowThreshold,
+			OnSlow: func(req *http.Request, elapsed time.Duration) {
+				warnSlowRequest(data, req, elapsed)
+			},
+			OnLargeRequest: func(req *http.Request, size, limit int64) {
+				warnLargeRequest(data, req, size, limit)
+			},
+			OnBodySizes: func(req *http.Request, sizes api.BodySizes, err error) {
+				logBodySizes(data, diagnosticOutput, req, sizes, err)
+			},
+			RateLimit: data.RateLimit,
+			OnComplete: func(req *http.Request, resp *http.Response, elapsed time.Duration, err error) {
+				traceRequest(data.Tracer, req, resp, elapsed, err)
+			},
+			OnFailure: func(req *http.Request, resp *http.Response, _ error) {
+				var status int
+				if resp != nil {
+					status = resp.StatusCode
+				}
+				fsterr.RecordFailedRequest(req.Method, req.URL.Path, status)
+			},
+		}
+		if data.Flags.PrintCurl {
+			transport.Curl = diagnosticOutput
+		}
+		if data.Flags.RawResponse {
+			transport.RawResponse = diagnosticOutput
+		}
+		if data.Flags.CompressRequests {
+			transport.CompressThreshold = api.CompressThreshold
+		}
+		client.HTTPClient.Transport = transport
+		return client, nil
 	}
 
 	// Identify debug-mode flag early (before Kingpin parser has executed) so we
@@ -151,11 +223,12 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 		}),
 	}
 
-	return &global.Data{
+	data = &global.Data{
 		APIClientFactory: factory,
 		Args:             args,
 		Config:           cfg,
 		ConfigPath:       config.FilePath,
+		CorrelationID:    correlationID,
 		Env:              e,
 		ErrLog:           fsterr.Log,
 		ExecuteWasmTools: compute.ExecuteWasmTools,
@@ -163,9 +236,11 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 		Manifest:         &md,
 		Opener:           open.Run,
 		Output:           out,
+		RateLimit:        new(api.RateLimit),
 		Versioners:       versioners,
 		Input:            in,
-	}, nil
+	}
+	return data, nil
 }
 
 // Exec constructs the application including all of the subcommands, parses the
@@ -179,6 +254,23 @@ var Init = func(args []string, stdin io.Reader) (*global.Data, error) {
 // io.Writer. All error-related information should be encoded into an error type
 // and returned to the caller. This includes usage text.
 func Exec(data *global.Data) error {
+	// Only warnings emitted by this execution are relevant to --fail-on-warning.
+	text.Warnings.Reset()
+	fsterr.Flags = nil
+
+	// NOTE: Color support is decided once, consistently for all output. As the
+	// help output (and any parsing error) is rendered while the args are
+	// parsed, --no-color is first inspected in the raw arguments, and then
+	// decided by its parsed value.
+	term.NoColor = slices.ContainsFunc(data.Args, func(a string) bool {
+		if v, ok := strings.CutPrefix(a, "--no-color="); ok {
+			b, err := strconv.ParseBool(v)
+			return err == nil && b
+		}
+		return a == "--no-color"
+	})
+	color.NoColor = !term.ColorEnabled()
+
 	app := configureKingpin(data)
 	cmds := commands.Define(app, data)
 	command, commandName, err := processCommandInput(data, app, cmds)
@@ -186,10 +278,90 @@ func Exec(data *global.Data) error {
 		return err
 	}
 
-	// Check for --json flag early and set quiet mode if found.
-	if slices.Contains(data.Args, "--json") {
+	term.NoColor = data.Flags.NoColor
+	color.NoColor = !term.ColorEnabled()
+
+	// NOTE: The error is reported once the application has finished executing
+	// (see fsterr.Process), using the parsed values of these flags.
+	fsterr.Flags = &fsterr.ReportFlags{
+		ErrorLogJSON:   data.Flags.ErrorLogJSON,
+		Explain:        data.Flags.Explain,
+		JSONErrorsOnly: data.Flags.JSONErrorsOnly,
+		MaskIDs:        data.Flags.MaskIDs,
+		QuietErrors:    data.Flags.QuietErrors,
+	}
+
+	// NOTE: The time zone timestamps are displayed in is decided once,
+	// consistently for all output.
+	text.LocalTime = data.Flags.LocalTime
+	text.MaxValueWidth = data.Flags.MaxValueWidth
+	text.FullValues = data.Flags.Full
+
+	filesystem.NoClobber = data.Flags.NoClobber
+
+	if data.Flags.TraceFile != "" {
+		if err := filesystem.CheckClobber(data.Flags.TraceFile); err != nil {
+			return err
+		}
+		data.Tracer = trace.New()
+		defer writeTrace(data, commandName)
+	}
+	argparser.Tracer = data.Tracer
+
+	data.RetryBudget = backoff.NewBudget(data.Flags.RetryBudget)
+
+	if data.Flags.MaskIDs {
+		data.Output = text.NewMaskWriter(data.Output)
+	}
+
+	labels, err := parseLabels(data.Flags.Labels)
+	if err != nil {
+		return err
+	}
+	fsterr.Labels = labels
+
+	style, err := jsonStyle(data.Flags)
+	if err != nil {
+		return err
+	}
+	if s, ok := command.(interface{ SetJSONStyle(argparser.JSONStyle) }); ok {
+		s.SetJSONStyle(style)
+	}
+
+	if err := setJSONPointer(command, data); err != nil {
+		return err
+	}
+	if err := setOutputFile(command, data); err != nil {
+		return err
+	}
+	if err := setRedactOutput(command, data); err != nil {
+		return err
+	}
+
+	// Set quiet mode if the command's output is structured (e.g. --json,
+	// --format or --field was set), so it isn't mixed with other messages.
+	//
+	// NOTE: With --output the structured output is written to a file, so the
+	// other messages are still displayed.
+	if (isStructuredOutput(command, data.Args) && data.Flags.OutputFile == "") || data.Flags.JSONErrorsOnly {
 		data.Flags.Quiet = true
 	}
+	argparser.DisplayDeprecations(color.Error, data)
+	argparser.DisplayWarnings(color.Error, data)
+
+	fsterr.FileRotationSize, fsterr.FileRotationAge, fsterr.FileRotationCount = data.ErrorLogRotation()
+
+	if v := apiVersion(data); v != "" {
+		fsterr.APIVersion = v
+		if !api.IsKnownAPIVersion(v) {
+			msg := fmt.Sprintf("The API version %q is unknown to this version of the CLI (known versions: %s).", v, strings.Join(api.KnownAPIVersions, ", "))
+			if data.Flags.Quiet {
+				text.Warnings.Add(msg)
+			} else {
+				text.Warning(data.Output, "%s", msg)
+			}
+		}
+	}
 
 	// We short-circuit the execution for specific cases:
 	//
@@ -216,12 +388,33 @@ func Exec(data *global.Data) error {
 		time.Sleep(5 * time.Second) // this message is only displayed once so give the user a chance to see it before it possibly scrolls off screen
 	}
 
+	telemetry.Disabled = telemetry.DisabledByEnv(data.Env)
+	if telemetry.Enabled(data.Env, data.Config.CLI) && !strings.HasPrefix(commandName, "telemetry") {
+		// NOTE: The disclosure is written to stderr, so it isn't mixed with the
+		// command output.
+		if !data.Config.CLI.TelemetryNoticeDisplayed && !data.Flags.Quiet {
+			text.Important(color.Error, telemetry.Notice)
+			text.Break(color.Error)
+			data.Config.CLI.TelemetryNoticeDisplayed = true
+			if err := data.Config.Write(data.ConfigPath); err != nil {
+				return fmt.Errorf("failed to persist change to telemetry notice: %w", err)
+			}
+		}
+		// NOTE: Nothing is sent until the user has seen the disclosure notice.
+		// The count is sent in the background (see telemetry.Send).
+		if data.Config.CLI.TelemetryNoticeDisplayed {
+			telemetry.Send(data.HTTPClient, telemetry.EndpointFromEnv(data.Env), commandName)
+		}
+	}
+
 	if data.Flags.Quiet {
 		data.Manifest.File.SetQuiet(true)
 	}
 
 	apiEndpoint, endpointSource := data.APIEndpoint()
+	fsterr.APIEndpoint = apiEndpoint
 	if data.Verbose() {
+		displayCorrelationID(data.CorrelationID, data.Output)
 		displayAPIEndpoint(apiEndpoint, endpointSource, data.Output)
 	}
 
@@ -268,22 +461,77 @@ func Exec(data *global.Data) error {
 
 		if data.Verbose() {
 			displayToken(tokenSource, data)
+			if data.TokenTrimmed() {
+				text.Warning(data.Output, "Leading or trailing whitespace (e.g. a newline) was trimmed from the Fastly API token.\n\n")
+			}
 		}
 		if !data.Flags.Quiet {
 			checkConfigPermissions(commandName, tokenSource, data.Output)
 		}
 
-		data.APIClient, data.RTSClient, err = configureClients(token, apiEndpoint, data.APIClientFactory, data.Flags.Debug)
-		if err != nil {
+		data.SetClientsFactory(func() (api.Interface, api.RealtimeStatsInterface, error) {
+			return configureClients(token, apiEndpoint, data.APIClientFactory, data.Flags.Debug)
+		})
+		// NOTE: The clients are constructed up front so a failure is reported
+		// before the command executes. Any later (possibly concurrent) callers
+		// of data.Clients() receive the same clients.
+		if _, _, err = data.Clients(); err != nil {
 			data.ErrLog.Add(err)
 			return fmt.Errorf("error constructing client: %w", err)
 		}
 	}
 
-	f := checkForUpdates(data.Versioners.CLI, commandName, data.Flags.Quiet)
-	defer f(data.Output)
+	noUpdateCheck, _ := strconv.ParseBool(data.Env.NoUpdateCheck)
+	f := checkForUpdates(data.Versioners.CLI, commandName, data.Flags.Quiet, data.Flags.NoUpdateCheck || noUpdateCheck)
+	defer f(color.Error)
 
-	return command.Exec(data.Input, data.Output)
+	// NOTE: With --json-errors-only a failure is reported by fsterr.Process, so
+	// the command output is discarded.
+	out := data.Output
+	if data.Flags.JSONErrorsOnly {
+		out = io.Discard
+	}
+	// NOTE: A terminal isn't captured, as commands inspect it (e.g. to read a
+	// secret without echoing it) and the wrapper would hide it.
+	in := data.Input
+	if data.Flags.LogStdin && !text.IsTerminal(in) {
+		fsterr.Stdin = fsterr.NewStdinCapture(in)
+		fsterr.Stdin.OmitLines = commandReadsSecrets(commandName)
+		in = fsterr.Stdin
+	}
+	// NOTE: With --json-errors-only a prompt isn't displayed, so reading from a
+	// terminal fails rather than waiting for input the user wasn't asked for.
+	if data.Flags.JSONErrorsOnly && text.IsTerminal(in) {
+		in = terminalInputDisabled{}
+	}
+	end := data.Tracer.Start("command", map[string]any{"command": commandName})
+	err = command.Exec(in, out)
+	end(err)
+	if err != nil {
+		if data.RetryBudget.Exhausted() {
+			fsterr.RetryBudget = data.RetryBudget.Summary()
+		}
+		return err
+	}
+	if warnings := text.Warnings.Messages(); data.Flags.FailOnWarning && len(warnings) > 0 {
+		return fsterr.RemediationError{
+			Inner:       fsterr.WarningsError{Warnings: warnings},
+			Remediation: fsterr.FailOnWarningRemediation,
+		}
+	}
+	if data.Flags.OutputFile != "" && !data.Flags.Quiet {
+		text.Success(data.Output, "Output written to %s", data.Flags.OutputFile)
+	}
+	return nil
+}
+
+// terminalInputDisabled is the input of a command when the terminal can't be
+// read from (see --json-errors-only).
+type terminalInputDisabled struct{}
+
+// Read implements the io.Reader interface.
+func (terminalInputDisabled) Read([]byte) (int, error) {
+	return 0, fsterr.ErrTerminalInputJSONErrorsOnly
 }
 
 func configureKingpin(data *global.Data) *kingpin.Application {
@@ -316,15 +564,72 @@ func configureKingpin(data *global.Data) *kingpin.Application {
 	app.Flag("accept-defaults", "Accept default options for all interactive prompts apart from Yes/No confirmations").Short('d').BoolVar(&data.Flags.AcceptDefaults)
 	app.Flag("account", "Fastly Accounts endpoint").Hidden().StringVar(&data.Flags.AccountEndpoint)
 	app.Flag("api", "Fastly API endpoint").Hidden().StringVar(&data.Flags.APIEndpoint)
+	app.Flag("api-version", "Pin the Fastly API version sent with each API request (overrides the config 'api_version')").StringVar(&data.Flags.APIVersion)
 	app.Flag("auto-yes", "Answer yes automatically to all Yes/No confirmations. This may suppress security warnings").Short('y').BoolVar(&data.Flags.AutoYes)
+	app.Flag("compress-requests", fmt.Sprintf("Gzip API request bodies larger than %d KiB (sent uncompressed if the API rejects it)", api.CompressThreshold/1024)).BoolVar(&data.Flags.CompressRequests)
 	// IMPORTANT: `--debug` is a built-in Kingpin flag so we must use `debug-mode`.
 	app.Flag("debug-mode", "Print API request and response details (NOTE: can disrupt the normal CLI flow output formatting)").BoolVar(&data.Flags.Debug)
 	// IMPORTANT: `--sso` causes a Kingpin runtime panic 🤦 so we use `enable-sso`.
 	app.Flag("enable-sso", "Enable Single-Sign On (SSO) for current profile execution (see also: 'fastly sso')").BoolVar(&data.Flags.SSO)
+	app.Flag("env-file", fmt.Sprintf("Load %s* environment variables from a dotenv-style file (exported variables take precedence)", env.Prefix)).StringVar(&data.Flags.EnvFile)
+	// NOTE: The error log rotation flags are nil unless set, as zero is a valid
+	// value (e.g. --error-log-backups 0 keeps no rotated logs).
+	var (
+		errorLogBackups, errorLogMaxDays int
+		errorLogMaxSize                  int64
+	)
+	app.Flag("error-log-backups", fmt.Sprintf("Keep this many rotated error logs (errors.log.1 being the most recent), dropping the oldest (default %d, 0 keeps none, overrides the config 'error_log_backups')", fsterr.DefaultFileRotationCount)).Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		data.Flags.ErrorLogBackups = &errorLogBackups
+		return nil
+	}).IntVar(&errorLogBackups)
+	app.Flag("error-log-json", "Write the error log as JSON lines (one object per error) to errors.jsonl, alongside the usual errors.log, for consumption by tooling").BoolVar(&data.Flags.ErrorLogJSON)
+	app.Flag("error-log-max-days", "Rotate the error log once its oldest entry is older than this many days, keeping the previous logs (see --error-log-backups, 0 disables rotation by age, overrides the config 'error_log_max_days')").Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		data.Flags.ErrorLogMaxDays = &errorLogMaxDays
+		return nil
+	}).IntVar(&errorLogMaxDays)
+	app.Flag("error-log-max-size", "Rotate the error log once it reaches this many bytes, keeping the previous logs (see --error-log-backups, default 5242880, 0 disables rotation by size, overrides the config 'error_log_max_size')").Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		data.Flags.ErrorLogMaxSize = &errorLogMaxSize
+		return nil
+	}).Int64Var(&errorLogMaxSize)
+	app.Flag("explain", "Print structured guidance (error category, likely causes and suggested next steps) when a command fails").BoolVar(&data.Flags.Explain)
+	app.Flag("fail-on-warning", fmt.Sprintf("Exit with status code %d if the command emits any warnings", fsterr.ExitCodeWarnings)).BoolVar(&data.Flags.FailOnWarning)
+	app.Flag("full", "Display long values in full, rather than truncated with an ellipsis (see --max-value-width)").BoolVar(&data.Flags.Full)
+	app.Flag("json-compact", "Render --json output on a single line (default when output is piped)").BoolVar(&data.Flags.JSONCompact)
+	app.Flag("json-errors-only", "Suppress the command output and print only a JSON error object if the command fails (the exit code indicates success or failure). Implies --quiet, and a prompt fails (see --non-interactive)").BoolVar(&data.Flags.JSONErrorsOnly)
+	app.Flag("json-pretty", "Render --json output indented (default when output is a terminal)").BoolVar(&data.Flags.JSONPretty)
+	app.Flag("label", "Annotate the invocation with a key=value label recorded in the error log (repeatable, e.g. --label ticket=CHG-123)").StringsVar(&data.Flags.Labels)
+	app.Flag("local-time", "Display timestamps in the local time zone when the output is a terminal (otherwise they're displayed in UTC, as RFC 3339)").BoolVar(&data.Flags.LocalTime)
+	app.Flag("log-stdin", "Record a redacted summary of the input read from stdin (size, SHA-256 hash, first and last lines) in the error log if the command fails. Only the size and hash are recorded for commands that read secrets, and a terminal isn't recorded").BoolVar(&data.Flags.LogStdin)
+	app.Flag("mask-ids", "Replace service IDs and other identifiers in the output with stable placeholders (e.g. SERVICE_1), so it can be shared safely").BoolVar(&data.Flags.MaskIDs)
+	app.Flag("max-value-width", "Truncate values in the text output of describe commands longer than this many characters with an ellipsis (by default, values are fitted to the terminal width). Never applies to --json").IntVar(&data.Flags.MaxValueWidth)
+	// NOTE: Kingpin parses a bool flag whose name starts with "no-" as a negated
+	// flag (i.e. false), so the value is set by the action instead.
+	app.Flag("no-clobber", "Refuse to overwrite an existing output file (e.g. the package archive written by compute build and compute pack)").Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		data.Flags.NoClobber = true
+		return nil
+	}).BoolVar(&data.Flags.NoClobber)
+	app.Flag("no-color", "Disable colored output (or via NO_COLOR)").Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		data.Flags.NoColor = true
+		return nil
+	}).BoolVar(&data.Flags.NoColor)
+	app.Flag("no-update-check", fmt.Sprintf("Disable the background check for a newer CLI version (or via %s)", env.NoUpdateCheck)).Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		data.Flags.NoUpdateCheck = true
+		return nil
+	}).BoolVar(&data.Flags.NoUpdateCheck)
 	app.Flag("non-interactive", "Do not prompt for user input - suitable for CI processes. Equivalent to --accept-defaults and --auto-yes").Short('i').BoolVar(&data.Flags.NonInteractive)
+	app.Flag("output", "Write the structured (e.g. --json) output of the command to this file instead of stdout, which is created with 0600 permissions (implies --json)").StringVar(&data.Flags.OutputFile)
+	app.Flag("pointer", "Print only the value at this RFC 6901 JSON Pointer within the --json output, e.g. --pointer /ServiceID (implies --json)").StringVar(&data.Flags.JSONPointer)
+	app.Flag("print-curl", "Print a (redacted) curl command equivalent to every API request to stderr, for reproducing it manually").BoolVar(&data.Flags.PrintCurl)
 	app.Flag("profile", "Switch account profile for single command execution (see also: 'fastly profile switch')").Short('o').StringVar(&data.Flags.Profile)
 	app.Flag("quiet", "Silence all output except direct command output. This won't prevent interactive prompts (see: --accept-defaults, --auto-yes, --non-interactive)").Short('q').BoolVar(&data.Flags.Quiet)
+	app.Flag("quiet-errors", "Silence non-fatal notices about failing to write the error log (the command error is still displayed)").BoolVar(&data.Flags.QuietErrors)
+	app.Flag("raw-response", "Print the (redacted) body of every API response to stderr, for debugging").BoolVar(&data.Flags.RawResponse)
+	app.Flag("redact-output", "Replace sensitive fields (e.g. access keys) in the output with REDACTED, so it can be shared safely (see also: --mask-ids). Commands that can't redact their text output require --json (or another format)").BoolVar(&data.Flags.RedactOutput)
+	app.Flag("retry-budget", "Cap the total time spent waiting to retry failed requests across the whole invocation (e.g. 30s), after which they're no longer retried (default: unlimited). Only `log-tail` and `kv-store-entry create` retry failed requests").DurationVar(&data.Flags.RetryBudget)
+	app.Flag("slow-threshold", "Warn when a single API request takes longer than this duration (e.g. 5s)").Default(DefaultSlowThreshold.String()).DurationVar(&data.Flags.SlowThreshold)
 	app.Flag("token", tokenHelp).HintAction(env.Vars).Short('t').StringVar(&data.Flags.Token)
+	app.Flag("trace-file", "Write a (redacted) JSON trace of the steps taken, e.g. service resolution and API requests with their timings, to this file").StringVar(&data.Flags.TraceFile)
+	app.Flag("validate-responses", "Warn when an API response omits fields the API always populates (they would otherwise be displayed as empty values)").BoolVar(&data.Flags.ValidateResponses)
 	app.Flag("verbose", "Verbose logging").Short('v').BoolVar(&data.Flags.Verbose)
 
 	return app
@@ -573,6 +878,12 @@ func checkConfigPermissions(commandName string, tokenSource lookup.Source, out i
 	}
 }
 
+func displayCorrelationID(id string, out io.Writer) {
+	if id != "" {
+		fmt.Fprintf(out, "Correlation ID: %s\n", id)
+	}
+}
+
 func displayAPIEndpoint(endpoint string, endpointSource lookup.Source, out io.Writer) {
 	switch endpointSource {
 	case lookup.SourceFlag:
@@ -602,9 +913,9 @@ func configureClients(token, apiEndpoint string, acf global.APIClientFactory, de
 	return apiClient, rtsClient, nil
 }
 
-func checkForUpdates(av github.AssetVersioner, commandName string, quietMode bool) func(io.Writer) {
-	if av != nil && commandName != "update" && !version.IsPreRelease(revision.AppVersion) {
-		return update.CheckAsync(revision.AppVersion, av, quietMode)
+func checkForUpdates(av github.AssetVersioner, commandName string, quietMode, disabled bool) func(io.Writer) {
+	if av != nil && !disabled && commandName != "update" && !version.IsPreRelease(revision.AppVersion) {
+		return update.CheckAsync(revision.AppVersion, av, quietMode, update.CachePath)
 	}
 	return func(_ io.Writer) {
 		// no-op
@@ -635,6 +946,16 @@ func commandCollectsData(command string) bool {
 	return false
 }
 
+// commandReadsSecrets determines if the command to be executed is one that
+// can read a secret (e.g. a token or passphrase) from stdin.
+func commandReadsSecrets(command string) bool {
+	switch command {
+	case "compute deploy", "compute publish", "config-store-entry create", "config-store-entry update", "kv-store-entry create", "profile create", "profile export", "profile import", "profile update", "secret-store-entry create":
+		return true
+	}
+	return false
+}
+
 // commandRequiresAuthServer determines if the command to be executed is one that
 // requires just the authentication server to be running.
 func commandRequiresAuthServer(command string) bool {
@@ -655,7 +976,7 @@ func commandRequiresToken(command argparser.Command) bool {
 			return text.IsFastlyID(initCmd.CloneFrom)
 		}
 		return false
-	case "compute build", "compute hash-files", "compute metadata", "compute serve":
+	case "compute build", "compute hash-files", "compute metadata", "compute serve", "logging regions":
 		return false
 	}
 	commandName = strings.Split(commandName, " ")[0]
@@ -746,3 +1067,210 @@ func accountEndpoint(args []string, e config.Environment, cfg config.File) strin
 	// Otherwise return the default account endpoint.
 	return global.DefaultAccountEndpoint
 }
+
+// DefaultSlowThreshold is the default --slow-threshold value. It's set high
+// enough that the warning is only displayed when the API is degraded.
+const DefaultSlowThreshold = 30 * time.Second
+
+// warnSlowRequest displays a warning for an API request that exceeded the
+// --slow-threshold and records it in the error log (which is persisted even
+// when the command succeeds).
+//
+// NOTE: Only the request path is reported as the query may contain secrets.
+func warnSlowRequest(data *global.Data, req *http.Request, elapsed time.Duration) {
+	elapsed = elapsed.Round(time.Millisecond)
+	data.ErrLog.AddWithContext(fmt.Errorf("slow API request: %s %s took %s", req.Method, req.URL.Path, elapsed), map[string]any{
+		"Method":    req.Method,
+		"Path":      req.URL.Path,
+		"Duration":  elapsed.String(),
+		"Threshold": data.Flags.SlowThreshold.String(),
+	})
+	msg := fmt.Sprintf("Slow API request: %s %s took %s (threshold: %s).", req.Method, req.URL.Path, elapsed, data.Flags.SlowThreshold)
+	if data.Flags.Quiet {
+		text.Warnings.Add(msg)
+		return
+	}
+	text.Warning(data.Output, "%s", msg)
+}
+
+// traceRequest records an API request in the trace (see --trace-file).
+//
+// NOTE: Only the request path is recorded as the query may contain secrets.
+func traceRequest(t *trace.Tracer, req *http.Request, resp *http.Response, elapsed time.Duration, err error) {
+	if t == nil {
+		return
+	}
+	attrs := map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+	if resp != nil {
+		attrs["status"] = resp.StatusCode
+	}
+	t.Record("api request", time.Now().Add(-elapsed), elapsed, attrs, err)
+}
+
+// writeTrace writes the trace of the invocation to the --trace-file. A failure
+// is only warned about, so the outcome of the command isn't affected.
+func writeTrace(data *global.Data, commandName string) {
+	if err := data.Tracer.Write(data.Flags.TraceFile, commandName); err != nil {
+		msg := fmt.Sprintf("Failed to write the trace file: %s.", err)
+		if data.Flags.Quiet {
+			text.Warnings.Add(msg)
+			return
+		}
+		text.Warning(data.Output, "%s", msg)
+	}
+}
+
+// warnLargeRequest warns that a request body exceeds the known limit of the
+// API endpoint, before the request is sent.
+func warnLargeRequest(data *global.Data, req *http.Request, size, limit int64) {
+	msg := fmt.Sprintf("The request body of %s %s is %d bytes, which exceeds the API limit of %d bytes. The request is likely to be rejected.", req.Method, req.URL.Path, size, limit)
+	if data.Flags.Quiet {
+		text.Warnings.Add(msg)
+		return
+	}
+	text.Warning(data.Output, "%s", msg)
+}
+
+// logBodySizes reports the request and response body sizes of an API request
+// (in verbose and debug mode). If the request failed, the sizes are recorded
+// so they're attached to the error log entry of the resulting API error (see
+// fsterr.RecordFailedRequest).
+func logBodySizes(data *global.Data, out io.Writer, req *http.Request, sizes api.BodySizes, err error) {
+	if err != nil || sizes.StatusCode >= http.StatusBadRequest {
+		fsterr.RecordFailedRequestSizes(req.Method, req.URL.Path, sizes.StatusCode, sizes.Request, sizes.Response)
+	}
+	if data.Flags.Debug || data.Verbose() {
+		fmt.Fprintf(out, "API request: %s %s (status: %d, request body: %d bytes, response body: %d bytes)\n", req.Method, req.URL.Path, sizes.StatusCode, sizes.Request, sizes.Response)
+	}
+}
+
+// envFileArg returns the --env-file flag value.
+//
+// NOTE: The file must be loaded before the Kingpin parser has executed, so we
+// inspect the raw arguments.
+func envFileArg(args []string) string {
+	for i, seg := range args {
+		if v, ok := strings.CutPrefix(seg, "--env-file="); ok {
+			return v
+		}
+		if seg == "--env-file" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// jsonStyle returns the --json output formatting requested via the global
+// --json-compact and --json-pretty flags.
+func jsonStyle(flags global.Flags) (argparser.JSONStyle, error) {
+	switch {
+	case flags.JSONCompact && flags.JSONPretty:
+		return argparser.JSONStyleAuto, fsterr.ErrInvalidJSONStyleCombo
+	case flags.JSONCompact:
+		return argparser.JSONStyleCompact, nil
+	case flags.JSONPretty:
+		return argparser.JSONStylePretty, nil
+	}
+	return argparser.JSONStyleAuto, nil
+}
+
+// setJSONPointer validates the --pointer flag and applies it to the command,
+// which must support JSON output.
+func setJSONPointer(command argparser.Command, data *global.Data) error {
+	if data.Flags.JSONPointer == "" {
+		return nil
+	}
+	if _, err := argparser.ParseJSONPointer(data.Flags.JSONPointer); err != nil {
+		return err
+	}
+	s, ok := command.(interface{ SetJSONPointer(string) })
+	if !ok {
+		return fsterr.ErrJSONPointerUnsupported
+	}
+	s.SetJSONPointer(data.Flags.JSONPointer)
+	// NOTE: As with --json, only the direct command output should be displayed.
+	data.Flags.Quiet = true
+	return nil
+}
+
+// setOutputFile applies the --output flag to the command, which must support
+// structured output.
+func setOutputFile(command argparser.Command, data *global.Data) error {
+	if data.Flags.OutputFile == "" {
+		return nil
+	}
+	s, ok := command.(interface{ SetOutputFile(string) })
+	if !ok {
+		return fsterr.ErrOutputFileUnsupported
+	}
+	if err := filesystem.CheckClobber(data.Flags.OutputFile); err != nil {
+		return err
+	}
+	s.SetOutputFile(data.Flags.OutputFile)
+	return nil
+}
+
+// isStructuredOutput reports whether the command's output is structured, as
+// parsed from its flags. If the command doesn't report it, the --json flag is
+// looked for in args instead.
+func isStructuredOutput(command argparser.Command, args []string) bool {
+	if s, ok := command.(interface{ StructuredOutput() bool }); ok {
+		return s.StructuredOutput()
+	}
+	return slices.Contains(args, "--json") || slices.Contains(args, "-j")
+}
+
+// setRedactOutput applies the --redact-output flag to the command. Its
+// structured output is always redacted, but its text output only if the
+// command supports it.
+func setRedactOutput(command argparser.Command, data *global.Data) error {
+	if !data.Flags.RedactOutput {
+		return nil
+	}
+	if s, ok := command.(interface{ SetRedactOutput() }); ok {
+		s.SetRedactOutput()
+	}
+	if _, ok := command.(interface{ RedactsTextOutput() }); ok {
+		return nil
+	}
+	if s, ok := command.(interface{ StructuredOutput() bool }); ok && s.StructuredOutput() {
+		return nil
+	}
+	return fsterr.ErrRedactOutputUnsupported
+}
+
+// parseLabels validates the --label flag values and returns them as a map.
+func parseLabels(values []string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	labels := make(map[string]string, len(values))
+	for _, v := range values {
+		key, value, ok := strings.Cut(v, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("invalid --label value %q", v),
+				Remediation: "Provide labels in the format key=value, e.g. --label ticket=CHG-123",
+			}
+		}
+		if strings.EqualFold(key, "token") {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("invalid --label key %q", key),
+				Remediation: "The 'token' label is reserved to prevent credentials from being persisted. Use a different key.",
+			}
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// apiVersion returns the pinned Fastly API version, preferring the
+// --api-version flag over the config file (an empty string means unpinned).
+func apiVersion(data *global.Data) string {
+	v, _ := data.APIVersion()
+	return v
+}
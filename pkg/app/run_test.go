@@ -3,15 +3,21 @@ package app_test
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/fatih/color"
+
 	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/internal/term"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
 )
 
 func TestShellCompletion(t *testing.T) {
@@ -92,6 +98,7 @@ service
 service-auth
 service-version
 stats
+telemetry
 tls-config
 tls-custom
 tls-platform
@@ -144,6 +151,104 @@ whoami
 	}
 }
 
+func TestLabels(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		args       string
+		wantError  string
+		wantLabels map[string]string
+	}{
+		{
+			name:       "valid labels",
+			args:       "version --json --label ticket=CHG-123 --label team=edge",
+			wantLabels: map[string]string{"ticket": "CHG-123", "team": "edge"},
+		},
+		{
+			name:      "missing value separator",
+			args:      "version --json --label ticket",
+			wantError: `invalid --label value "ticket"`,
+		},
+		{
+			name:      "reserved token key",
+			args:      "version --json --label token=abc",
+			wantError: `invalid --label key "token"`,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			defer func() {
+				errors.Labels = nil
+			}()
+			var stdout bytes.Buffer
+			args := testutil.SplitArgs(testcase.args)
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return testutil.MockGlobalData(args, &stdout), nil
+			}
+			err := app.Run(args, nil)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			if testcase.wantError == "" {
+				testutil.AssertEqual(t, testcase.wantLabels, errors.Labels)
+			}
+		})
+	}
+}
+
+func TestAPIVersion(t *testing.T) {
+	for _, testcase := range []struct {
+		name        string
+		args        string
+		config      string
+		wantVersion string
+		wantWarning bool
+	}{
+		{
+			name: "not pinned",
+			args: "version --json",
+		},
+		{
+			name:        "pinned via flag",
+			args:        "version --json --api-version 1",
+			wantVersion: "1",
+		},
+		{
+			name:        "pinned via config",
+			args:        "version --json",
+			config:      "1",
+			wantVersion: "1",
+		},
+		{
+			name:        "flag overrides config",
+			args:        "version --json --api-version 99",
+			config:      "1",
+			wantVersion: "99",
+			wantWarning: true,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			defer func() {
+				errors.APIVersion = ""
+			}()
+			var stdout bytes.Buffer
+			args := testutil.SplitArgs(testcase.args)
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(args, &stdout)
+				opts.Config.CLI.APIVersion = testcase.config
+				return opts, nil
+			}
+			err := app.Run(args, nil)
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, testcase.wantVersion, errors.APIVersion)
+
+			var warned bool
+			for _, w := range text.Warnings.Messages() {
+				if strings.Contains(w, "is unknown to this version of the CLI") {
+					warned = true
+				}
+			}
+			testutil.AssertBool(t, testcase.wantWarning, warned)
+		})
+	}
+}
+
 // stripTrailingSpace removes any trailing spaces from the multiline str.
 func stripTrailingSpace(str string) string {
 	buf := bytes.NewBuffer(nil)
@@ -155,3 +260,296 @@ func stripTrailingSpace(str string) string {
 	}
 	return buf.String()
 }
+
+func TestNegatedGlobalFlags(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		args string
+		want func(*global.Data) bool
+	}{
+		{
+			name: "no-color",
+			args: "version --json --no-color",
+			want: func(d *global.Data) bool { return d.Flags.NoColor },
+		},
+		{
+			name: "no-update-check",
+			args: "version --json --no-update-check",
+			want: func(d *global.Data) bool { return d.Flags.NoUpdateCheck },
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var (
+				stdout bytes.Buffer
+				data   *global.Data
+			)
+			args := testutil.SplitArgs(testcase.args)
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				data = testutil.MockGlobalData(args, &stdout)
+				return data, nil
+			}
+			err := app.Run(args, nil)
+			testutil.AssertNoError(t, err)
+			testutil.AssertBool(t, true, testcase.want(data))
+		})
+	}
+}
+
+func TestNoColor(t *testing.T) {
+	for _, testcase := range []struct {
+		name      string
+		args      string
+		want      bool
+		wantError string
+	}{
+		{
+			name: "parsed",
+			args: "version --json --no-color",
+			want: true,
+		},
+		{
+			name:      "before parsing",
+			args:      "--no-color not-a-command",
+			want:      true,
+			wantError: "expected command but got",
+		},
+		{
+			name:      "before parsing with a value",
+			args:      "version --no-color=true",
+			want:      true,
+			wantError: "unexpected 'true'",
+		},
+		{
+			name:      "before parsing with a false value",
+			args:      "version --no-color=false",
+			wantError: "unexpected 'false'",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			defer func() {
+				term.NoColor = false
+			}()
+			var stdout bytes.Buffer
+			args := testutil.SplitArgs(testcase.args)
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return testutil.MockGlobalData(args, &stdout), nil
+			}
+			err := app.Run(args, nil)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertBool(t, testcase.want, term.NoColor)
+		})
+	}
+}
+
+func TestJSONErrorsOnly(t *testing.T) {
+	var (
+		stdout bytes.Buffer
+		data   *global.Data
+	)
+	args := testutil.SplitArgs("version --json --json-errors-only")
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		data = testutil.MockGlobalData(args, &stdout)
+		return data, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "", stdout.String())
+	testutil.AssertBool(t, true, data.Flags.Quiet)
+}
+
+func TestStructuredOutputQuiet(t *testing.T) {
+	for _, flag := range []string{"--json", "-j"} {
+		t.Run(flag, func(t *testing.T) {
+			var (
+				stdout bytes.Buffer
+				data   *global.Data
+			)
+			args := testutil.SplitArgs("version " + flag)
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				data = testutil.MockGlobalData(args, &stdout)
+				return data, nil
+			}
+			err := app.Run(args, nil)
+			testutil.AssertNoError(t, err)
+			testutil.AssertBool(t, true, data.Flags.Quiet)
+		})
+	}
+}
+
+// TestOutputFileNotQuiet validates that with --output the messages are still
+// displayed, as the structured output is written to the file.
+func TestOutputFileNotQuiet(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "version.json")
+	var (
+		stdout bytes.Buffer
+		data   *global.Data
+	)
+	args := testutil.SplitArgs("version --output " + output)
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		data = testutil.MockGlobalData(args, &stdout)
+		return data, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertNoError(t, err)
+	testutil.AssertBool(t, false, data.Flags.Quiet)
+	testutil.AssertStringContains(t, stdout.String(), "SUCCESS: Output written to "+output)
+	testutil.AssertStringDoesntContain(t, stdout.String(), `"version"`)
+
+	b, err := os.ReadFile(output)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, string(b), `"version"`)
+}
+
+// TestDeprecationNotice validates the notice of a deprecated command is written
+// to stderr, unless the output is suppressed.
+func TestDeprecationNotice(t *testing.T) {
+	originalStderr := color.Error
+	defer func() {
+		color.Error = originalStderr
+	}()
+	for _, testcase := range []struct {
+		name       string
+		args       string
+		wantStderr bool
+	}{
+		{
+			name:       "notice displayed",
+			args:       "compute hashsum --package missing.tar.gz --metadata-disable",
+			wantStderr: true,
+		},
+		{
+			name: "notice suppressed with --json-errors-only",
+			args: "compute hashsum --package missing.tar.gz --json-errors-only",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			color.Error = &stderr
+			args := testutil.SplitArgs(testcase.args)
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				return testutil.MockGlobalData(args, &stdout), nil
+			}
+			_ = app.Run(args, nil)
+			testutil.AssertStringDoesntContain(t, stdout.String(), "is deprecated")
+			testutil.AssertBool(t, testcase.wantStderr, strings.Contains(stderr.String(), "The `fastly compute hashsum` command is deprecated"))
+			testutil.AssertStringContains(t, strings.Join(text.Warnings.Messages(), "\n"), "The `fastly compute hashsum` command is deprecated")
+		})
+	}
+}
+
+func TestJSONErrorsOnlyPrompt(t *testing.T) {
+	defer func() {
+		text.IsTerminal = term.IsTerminal
+	}()
+	input := strings.NewReader("")
+	text.IsTerminal = func(fd any) bool {
+		return fd == input
+	}
+	var stdout bytes.Buffer
+	args := testutil.SplitArgs("secret-store-entry create --store-id 123 --name example --json-errors-only")
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		data := testutil.MockGlobalData(args, &stdout)
+		data.Input = input
+		return data, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertErrorContains(t, err, "--json-errors-only can't be used when the command prompts for input")
+}
+
+// TestStackWithTelemetryDisabled validates disabling telemetry doesn't stop
+// stack traces being recorded in the local error log.
+func TestStackWithTelemetryDisabled(t *testing.T) {
+	var stdout bytes.Buffer
+	args := testutil.SplitArgs("service-version list")
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		data := testutil.MockGlobalData(args, &stdout)
+		data.Env.DisableTelemetry = "true"
+		return data, nil
+	}
+	_ = app.Run(args, nil)
+	testutil.AssertBool(t, true, errors.AllowInstrumentation)
+}
+
+func TestLogStdin(t *testing.T) {
+	defer func() {
+		errors.Stdin = nil
+		text.IsTerminal = term.IsTerminal
+	}()
+	scenarios := []struct {
+		name     string
+		args     string
+		terminal bool
+		want     func(*errors.StdinCapture) bool
+	}{
+		{
+			name: "piped input is captured",
+			args: "version --json --log-stdin",
+			want: func(c *errors.StdinCapture) bool { return c != nil && !c.OmitLines },
+		},
+		{
+			name:     "a terminal isn't wrapped",
+			args:     "version --json --log-stdin",
+			terminal: true,
+			want:     func(c *errors.StdinCapture) bool { return c == nil },
+		},
+		{
+			name: "only the size is captured for a command reading secrets",
+			args: "profile import --file missing.json --log-stdin",
+			want: func(c *errors.StdinCapture) bool { return c != nil && c.OmitLines },
+		},
+		{
+			name: "only the size is captured for a command reading store values",
+			args: "kv-store-entry create --store-id 123 --stdin --file missing.json --log-stdin",
+			want: func(c *errors.StdinCapture) bool { return c != nil && c.OmitLines },
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			errors.Stdin = nil
+			input := strings.NewReader("input\n")
+			text.IsTerminal = func(fd any) bool {
+				return testcase.terminal && fd == input
+			}
+			var stdout bytes.Buffer
+			args := testutil.SplitArgs(testcase.args)
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				data := testutil.MockGlobalData(args, &stdout)
+				data.Input = input
+				return data, nil
+			}
+			_ = app.Run(args, nil)
+			testutil.AssertBool(t, true, testcase.want(errors.Stdin))
+		})
+	}
+}
+
+func TestTraceFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+	var stdout bytes.Buffer
+	args := testutil.SplitArgs("version --json --trace-file " + path)
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		return testutil.MockGlobalData(args, &stdout), nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertNoError(t, err)
+
+	data, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	var f struct {
+		Command string `json:"command"`
+		Spans   []struct {
+			Name       string         `json:"name"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"spans"`
+	}
+	testutil.AssertNoError(t, json.Unmarshal(data, &f))
+	testutil.AssertString(t, "version", f.Command)
+	testutil.AssertEqual(t, 1, len(f.Spans))
+	testutil.AssertString(t, "command", f.Spans[0].Name)
+	testutil.AssertEqual(t, map[string]any{"command": "version"}, f.Spans[0].Attributes)
+
+	// An existing trace file isn't overwritten with --no-clobber.
+	args = testutil.SplitArgs("version --json --no-clobber --trace-file " + path)
+	err = app.Run(args, nil)
+	testutil.AssertErrorContains(t, err, "refusing to overwrite existing file")
+}
@@ -145,18 +145,49 @@ var UsageTemplateFuncs = template.FuncMap{
 //
 // NOTE: This map is used to help populate the CLI 'usage' template renderer.
 var globalFlags = map[string]bool{
-	"accept-defaults": true,
-	"account":         true,
-	"auto-yes":        true,
-	"debug-mode":      true,
-	"enable-sso":      true,
-	"endpoint":        true,
-	"help":            true,
-	"non-interactive": true,
-	"profile":         true,
-	"quiet":           true,
-	"token":           true,
-	"verbose":         true,
+	"accept-defaults":    true,
+	"account":            true,
+	"api-version":        true,
+	"auto-yes":           true,
+	"compress-requests":  true,
+	"debug-mode":         true,
+	"enable-sso":         true,
+	"endpoint":           true,
+	"env-file":           true,
+	"error-log-backups":  true,
+	"error-log-json":     true,
+	"error-log-max-days": true,
+	"error-log-max-size": true,
+	"explain":            true,
+	"fail-on-warning":    true,
+	"full":               true,
+	"help":               true,
+	"json-compact":       true,
+	"json-errors-only":   true,
+	"json-pretty":        true,
+	"label":              true,
+	"local-time":         true,
+	"log-stdin":          true,
+	"mask-ids":           true,
+	"max-value-width":    true,
+	"no-clobber":         true,
+	"no-color":           true,
+	"no-update-check":    true,
+	"non-interactive":    true,
+	"output":             true,
+	"pointer":            true,
+	"print-curl":         true,
+	"profile":            true,
+	"quiet":              true,
+	"quiet-errors":       true,
+	"raw-response":       true,
+	"redact-output":      true,
+	"retry-budget":       true,
+	"slow-threshold":     true,
+	"token":              true,
+	"trace-file":         true,
+	"validate-responses": true,
+	"verbose":            true,
 }
 
 // VerboseUsageTemplate is the full-fat usage template, rendered when users type
@@ -0,0 +1,214 @@
+package argparser
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+const (
+	// OnErrorContinue processes every item of a bulk operation regardless of
+	// failures.
+	OnErrorContinue = "continue"
+	// OnErrorAbort skips the remaining items of a bulk operation once an item
+	// has failed.
+	OnErrorAbort = "abort"
+)
+
+// OnErrorBehaviours is the list of values accepted by the --on-error flag.
+var OnErrorBehaviours = []string{OnErrorContinue, OnErrorAbort}
+
+// RunItem calls fn for a single item of a bulk operation, bounding it by a
+// per-item deadline derived from ctx when timeout is non-zero (see
+// --timeout-per-item). An item that times out returns a fsterr.TimeoutError
+// (wrapping context.DeadlineExceeded), so it's recorded as a failure.
+//
+// NOTE: The API client doesn't accept a context, so a timed out call is
+// abandoned (rather than cancelled) and its result discarded.
+func RunItem(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fn(ctx)
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return fsterr.TimeoutError{Timeout: timeout, Err: ctx.Err()}
+	}
+}
+
+// BulkStatus is the outcome of a single item in a bulk operation.
+type BulkStatus string
+
+const (
+	// BulkSucceeded means the operation succeeded for the item.
+	BulkSucceeded BulkStatus = "succeeded"
+	// BulkFailed means the operation failed for the item.
+	BulkFailed BulkStatus = "failed"
+	// BulkSkipped means the operation wasn't attempted for the item (e.g. too
+	// many other items had already failed).
+	BulkSkipped BulkStatus = "skipped"
+)
+
+// maxBulkErrorIDs is the number of failed items named in the error returned
+// by BulkResult.Err.
+const maxBulkErrorIDs = 10
+
+// BulkItem is the result of a single item in a bulk operation.
+type BulkItem struct {
+	ID     string     `json:"id"`
+	Status BulkStatus `json:"status"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// BulkSummary is the number of items in each state.
+type BulkSummary struct {
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+}
+
+// BulkReport is the JSON representation of a bulk operation.
+type BulkReport struct {
+	Summary BulkSummary `json:"summary"`
+	Results []BulkItem  `json:"results"`
+}
+
+// BulkResult records the per-item results of a bulk operation (e.g. deleting
+// all the keys in a store). It's safe for concurrent use.
+type BulkResult struct {
+	// Action is the operation, used in the returned error (e.g. "delete").
+	Action string
+	// Noun is the plural of the item type (e.g. "keys").
+	Noun string
+	// OnError is the behaviour when an item fails (see --on-error). It
+	// defaults to OnErrorContinue.
+	OnError string
+
+	mu     sync.Mutex
+	failed bool
+	items  []BulkItem
+}
+
+// Aborted reports whether the remaining items should be skipped, as an item
+// failed and OnError is OnErrorAbort.
+func (r *BulkResult) Aborted() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed && r.OnError == OnErrorAbort
+}
+
+// Succeeded records the operation succeeded for the item.
+func (r *BulkResult) Succeeded(id string) {
+	r.add(BulkItem{ID: id, Status: BulkSucceeded})
+}
+
+// Failed records the operation failed for the item.
+func (r *BulkResult) Failed(id string, err error) {
+	r.add(BulkItem{ID: id, Status: BulkFailed, Error: err.Error()})
+}
+
+// Skipped records the operation wasn't attempted for the item.
+func (r *BulkResult) Skipped(id string) {
+	r.add(BulkItem{ID: id, Status: BulkSkipped})
+}
+
+func (r *BulkResult) add(item BulkItem) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed = r.failed || item.Status == BulkFailed
+	r.items = append(r.items, item)
+}
+
+// Report returns the summary along with the results sorted by ID.
+func (r *BulkResult) Report() BulkReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := BulkReport{Results: append([]BulkItem{}, r.items...)}
+	sort.SliceStable(report.Results, func(i, j int) bool {
+		return report.Results[i].ID < report.Results[j].ID
+	})
+	for _, item := range report.Results {
+		report.Summary.Total++
+		switch item.Status {
+		case BulkSucceeded:
+			report.Summary.Succeeded++
+		case BulkFailed:
+			report.Summary.Failed++
+		case BulkSkipped:
+			report.Summary.Skipped++
+		}
+	}
+	return report
+}
+
+// Render writes the report to out, as JSON if j is enabled. Otherwise a
+// summary table is displayed followed by the items that failed.
+func (r *BulkResult) Render(out io.Writer, j JSONOutput) error {
+	report := r.Report()
+	if ok, err := j.WriteOutput(out, report); ok {
+		return err
+	}
+
+	text.Break(out)
+	tw := text.NewTable(out)
+	tw.AddHeader("TOTAL", "SUCCEEDED", "FAILED", "SKIPPED")
+	tw.AddLine(report.Summary.Total, report.Summary.Succeeded, report.Summary.Failed, report.Summary.Skipped)
+	tw.Print()
+
+	if report.Summary.Failed == 0 {
+		return nil
+	}
+	text.Break(out)
+	tw = text.NewTable(out)
+	tw.AddHeader("FAILED", "ERROR")
+	for _, item := range report.Results {
+		if item.Status == BulkFailed {
+			tw.AddLine(item.ID, item.Error)
+		}
+	}
+	tw.Print()
+	return nil
+}
+
+// Err returns an error if any item failed, unless ignoreErrors is set (see
+// --ignore-errors). The error is a fsterr.BulkError, so the exit code
+// distinguishes a partial failure from every item failing.
+func (r *BulkResult) Err(ignoreErrors bool) error {
+	report := r.Report()
+	if report.Summary.Failed == 0 || ignoreErrors {
+		return nil
+	}
+	var ids []string
+	for _, item := range report.Results {
+		if item.Status == BulkFailed {
+			ids = append(ids, item.ID)
+		}
+	}
+	if len(ids) > maxBulkErrorIDs {
+		ids = append(ids[:maxBulkErrorIDs], fmt.Sprintf("and %d more", report.Summary.Failed-maxBulkErrorIDs))
+	}
+	return fsterr.BulkError{
+		Action:    r.Action,
+		Noun:      r.Noun,
+		IDs:       ids,
+		Failed:    report.Summary.Failed,
+		Succeeded: report.Summary.Succeeded,
+		Total:     report.Summary.Total,
+	}
+}
@@ -0,0 +1,336 @@
+package argparser
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fastly/kingpin"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/internal/term"
+	"github.com/fastly/cli/pkg/text"
+)
+
+const (
+	// DiffContextChangedOnly displays only the fields that were changed.
+	DiffContextChangedOnly = "changed-only"
+	// DiffContextFull displays every field, whether it was changed or not.
+	DiffContextFull = "full"
+)
+
+// DiffContexts is the list of values accepted by the --diff-context flag.
+var DiffContexts = []string{DiffContextChangedOnly, DiffContextFull}
+
+// maxChangeValueWidth is the number of characters of a field value displayed
+// before it's truncated with an ellipsis (unless --max-value-width or --full
+// is set).
+const maxChangeValueWidth = 60
+
+// ignoredChangeFields are fields that are expected to differ between two
+// fetches of the same resource and so aren't reported as changes.
+var ignoredChangeFields = []string{"created_at", "deleted_at", "updated_at"}
+
+// ChangesOutput is a helper for adding a `--show-changes` flag to update
+// commands. It can be embedded into command structs.
+type ChangesOutput struct {
+	DiffContext   string // Set via flag.
+	OnlyIfChanged bool   // Set via flag.
+	ShowChanges   bool   // Set via flag.
+}
+
+// FieldChange is a single field's value before and after an update.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// ChangeSet is the JSON representation of the changes made by an update. An
+// update skipped by --only-if-changed has no changes.
+type ChangeSet struct {
+	Changed map[string]FieldChange `json:"changed"`
+	// Rejected are the fields whose requested value the API didn't apply, i.e.
+	// the update was only partially applied (see RejectedFields).
+	Rejected []string `json:"rejected,omitempty"`
+
+	// unchanged is displayed with --diff-context full.
+	unchanged map[string]any
+}
+
+// RegisterChangesFlags defines the --show-changes flag, along with the
+// --diff-context flag controlling how the changes are displayed.
+//
+// NOTE: Long values are displayed in full with the global --full flag.
+func (c *ChangesOutput) RegisterChangesFlags(cmd *kingpin.CmdClause) {
+	cmd.Flag(FlagDiffContextName, FlagDiffContextDesc).Default(DiffContextChangedOnly).HintOptions(DiffContexts...).EnumVar(&c.DiffContext, DiffContexts...)
+	cmd.Flag(FlagShowChangesName, FlagShowChangesDesc).BoolVar(&c.ShowChanges)
+}
+
+// RegisterOnlyIfChangedFlag defines the --only-if-changed flag, which skips an
+// update whose values match the existing values (see PendingChanges).
+func (c *ChangesOutput) RegisterOnlyIfChangedFlag(cmd *kingpin.CmdClause) {
+	cmd.Flag(FlagOnlyIfChangedName, FlagOnlyIfChangedDesc).BoolVar(&c.OnlyIfChanged)
+}
+
+// ChangesRequested indicates if the resource needs to be fetched before it's
+// updated so the changes can be reported (i.e. --show-changes or --json).
+func (c *ChangesOutput) ChangesRequested(j JSONOutput) bool {
+	return c.ShowChanges || j.Enabled
+}
+
+// DisplayChanges writes the changes to out as a table, if --show-changes is
+// set. Otherwise it's a no-op.
+//
+// Changed values are colored (unless color is disabled, e.g. --no-color) and
+// long values are truncated with an ellipsis (unless --full is set).
+func (c *ChangesOutput) DisplayChanges(out io.Writer, cs ChangeSet) {
+	if !c.ShowChanges {
+		return
+	}
+	text.Break(out)
+	if len(cs.Changed) == 0 && c.DiffContext != DiffContextFull {
+		text.Output(out, "No fields were changed (the values provided match the existing values).")
+		return
+	}
+
+	names := cs.Fields()
+	if c.DiffContext == DiffContextFull {
+		for name := range cs.unchanged {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	rows := [][]string{{"FIELD", "OLD", "NEW"}}
+	for _, name := range names {
+		if fc, ok := cs.Changed[name]; ok {
+			rows = append(rows, []string{name, c.formatChangeValue(fc.Old), c.formatChangeValue(fc.New)})
+			continue
+		}
+		v := c.formatChangeValue(cs.unchanged[name])
+		rows = append(rows, []string{name, v, v})
+	}
+
+	// NOTE: The table is laid out here (rather than via text.Table) as the
+	// color escape codes would otherwise skew the column widths.
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	color := term.ColorEnabled() && term.IsTerminal(out)
+	for r, row := range rows {
+		_, changed := cs.Changed[row[0]]
+		var b strings.Builder
+		for i, cell := range row {
+			if i+1 < len(row) {
+				cell += strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)+2)
+			}
+			switch {
+			case !color:
+			case r == 0:
+				cell = text.Bold(cell)
+			case changed && i == 1:
+				cell = text.BoldRed(cell)
+			case changed && i == 2:
+				cell = text.BoldGreen(cell)
+			}
+			b.WriteString(cell)
+		}
+		fmt.Fprintln(out, b.String())
+	}
+}
+
+// Fields returns the sorted names of the changed fields.
+func (cs ChangeSet) Fields() []string {
+	names := make([]string, 0, len(cs.Changed))
+	for name := range cs.Changed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Changes compares a resource fetched before an update with the resource
+// returned by the update, and returns the fields whose value differs.
+//
+// A field that was given its existing value isn't reported as changed. If
+// either resource is nil (e.g. it wasn't fetched) no changes are returned.
+//
+// Fields are named after their `mapstructure` tag (as used by the API client
+// library), falling back to the struct field name.
+//
+// NOTE: The values of sensitive fields (see text.IsSensitiveField) are
+// compared, but replaced with api.Redacted in the result.
+func Changes(before, after any) ChangeSet {
+	cs := ChangeSet{Changed: make(map[string]FieldChange)}
+	b, a := changeFields(before), changeFields(after)
+	if b == nil || a == nil {
+		return cs
+	}
+	for name, nv := range a {
+		ov := b[name]
+		if !reflect.DeepEqual(ov, nv) {
+			cs.Changed[name] = FieldChange{Old: maskChangeValue(name, ov), New: maskChangeValue(name, nv)}
+		}
+	}
+	for name, ov := range b {
+		if _, ok := a[name]; !ok {
+			cs.Changed[name] = FieldChange{Old: maskChangeValue(name, ov)}
+		}
+	}
+	cs.unchanged = make(map[string]any)
+	for name, v := range a {
+		if _, ok := cs.Changed[name]; !ok {
+			cs.unchanged[name] = maskChangeValue(name, v)
+		}
+	}
+	return cs
+}
+
+// maskChangeValue returns api.Redacted in place of the value of a sensitive
+// field, unless it's unset.
+func maskChangeValue(name string, v any) any {
+	if v == nil || v == "" || !text.IsSensitiveField(name) {
+		return v
+	}
+	return api.Redacted
+}
+
+// RejectedFields compares the input of an update (e.g.
+// fastly.UpdateCloudfilesInput) with the resource returned by the update, and
+// returns the sorted names of the fields that were set in the input but have a
+// different value in the resource, i.e. the API didn't apply them.
+//
+// Input fields are matched to the resource's fields by their `url` tag (as used
+// by the API client library to encode the request). A field the resource
+// doesn't include (or is nil) isn't reported, as its value can't be verified
+// (e.g. a write-only secret).
+func RejectedFields(input, after any) []string {
+	if changeFields(after) == nil {
+		return nil
+	}
+	return inputDifferences(input, after, false)
+}
+
+// PendingChanges compares the input of an update with the resource fetched
+// before the update, and returns the sorted names of the fields the update
+// would change (see --only-if-changed).
+//
+// Unlike RejectedFields, a field the resource doesn't include is reported, as
+// it can't be verified to already have the requested value.
+func PendingChanges(input, before any) []string {
+	return inputDifferences(input, before, true)
+}
+
+// inputDifferences returns the sorted names of the fields set in the input
+// whose value differs from the resource's. The fields whose value can't be
+// verified (i.e. the resource doesn't include them) are reported if
+// unverifiable is set.
+func inputDifferences(input, resource any, unverifiable bool) []string {
+	rv := reflect.ValueOf(input)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	r := changeFields(resource)
+	var names []string
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("url"), ",")
+		fv := rv.Field(i)
+		if !f.IsExported() || name == "" || name == "-" || fv.Kind() != reflect.Pointer || fv.IsNil() {
+			continue
+		}
+		got, ok := r[name]
+		if !ok || got == nil {
+			if unverifiable {
+				names = append(names, name)
+			}
+			continue
+		}
+		want := fv.Elem()
+		// NOTE: The input can use a different type of the same kind (e.g.
+		// fastly.Compatibool rather than bool).
+		if gv := reflect.ValueOf(got); want.Kind() == gv.Kind() && want.Type() != gv.Type() && want.Type().ConvertibleTo(gv.Type()) {
+			want = want.Convert(gv.Type())
+		}
+		if !reflect.DeepEqual(want.Interface(), got) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// changeFields returns the exported field values of a struct (or pointer to a
+// struct), dereferencing pointer values. nil is returned for a nil value.
+func changeFields(v any) map[string]any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := make(map[string]any)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("mapstructure"), ",")
+		if name == "" {
+			name = f.Name
+		}
+		if name == "-" || slices.Contains(ignoredChangeFields, name) {
+			continue
+		}
+		fv := rv.Field(i)
+		for fv.Kind() == reflect.Pointer && !fv.IsNil() {
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Pointer {
+			fields[name] = nil
+			continue
+		}
+		fields[name] = fv.Interface()
+	}
+	return fields
+}
+
+// formatChangeValue renders a field value for the changes table. Values longer
+// than maxChangeValueWidth (or --max-value-width) are truncated with an
+// ellipsis, unless --full is set. Line breaks are escaped.
+func (c *ChangesOutput) formatChangeValue(v any) string {
+	if v == nil {
+		return "-"
+	}
+	// NOTE: Line breaks (e.g. in a PEM encoded key) would break the table.
+	s := strings.ReplaceAll(fmt.Sprint(v), "\n", `\n`)
+	width := maxChangeValueWidth
+	if text.MaxValueWidth > 0 {
+		width = text.MaxValueWidth
+	}
+	if text.FullValues || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width-1]) + "…"
+}
@@ -3,6 +3,9 @@ package argparser
 import (
 	"fmt"
 	"io"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/fastly/kingpin"
@@ -14,6 +17,8 @@ import (
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/trace"
+	"github.com/fastly/cli/pkg/versionlock"
 )
 
 // Command is an interface that abstracts over all of the concrete command
@@ -129,30 +134,141 @@ type ServiceDetailsOpts struct {
 	ServiceVersionFlag OptionalServiceVersion
 	VerboseMode        bool
 	ErrLog             fsterr.LogInterface
+	// ResolveOnly returns the resolved version without cloning it (with
+	// --autoclone) or checking its state, so the caller can inspect it first
+	// (see EditableVersion).
+	ResolveOnly bool
 }
 
+// NoticeOutput is where ServiceDetails reports a version it created (i.e. with
+// --version editable). It's stderr so the command output (e.g. --json) isn't
+// affected.
+//
+// NOTE: It's a variable so the tests can capture the notices.
+var NoticeOutput io.Writer = os.Stderr
+
+// Tracer records the steps of ServiceDetails (see --trace-file).
+//
+// NOTE: It's assigned by the app package, as the ServiceDetailsOpts are
+// constructed by each command.
+var Tracer *trace.Tracer
+
 // ServiceDetails returns the Service ID and Service Version.
+//
+// The Service Version is resolved from the --version flag, then the
+// .fastly-version lockfile in the working directory (if it was written for the
+// resolved Service ID), otherwise the active version (or the latest version if
+// none is active) is used.
+//
+// See ResolveServiceDetails for a description of how they were resolved.
 func ServiceDetails(opts ServiceDetailsOpts) (serviceID string, serviceVersion *fastly.Version, err error) {
+	serviceID, serviceVersion, _, err = ResolveServiceDetails(opts)
+	return serviceID, serviceVersion, err
+}
+
+// ResolveServiceDetails returns the Service ID and Service Version (see
+// ServiceDetails) along with a record of how they were resolved.
+//
+// The record is displayed in verbose mode, and is written into the error log
+// (if the command fails) even when the resolution itself fails.
+func ResolveServiceDetails(opts ServiceDetailsOpts) (serviceID string, serviceVersion *fastly.Version, r ServiceResolution, err error) {
+	start := time.Now()
+	defer func() {
+		fsterr.ServiceResolution = r.String()
+		Tracer.Record("service resolution", start, time.Since(start), map[string]any{"resolution": r}, err)
+	}()
+
 	serviceID, source, flag, err := ServiceID(opts.ServiceNameFlag, opts.Manifest, opts.APIClient, opts.ErrLog)
+	text.RegisterID(text.IDKindService, serviceID) // --mask-ids
+	r.ServiceID = serviceID
+	r.ServiceIDSource = serviceIDSourceName(flag, source)
+	if source == manifest.SourceUndefined {
+		r.ServiceIDSource = "not provided"
+	}
+	r.IgnoredServiceIDs = serviceIDOverrides(serviceID, flag, source, opts.Manifest)
 	if err != nil {
-		return serviceID, serviceVersion, err
+		return serviceID, serviceVersion, r, err
 	}
 	if opts.VerboseMode {
 		DisplayServiceID(serviceID, flag, source, opts.Out)
+		DisplayServiceIDOverrides(serviceID, flag, source, opts.Manifest, opts.Out)
 	}
 
-	v, err := opts.ServiceVersionFlag.Parse(serviceID, opts.APIClient)
+	r.VersionSource = "default"
+	if v := opts.ServiceVersionFlag.Value; v != "" && v != versionFromLockfile {
+		r.VersionSource = "--" + FlagVersionName
+	}
+	locked, err := opts.ServiceVersionFlag.readLockfile(serviceID)
 	if err != nil {
-		return serviceID, serviceVersion, err
+		return serviceID, serviceVersion, r, err
+	}
+	if locked {
+		r.VersionSource = versionlock.FileName
+	}
+	r.VersionInput = opts.ServiceVersionFlag.Value
+
+	v, vs, rule, err := opts.ServiceVersionFlag.resolve(serviceID, opts.APIClient)
+	r.VersionRule = rule
+	r.VersionCandidates = newVersionCandidates(vs)
+	if err != nil {
+		return serviceID, serviceVersion, r, err
+	}
+	r.Version = fastly.ToValue(v.Number)
+	// NOTE: A version specified by number (via --version) isn't displayed, as
+	// there was no decision to make.
+	if opts.VerboseMode && (r.VersionSource != "--"+FlagVersionName || r.VersionRule != "specified version") {
+		via := r.VersionSource
+		switch {
+		case via == "default":
+			via = r.VersionRule
+		case r.VersionRule != "specified version":
+			via += " " + r.VersionInput
+		}
+		text.Output(opts.Out, "Service version (via %s): %d", via, r.Version)
+		text.Break(opts.Out)
+	}
+
+	if opts.ResolveOnly {
+		return serviceID, v, r, nil
+	}
+	v, err = editableVersion(opts, serviceID, v, &r)
+	return serviceID, v, r, err
+}
+
+// EditableVersion returns the version to modify, once the version resolved by
+// ServiceDetails (with ResolveOnly set) has been inspected: the version is
+// cloned if --autoclone is set and it isn't editable, otherwise its state is
+// checked (see ServiceDetailsOpts).
+func EditableVersion(opts ServiceDetailsOpts, serviceID string, v *fastly.Version) (*fastly.Version, error) {
+	r := ServiceResolution{Version: fastly.ToValue(v.Number), VersionInput: opts.ServiceVersionFlag.Value}
+	return editableVersion(opts, serviceID, v, &r)
+}
+
+// editableVersion implements EditableVersion, recording a clone in r.
+func editableVersion(opts ServiceDetailsOpts, serviceID string, v *fastly.Version, r *ServiceResolution) (*fastly.Version, error) {
+	// NOTE: When there's no editable version, --version editable only clones
+	// one with --autoclone, so a version isn't created unexpectedly.
+	editable := strings.EqualFold(r.VersionInput, "editable")
+	if editable && !opts.AutoCloneFlag.Value && !IsEditableVersion(v) {
+		return v, fsterr.RemediationError{
+			Inner:       fmt.Errorf("service %s has no editable version", serviceID),
+			Remediation: fmt.Sprintf("Repeat the command with the --autoclone flag to clone version %d, or clone it with `fastly service-version clone`.", r.Version),
+		}
 	}
 
 	if opts.AutoCloneFlag.WasSet {
 		currentVersion := v
-		v, err = opts.AutoCloneFlag.Parse(currentVersion, serviceID, opts.VerboseMode, opts.Out, opts.APIClient)
+		v, err := opts.AutoCloneFlag.Parse(currentVersion, serviceID, opts.VerboseMode, opts.Out, opts.APIClient)
 		if err != nil {
-			return serviceID, currentVersion, err
+			return currentVersion, err
+		}
+		if n := fastly.ToValue(v.Number); n != r.Version {
+			r.ClonedFrom, r.Version = r.Version, n
+			if editable {
+				text.Info(NoticeOutput, "Cloned version %d of service %s to create editable version %d.\n\n", r.ClonedFrom, serviceID, r.Version)
+			}
 		}
-		return serviceID, v, nil
+		return v, nil
 	}
 
 	failure := false
@@ -192,13 +308,12 @@ func ServiceDetails(opts ServiceDetailsOpts) (serviceID string, serviceVersion *
 	}
 
 	if failure {
-		err = fsterr.RemediationError{
+		return v, fsterr.RemediationError{
 			Inner:       fmt.Errorf("service version %d is %s", fastly.ToValue(v.Number), failureState),
 			Remediation: fsterr.AutoCloneRemediation,
 		}
-		return serviceID, v, err
 	}
-	return serviceID, v, nil
+	return v, nil
 }
 
 // ServiceID returns the Service ID and the source of that information.
@@ -239,19 +354,54 @@ func ServiceID(serviceName OptionalServiceNameID, data manifest.Data, client api
 // DisplayServiceID acquires the Service ID (if provided) and displays both it
 // and its source location.
 func DisplayServiceID(sid, flag string, s manifest.Source, out io.Writer) {
-	var via string
+	via := " (not provided)"
+	if s != manifest.SourceUndefined {
+		via = fmt.Sprintf(" (via %s)", serviceIDSourceName(flag, s))
+	}
+	text.Output(out, "Service ID%s: %s", via, sid)
+	text.Break(out)
+}
+
+// DisplayServiceIDOverrides displays any Service ID defined by a lower
+// priority source (i.e. FASTLY_SERVICE_ID or the manifest file) that differs
+// from, and so was ignored in favour of, the resolved Service ID.
+func DisplayServiceIDOverrides(sid, flag string, s manifest.Source, data manifest.Data, out io.Writer) {
+	overrides := serviceIDOverrides(sid, flag, s, data)
+	for _, o := range overrides {
+		text.Info(out, "Ignoring Service ID %s (via %s) as %s takes precedence.", o.ServiceID, o.Source, serviceIDSourceName(flag, s))
+	}
+	if len(overrides) > 0 {
+		text.Break(out)
+	}
+}
+
+// serviceIDOverrides returns the Service IDs defined by lower priority sources
+// that differ from the resolved Service ID.
+func serviceIDOverrides(sid, flag string, s manifest.Source, data manifest.Data) []ServiceIDCandidate {
+	var overrides []ServiceIDCandidate
+	for _, lower := range []manifest.Source{manifest.SourceEnv, manifest.SourceFile} {
+		if lower >= s {
+			continue
+		}
+		if ignored := data.ServiceIDFrom(lower); ignored != "" && ignored != sid {
+			overrides = append(overrides, ServiceIDCandidate{ServiceID: ignored, Source: serviceIDSourceName(flag, lower)})
+		}
+	}
+	return overrides
+}
+
+// serviceIDSourceName returns a human readable name for the Service ID source.
+func serviceIDSourceName(flag string, s manifest.Source) string {
 	switch s {
 	case manifest.SourceFlag:
-		via = fmt.Sprintf(" (via %s)", flag)
+		return flag
 	case manifest.SourceFile:
-		via = fmt.Sprintf(" (via %s)", manifest.Filename)
+		return manifest.Filename
 	case manifest.SourceEnv:
-		via = fmt.Sprintf(" (via %s)", env.ServiceID)
+		return env.ServiceID
 	case manifest.SourceUndefined:
-		via = " (not provided)"
 	}
-	text.Output(out, "Service ID%s: %s", via, sid)
-	text.Break(out)
+	return ""
 }
 
 // ArgsIsHelpJSON determines whether the supplied command arguments are exactly
@@ -321,25 +471,56 @@ func IsGlobalFlagsOnly(args []string) bool {
 	// False positive https://github.com/semgrep/semgrep/issues/8593
 	// nosemgrep: trailofbits.go.iterate-over-empty-map.iterate-over-empty-map
 	globals := map[string]int{
-		"--accept-defaults": 0,
-		"-d":                0,
-		"--account":         1,
-		"--api":             1,
-		"--auto-yes":        0,
-		"-y":                0,
-		"--debug-mode":      0,
-		"--enable-sso":      0,
-		"--help":            0,
-		"--non-interactive": 0,
-		"-i":                0,
-		"--profile":         1,
-		"-o":                1,
-		"--quiet":           0,
-		"-q":                0,
-		"--token":           1,
-		"-t":                1,
-		"--verbose":         0,
-		"-v":                0,
+		"--accept-defaults":    0,
+		"-d":                   0,
+		"--account":            1,
+		"--api":                1,
+		"--api-version":        1,
+		"--auto-yes":           0,
+		"-y":                   0,
+		"--compress-requests":  0,
+		"--debug-mode":         0,
+		"--enable-sso":         0,
+		"--env-file":           1,
+		"--error-log-backups":  1,
+		"--error-log-json":     0,
+		"--error-log-max-days": 1,
+		"--error-log-max-size": 1,
+		"--explain":            0,
+		"--fail-on-warning":    0,
+		"--full":               0,
+		"--help":               0,
+		"--json-compact":       0,
+		"--json-errors-only":   0,
+		"--json-pretty":        0,
+		"--label":              1,
+		"--local-time":         0,
+		"--log-stdin":          0,
+		"--mask-ids":           0,
+		"--max-value-width":    1,
+		"--no-clobber":         0,
+		"--no-color":           0,
+		"--no-update-check":    0,
+		"--non-interactive":    0,
+		"-i":                   0,
+		"--output":             1,
+		"--pointer":            1,
+		"--print-curl":         0,
+		"--profile":            1,
+		"-o":                   1,
+		"--quiet":              0,
+		"-q":                   0,
+		"--quiet-errors":       0,
+		"--raw-response":       0,
+		"--redact-output":      0,
+		"--retry-budget":       1,
+		"--slow-threshold":     1,
+		"--token":              1,
+		"-t":                   1,
+		"--trace-file":         1,
+		"--validate-responses": 0,
+		"--verbose":            0,
+		"-v":                   0,
 	}
 	var total int
 	for _, a := range args {
@@ -1,14 +1,68 @@
 package argparser
 
 var (
+	// FlagConcurrencyName is the flag name.
+	FlagConcurrencyName = "concurrency"
+	// FlagConcurrencyDesc is the flag description.
+	FlagConcurrencyDesc = "The number of concurrent requests of the bulk operation (defaults to a small number based on the CPUs available, capped by the API rate limit)"
+	// FlagCountOnlyName is the flag name.
+	FlagCountOnlyName = "count-only"
+	// FlagCountOnlyDesc is the flag description.
+	FlagCountOnlyDesc = "Print only the number of items (as {\"count\": N} with --json)"
+	// FlagCSVDesc is the description of the --csv flag.
+	FlagCSVDesc = "Render output as CSV"
 	// FlagCustomerIDName is the flag name.
 	FlagCustomerIDName = "customer-id"
 	// FlagCustomerIDDesc is the flag description.
 	FlagCustomerIDDesc = "Alphanumeric string identifying the customer (falls back to FASTLY_CUSTOMER_ID)"
+	// FlagDiffContextName is the flag name.
+	FlagDiffContextName = "diff-context"
+	// FlagDiffContextDesc is the flag description.
+	FlagDiffContextDesc = "Whether --show-changes displays only the changed fields or every field (changed-only, full)"
+	// FlagFieldFromFileName is the flag name.
+	FlagFieldFromFileName = "field-from-file"
+	// FlagFieldFromFileDesc is the flag description.
+	FlagFieldFromFileDesc = "Path to a JSON or YAML file mapping flag names to files whose contents are used as the flag value, e.g. {\"public-key\": \"./key.pem\"}"
+	// FlagFilterName is the flag name.
+	FlagFilterName = "filter"
+	// FlagFilterDesc is the flag description.
+	FlagFilterDesc = "Only list the items matching every comma-separated comparison (=, !=, >, < or ~ for a substring match), e.g. \"region=DFW,gzip_level>0\""
+	// FlagFromFileName is the flag name.
+	FlagFromFileName = "from-file"
+	// FlagFromFileDesc is the flag description.
+	FlagFromFileDesc = "Path to a JSON or YAML file of flag names and values to update. Only the fields present in the file are changed, e.g. {\"period\": 60}"
+	// FlagIgnoreErrorsName is the flag name.
+	FlagIgnoreErrorsName = "ignore-errors"
+	// FlagIgnoreErrorsDesc is the flag description.
+	FlagIgnoreErrorsDesc = "Exit successfully even if some items of the bulk operation failed (they are still reported)"
+	// FlagInputFormatName is the flag name.
+	FlagInputFormatName = "input-format"
+	// FlagInputFormatDesc is the flag description.
+	FlagInputFormatDesc = "The format of input files (json, yaml). Detected from the file extension or content if not set"
 	// FlagJSONName is the flag name.
 	FlagJSONName = "json"
 	// FlagJSONDesc is the flag description.
 	FlagJSONDesc = "Render output as JSON"
+	// FlagLimitRecordsName is the flag name.
+	FlagLimitRecordsName = "limit"
+	// FlagLimitRecordsDesc is the flag description.
+	FlagLimitRecordsDesc = "Maximum number of records to fetch across all pages (0 means no limit)"
+	// FlagNoAutopaginateName is the flag name.
+	FlagNoAutopaginateName = "no-autopaginate"
+	// FlagNoAutopaginateDesc is the flag description.
+	FlagNoAutopaginateDesc = "Fetch only the first page of results rather than every page"
+	// FlagOnErrorName is the flag name.
+	FlagOnErrorName = "on-error"
+	// FlagOnErrorDesc is the flag description.
+	FlagOnErrorDesc = "Whether the bulk operation continues past items that fail or aborts at the first failure (remaining items are skipped)"
+	// FlagOnlyIfChangedName is the flag name.
+	FlagOnlyIfChangedName = "only-if-changed"
+	// FlagOnlyIfChangedDesc is the flag description.
+	FlagOnlyIfChangedDesc = "Skip the update (and --autoclone) if the values provided match the existing values (reported as {\"changed\": false} with --json)"
+	// FlagPageSizeName is the flag name.
+	FlagPageSizeName = "page-size"
+	// FlagPageSizeDesc is the flag description.
+	FlagPageSizeDesc = "Number of records fetched per request (defaults to the API's default of 100, at most 1000)"
 	// FlagServiceIDName is the flag name.
 	FlagServiceIDName = "service-id"
 	// FlagServiceIDDesc is the flag description.
@@ -17,10 +71,20 @@ var (
 	FlagServiceName = "service-name"
 	// FlagServiceNameDesc is the flag description.
 	FlagServiceNameDesc = "The name of the service"
+	// FlagShowChangesName is the flag name.
+	FlagShowChangesName = "show-changes"
+	// FlagShowChangesDesc is the flag description.
+	FlagShowChangesDesc = "Display the fields changed by the update (as {\"changed\": {...}} with --json)"
+	// FlagTimeoutPerItemName is the flag name.
+	FlagTimeoutPerItemName = "timeout-per-item"
+	// FlagTimeoutPerItemDesc is the flag description.
+	FlagTimeoutPerItemDesc = "Maximum time to wait for each item of the bulk operation before recording it as failed, e.g. 30s (0 means no limit)"
 	// FlagVersionName is the flag name.
 	FlagVersionName = "version"
 	// FlagVersionDesc is the flag description.
-	FlagVersionDesc = "'latest', 'active', or the number of a specific Fastly service version"
+	FlagVersionDesc = "'latest', 'active', 'editable' (the most recent editable version, cloned from the active version with --autoclone if there isn't one), or the number of a specific Fastly service version"
+	// FlagYAMLDesc is the description of the --yaml flag.
+	FlagYAMLDesc = "Render output as YAML"
 )
 
 // PaginationDirection is a list of directions the page results can be displayed.
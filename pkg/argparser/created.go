@@ -0,0 +1,30 @@
+package argparser
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CreatedResource identifies a resource created by a create command, so
+// scripts can capture it (via --json) without listing the resources again.
+type CreatedResource struct {
+	// Type describes the kind of resource (e.g. "Cloudfiles logging endpoint").
+	Type string `json:"type"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// ServiceID is the ID of the service the resource was created on.
+	ServiceID string `json:"service_id"`
+	// ServiceVersion is the service version the resource was created on.
+	ServiceVersion int `json:"service_version"`
+}
+
+// WriteCreated renders the result of a create command: the resource as JSON
+// if --json is set, otherwise a "Created <type> '<name>' on version N" line.
+func WriteCreated(out io.Writer, j JSONOutput, r CreatedResource) error {
+	if ok, err := j.WriteOutput(out, r); ok {
+		return err
+	}
+	text.Success(out, "Created %s '%s' on version %d", r.Type, r.Name, r.ServiceVersion)
+	return nil
+}
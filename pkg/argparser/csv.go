@@ -0,0 +1,113 @@
+package argparser
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// WriteCSV writes value as CSV (quoted per RFC 4180): a header row and then a
+// row per element if value is a list (e.g. the output of a list command), or a
+// single row otherwise.
+//
+// The columns are the fields of the --json output. If the elements are
+// structs the columns follow the order of their fields (named by their JSON
+// tags), otherwise they're sorted. Nested values are written as JSON.
+func WriteCSV(out io.Writer, value any) error {
+	return writeCSV(out, value, jsonFieldNames(reflect.TypeOf(value)))
+}
+
+// writeCSV writes value as CSV with the given columns, or its keys (sorted) if
+// columns is nil.
+func writeCSV(out io.Writer, value any, columns []string) error {
+	doc, err := decodeJSONDocument(value)
+	if err != nil {
+		return err
+	}
+	items, ok := doc.([]any)
+	if !ok {
+		items = []any{doc}
+	}
+	records := make([]map[string]any, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return fmt.Errorf("CSV output requires objects (or a list of objects)")
+		}
+		records[i] = m
+	}
+
+	header := columns
+	if header == nil {
+		header = csvKeys(records)
+	}
+
+	w := csv.NewWriter(out)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	for _, record := range records {
+		row := make([]string, len(header))
+		for i, k := range header {
+			if row[i], err = csvCell(record[k]); err != nil {
+				return err
+			}
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// csvColumns returns the columns of the CSV output of a value of type t, i.e.
+// its JSON field names (see jsonFieldNames) limited to the selected fields, if
+// any.
+func csvColumns(t reflect.Type, fields []string) []string {
+	names := jsonFieldNames(t)
+	if names == nil || len(fields) == 0 {
+		return names
+	}
+	columns := make([]string, 0, len(fields))
+	for _, name := range names {
+		if slices.Contains(fields, name) {
+			columns = append(columns, name)
+		}
+	}
+	return columns
+}
+
+// csvKeys returns the keys of the records, sorted.
+func csvKeys(records []map[string]any) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, r := range records {
+		for k := range r {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// csvCell formats a value of the JSON document as a CSV cell.
+func csvCell(v any) (string, error) {
+	switch t := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return t, nil
+	case json.Number, bool:
+		return fmt.Sprint(t), nil
+	}
+	data, err := json.Marshal(v)
+	return string(data), err
+}
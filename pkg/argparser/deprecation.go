@@ -0,0 +1,86 @@
+package argparser
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/kingpin"
+
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Deprecation describes a deprecated flag or command and its migration path.
+type Deprecation struct {
+	// Replacement is the flag or command to use instead (e.g. "--foo").
+	Replacement string
+	// RemovalVersion is the CLI version the flag or command will be removed in.
+	RemovalVersion string
+}
+
+// Notice returns the standardized deprecation message for the subject.
+func (d Deprecation) Notice(subject string) string {
+	removal := "a future release"
+	if d.RemovalVersion != "" {
+		removal = d.RemovalVersion
+	}
+	msg := fmt.Sprintf("%s is deprecated and will be removed in %s.", subject, removal)
+	if d.Replacement != "" {
+		msg += fmt.Sprintf(" Use %s instead.", d.Replacement)
+	}
+	return msg
+}
+
+// Deprecate marks the command as deprecated so a notice is displayed whenever
+// the command is invoked.
+func (b Base) Deprecate(d Deprecation) {
+	b.CmdClause.Action(b.deprecationAction(fmt.Sprintf("The `fastly %s` command", b.CmdClause.FullCommand()), d))
+}
+
+// deprecationAction returns a kingpin.Action that records the deprecation
+// notice for the subject, to be displayed by DisplayDeprecations.
+//
+// NOTE: The notice isn't displayed by the action, as it runs before the
+// command's output mode (e.g. structured output via -j, --format or --pointer)
+// is known.
+func (b Base) deprecationAction(subject string, d Deprecation) kingpin.Action {
+	return func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		notice := d.Notice(subject)
+		if b.Globals == nil {
+			text.Warnings.Add(notice)
+			return nil
+		}
+		b.Globals.Deprecations = append(b.Globals.Deprecations, notice)
+		return nil
+	}
+}
+
+// DisplayDeprecations writes the recorded deprecation notices to w (i.e.
+// stderr, so they're never mixed with the command output).
+//
+// NOTE: The notices are always recorded in text.Warnings (so --fail-on-warning
+// is respected) but are only printed when the output isn't suppressed, e.g.
+// --quiet or structured output (which implies it).
+func DisplayDeprecations(w io.Writer, g *global.Data) {
+	for _, notice := range g.Deprecations {
+		if g.Flags.Quiet {
+			text.Warnings.Add(notice)
+			continue
+		}
+		text.Deprecated(w, "%s\n\n", notice)
+	}
+	g.Deprecations = nil
+}
+
+// DisplayWarnings writes the warnings recorded while the flags were parsed to
+// w (i.e. stderr), respecting the output mode as DisplayDeprecations does.
+func DisplayWarnings(w io.Writer, g *global.Data) {
+	for _, msg := range g.Warnings {
+		if g.Flags.Quiet {
+			text.Warnings.Add(msg)
+			continue
+		}
+		text.Warning(w, "%s\n\n", msg)
+	}
+	g.Warnings = nil
+}
@@ -0,0 +1,75 @@
+package argparser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// DescribePoolSize limits the number of concurrent API requests issued when
+// describing multiple named resources.
+const DescribePoolSize int = 10
+
+// DescribeMany concurrently fetches each of the named resources and writes
+// them to out, either as a JSON array (if enabled) or by calling display for
+// each resource in the order the names were given (display is expected to
+// separate its output, e.g. text.PrintLines starts with a line break).
+//
+// A failure to fetch one resource doesn't prevent the others from being
+// displayed. Instead the failures are reported together in the returned error.
+func DescribeMany[T any](out io.Writer, names []string, j JSONOutput, fetch func(name string) (T, error), display func(io.Writer, T) error) error {
+	var (
+		errs    = make([]error, len(names))
+		results = make([]T, len(names))
+		sem     = make(chan struct{}, DescribePoolSize)
+		wg      sync.WaitGroup
+	)
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			// Restrict resource allocation if concurrency limit is exceeded.
+			sem <- struct{}{}
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i], errs[i] = fetch(name)
+		}(i, name)
+	}
+	wg.Wait()
+
+	var (
+		failed    []string
+		succeeded []T
+	)
+	for i, name := range names {
+		if errs[i] != nil {
+			failed = append(failed, fmt.Sprintf("%s (%s)", name, errs[i]))
+			continue
+		}
+		succeeded = append(succeeded, results[i])
+	}
+
+	if ok, err := j.WriteOutput(out, succeeded); ok {
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, r := range succeeded {
+			if err := display(out, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to describe %d of %d resources: %s", len(failed), len(names), strings.Join(failed, ", ")),
+			Remediation: "The output only includes the resources that were described successfully. Check the failed names are correct and re-run the command for them.",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package argparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// SelectFields returns the JSON representation of value (i.e. maps, slices
+// and scalars) with only the named top-level fields of the object. If value is
+// a list (e.g. describing multiple resources) the fields of each element are
+// selected.
+//
+// The field names are those of the --json output, and a field that doesn't
+// exist is an error rather than being ignored. If value is a struct (or a list
+// of structs) the fields are checked against its type, so that an empty list
+// or a field omitted as it's empty isn't treated differently.
+func SelectFields(value any, fields []string) (any, error) {
+	doc, err := decodeJSONDocument(value)
+	if err != nil {
+		return nil, err
+	}
+	t := reflect.TypeOf(value)
+	known := jsonFieldNames(t)
+
+	if doc == nil && t != nil && t.Kind() == reflect.Slice {
+		doc = []any{}
+	}
+	if items, ok := doc.([]any); ok {
+		if len(items) == 0 && known != nil {
+			if err := validateFields(fields, known); err != nil {
+				return nil, err
+			}
+		}
+		for i, item := range items {
+			selected, err := selectObjectFields(item, fields, known)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = selected
+		}
+		return items, nil
+	}
+	return selectObjectFields(doc, fields, known)
+}
+
+// selectObjectFields returns a copy of the object doc with only the named
+// fields. The fields are checked against known, or the fields of doc if known
+// is nil.
+func selectObjectFields(doc any, fields, known []string) (map[string]any, error) {
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return nil, fsterr.RemediationError{
+			Inner:       fmt.Errorf("--field can't be used as the output isn't an object"),
+			Remediation: "Remove the --field flag.",
+		}
+	}
+	if known == nil {
+		known = make([]string, 0, len(obj))
+		for k := range obj {
+			known = append(known, k)
+		}
+	}
+	if err := validateFields(fields, known); err != nil {
+		return nil, err
+	}
+	selected := make(map[string]any, len(fields))
+	for _, f := range fields {
+		if v, ok := obj[f]; ok {
+			selected[f] = v
+		}
+	}
+	return selected, nil
+}
+
+// validateFields checks each of the fields is one of the known fields.
+func validateFields(fields, known []string) error {
+	for _, f := range fields {
+		if slices.Contains(known, f) {
+			continue
+		}
+		names := slices.Clone(known)
+		sort.Strings(names)
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("unknown field %q", f),
+			Remediation: fmt.Sprintf("Use one of the fields of the --json output: %s.", strings.Join(names, ", ")),
+		}
+	}
+	return nil
+}
+
+// decodeJSONDocument converts value into the generic representation of its
+// JSON encoding, like jsonDocument, but with numbers decoded as json.Number so
+// they're written exactly as in the --json output.
+func decodeJSONDocument(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// jsonMarshalerType is the type of the json.Marshaler interface.
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// jsonFieldNames returns the JSON names of the fields of t (or its elements, if
+// t is a slice), or nil if they aren't structs (or are encoded by their own
+// MarshalJSON method).
+func jsonFieldNames(t reflect.Type) []string {
+	for t != nil && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return nil
+	}
+	var names []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		// NOTE: The fields of an embedded struct (even if it's unexported) are
+		// promoted in the JSON.
+		if name == "" && f.Anonymous {
+			names = append(names, jsonFieldNames(f.Type)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,274 @@
+package argparser
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// ListFilter is a helper for adding a `--filter` flag to list commands.
+// It can be embedded into command structs.
+type ListFilter struct {
+	Filter string // Set via flag.
+}
+
+// FilterFlag creates a flag for filtering the listed items.
+func (f *ListFilter) FilterFlag() StringFlagOpts {
+	return StringFlagOpts{
+		Name:        FlagFilterName,
+		Description: FlagFilterDesc,
+		Dst:         &f.Filter,
+	}
+}
+
+// filterOperators are the supported comparisons. The two character operators
+// come first so that `!=` isn't parsed as `!` followed by `=`.
+var filterOperators = []string{"!=", "=", ">", "<", "~"}
+
+// filterCondition is a single comparison of a --filter expression.
+type filterCondition struct {
+	field    string
+	index    []int
+	operator string
+	value    string
+}
+
+// ApplyFilter returns the records matching every comparison of the --filter
+// expression (or all of them if the flag isn't set).
+//
+// The fields are referenced by their API name (e.g. gzip_level), or their Go
+// field name (e.g. GzipLevel), and only fields holding a string, number,
+// boolean or time can be compared. The expression is validated against the
+// record type, so an invalid expression is reported even if there are no
+// records.
+func ApplyFilter[T any](f ListFilter, records []T) ([]T, error) {
+	if f.Filter == "" {
+		return records, nil
+	}
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("--%s isn't supported for %s", FlagFilterName, t)
+	}
+
+	conditions, err := parseFilter(f.Filter, t)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]T, 0, len(records))
+	for _, r := range records {
+		v := reflect.ValueOf(r)
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				break
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		if matchFilter(conditions, v) {
+			matched = append(matched, r)
+		}
+	}
+	return matched, nil
+}
+
+// parseFilter parses the comma-separated comparisons of a --filter expression,
+// resolving each field against the struct type t.
+func parseFilter(expr string, t reflect.Type) ([]filterCondition, error) {
+	fields, names := filterFields(t)
+
+	var conditions []filterCondition
+	for _, clause := range strings.Split(expr, ",") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+
+		var c filterCondition
+		for i := range clause {
+			for _, op := range filterOperators {
+				if strings.HasPrefix(clause[i:], op) {
+					c.field, c.operator, c.value = strings.TrimSpace(clause[:i]), op, strings.TrimSpace(clause[i+len(op):])
+					break
+				}
+			}
+			if c.operator != "" {
+				break
+			}
+		}
+		if c.operator == "" || c.field == "" {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("invalid --%s comparison '%s'", FlagFilterName, clause),
+				Remediation: "Each comparison must be a field, an operator (=, !=, >, < or ~) and a value, e.g. region=DFW.",
+			}
+		}
+
+		field, ok := fields[strings.ToLower(c.field)]
+		if !ok {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("invalid --%s field '%s'", FlagFilterName, c.field),
+				Remediation: fmt.Sprintf("The fields that can be filtered on are: %s.", strings.Join(names, ", ")),
+			}
+		}
+		c.index = field.Index
+
+		if err := validateFilterValue(c, field.Type); err != nil {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("invalid --%s comparison '%s': %w", FlagFilterName, clause, err),
+				Remediation: "Numbers are compared numerically, times must be in RFC 3339 format (e.g. 2024-01-02T15:04:05Z) and only strings can be compared with ~.",
+			}
+		}
+		conditions = append(conditions, c)
+	}
+	return conditions, nil
+}
+
+// filterFields indexes the fields of the struct type t that can be compared,
+// by both their (lowercased) API name and Go field name. The sorted API names
+// are also returned for use in error messages.
+func filterFields(t reflect.Type) (map[string]reflect.StructField, []string) {
+	fields := make(map[string]reflect.StructField)
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || filterKind(f.Type) == "" {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+		if name == "" || name == "-" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f
+		fields[strings.ToLower(f.Name)] = f
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fields, names
+}
+
+// filterKind describes how a field of type t is compared ("" if it can't be).
+func filterKind(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return "time"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return ""
+}
+
+// validateFilterValue checks the comparison is valid for the field type.
+func validateFilterValue(c filterCondition, t reflect.Type) error {
+	kind := filterKind(t)
+	if c.operator == "~" && kind != "string" {
+		return fmt.Errorf("the ~ operator requires a string field")
+	}
+	switch kind {
+	case "number":
+		if _, err := strconv.ParseFloat(c.value, 64); err != nil {
+			return fmt.Errorf("'%s' isn't a number", c.value)
+		}
+	case "bool":
+		if _, err := strconv.ParseBool(c.value); err != nil {
+			return fmt.Errorf("'%s' isn't a boolean", c.value)
+		}
+		if c.operator != "=" && c.operator != "!=" {
+			return fmt.Errorf("booleans can only be compared with = or !=")
+		}
+	case "time":
+		if _, err := time.Parse(time.RFC3339, c.value); err != nil {
+			return fmt.Errorf("'%s' isn't an RFC 3339 time", c.value)
+		}
+	}
+	return nil
+}
+
+// matchFilter reports whether the struct value v matches every condition.
+func matchFilter(conditions []filterCondition, v reflect.Value) bool {
+	for _, c := range conditions {
+		fv := v.FieldByIndex(c.index)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				// An unset field is treated as empty, so it can only be
+				// matched by an equality comparison.
+				if !(c.operator == "=" && c.value == "") && !(c.operator == "!=" && c.value != "") {
+					return false
+				}
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if !compareFilterValue(c, fv) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareFilterValue compares the field value fv with the condition value
+// (which was validated by validateFilterValue).
+func compareFilterValue(c filterCondition, fv reflect.Value) bool {
+	var result int
+	switch filterKind(fv.Type()) {
+	case "time":
+		want, _ := time.Parse(time.RFC3339, c.value)
+		result = fv.Interface().(time.Time).Compare(want)
+	case "number":
+		want, _ := strconv.ParseFloat(c.value, 64)
+		var got float64
+		switch {
+		case fv.CanInt():
+			got = float64(fv.Int())
+		case fv.CanUint():
+			got = float64(fv.Uint())
+		default:
+			got = fv.Float()
+		}
+		result = cmp.Compare(got, want)
+	case "bool":
+		want, _ := strconv.ParseBool(c.value)
+		result = 1
+		if fv.Bool() == want {
+			result = 0
+		}
+	default:
+		if c.operator == "~" {
+			return strings.Contains(fv.String(), c.value)
+		}
+		result = strings.Compare(fv.String(), c.value)
+	}
+
+	switch c.operator {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case "<":
+		return result < 0
+	}
+	return false
+}
@@ -7,10 +7,12 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/fastly/kingpin"
@@ -18,7 +20,11 @@ import (
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/env"
 	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/filesystem"
+	"github.com/fastly/cli/pkg/internal/term"
+	"github.com/fastly/cli/pkg/sync"
 	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/versionlock"
 )
 
 var (
@@ -29,6 +35,7 @@ var (
 // StringFlagOpts enables easy configuration of a flag.
 type StringFlagOpts struct {
 	Action      kingpin.Action
+	Deprecated  *Deprecation
 	Description string
 	Dst         *string
 	Name        string
@@ -42,12 +49,21 @@ func (b Base) RegisterFlag(opts StringFlagOpts) {
 	if opts.Short > 0 {
 		clause = clause.Short(opts.Short)
 	}
-	if opts.Required {
+	// NOTE: A required --version flag can be omitted when the .fastly-version
+	// lockfile provides the version instead, so it's checked once the command
+	// is selected (see requireVersion) rather than when it's registered.
+	switch {
+	case opts.Required && opts.Name == FlagVersionName:
+		b.CmdClause.Action(requireVersion(opts.Dst))
+	case opts.Required:
 		clause = clause.Required()
 	}
 	if opts.Action != nil {
 		clause = clause.Action(opts.Action)
 	}
+	if opts.Deprecated != nil {
+		clause = clause.Action(b.deprecationAction(fmt.Sprintf("The --%s flag", opts.Name), *opts.Deprecated))
+	}
 	clause.StringVar(opts.Dst)
 }
 
@@ -107,21 +123,96 @@ func (b Base) RegisterFlagInt(opts IntFlagOpts) {
 	clause.IntVar(opts.Dst)
 }
 
+// versionFromLockfile is the value of a required --version flag that was
+// omitted as there's a lockfile, which must then provide the version (see
+// OptionalServiceVersion.readLockfile).
+const versionFromLockfile = "(" + versionlock.FileName + ")"
+
+// requireVersion returns a kingpin.Action that errors if the required
+// --version flag wasn't set and there's no lockfile to provide the version.
+//
+// NOTE: The lockfile is only read when the command is executed, as the
+// version it pins is only used for the service it was written for.
+func requireVersion(dst *string) kingpin.Action {
+	return func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		if *dst != "" {
+			return nil
+		}
+		if !versionlock.Exists(versionlock.Path) {
+			return fmt.Errorf("required flag --%s not provided", FlagVersionName)
+		}
+		*dst = versionFromLockfile
+		return nil
+	}
+}
+
 // OptionalServiceVersion represents a Fastly service version.
 type OptionalServiceVersion struct {
 	OptionalString
 }
 
-// Parse returns a service version based on the given user input.
+// Parse returns a service version based on the given user input, or the
+// .fastly-version lockfile (see readLockfile).
 func (sv *OptionalServiceVersion) Parse(sid string, client api.Interface) (*fastly.Version, error) {
-	vs, err := client.ListVersions(&fastly.ListVersionsInput{
+	if _, err := sv.readLockfile(sid); err != nil {
+		return nil, err
+	}
+	v, _, _, err := sv.resolve(sid, client)
+	return v, err
+}
+
+// readLockfile sets the version pinned by the .fastly-version lockfile, if the
+// --version flag wasn't set and the lockfile was written for the service sid.
+// It reports whether the lockfile provided the version.
+//
+// NOTE: A required --version flag could only be omitted because of the
+// lockfile (see requireVersion), so it's an error if the lockfile doesn't
+// provide the version, rather than the version being silently defaulted.
+// Otherwise a lockfile for another service is ignored.
+func (sv *OptionalServiceVersion) readLockfile(sid string) (bool, error) {
+	required := sv.Value == versionFromLockfile
+	if sv.Value != "" && !required {
+		return false, nil
+	}
+	sv.Value = ""
+
+	lock, ok, err := versionlock.Read(versionlock.Path)
+	if err != nil {
+		return false, fsterr.RemediationError{
+			Inner:       err,
+			Remediation: fmt.Sprintf("Fix (or delete) the %s file, or use the --%s flag.", versionlock.FileName, FlagVersionName),
+		}
+	}
+	switch {
+	case ok && lock.ServiceID == sid:
+		sv.Value = strconv.Itoa(lock.Version)
+		return true, nil
+	case required && ok:
+		return false, fsterr.RemediationError{
+			Inner:       fmt.Errorf("no service version provided: the %s file pins a version of service %s, not %s", versionlock.FileName, lock.ServiceID, sid),
+			Remediation: fmt.Sprintf("Use the --%s flag, or pin a version of service %s with `fastly service-version pin`.", FlagVersionName, sid),
+		}
+	case required:
+		return false, fsterr.RemediationError{
+			Inner:       fmt.Errorf("no service version provided: the %s file was removed", versionlock.FileName),
+			Remediation: fmt.Sprintf("Use the --%s flag, or pin a version with `fastly service-version pin`.", FlagVersionName),
+		}
+	}
+	return false, nil
+}
+
+// resolve returns a service version based on the given user input, along with
+// the versions (sorted into descending order) it was chosen from and a
+// description of how it was chosen.
+func (sv *OptionalServiceVersion) resolve(sid string, client api.Interface) (v *fastly.Version, vs []*fastly.Version, rule string, err error) {
+	vs, err = client.ListVersions(&fastly.ListVersionsInput{
 		ServiceID: sid,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("error listing service versions: %w", err)
+		return nil, nil, "", fmt.Errorf("error listing service versions: %w", err)
 	}
 	if len(vs) == 0 {
-		return nil, errors.New("error listing service versions: no versions available")
+		return nil, nil, "", errors.New("error listing service versions: no versions available")
 	}
 
 	// Sort versions into descending order.
@@ -129,26 +220,38 @@ func (sv *OptionalServiceVersion) Parse(sid string, client api.Interface) (*fast
 		return fastly.ToValue(vs[i].Number) > fastly.ToValue(vs[j].Number)
 	})
 
-	var v *fastly.Version
-
 	switch strings.ToLower(sv.Value) {
 	case "latest":
-		return vs[0], nil
+		return vs[0], vs, "latest version", nil
 	case "active":
+		rule = "active version"
+		v, err = GetActiveVersion(vs)
+	case "editable":
+		if v := GetEditableVersion(vs); v != nil {
+			return v, vs, "editable version", nil
+		}
+		// NOTE: The active (or latest) version is returned so it can be cloned
+		// (see ResolveServiceDetails).
 		v, err = GetActiveVersion(vs)
+		if err != nil {
+			return vs[0], vs, "latest version (no version is editable)", nil //lint:ignore nilerr if no active version, return latest version
+		}
+		rule = "active version (no version is editable)"
 	case "": // no --version flag provided
 		v, err = GetActiveVersion(vs)
 		if err != nil {
-			return vs[0], nil //lint:ignore nilerr if no active version, return latest version
+			return vs[0], vs, "latest version (no version is active)", nil //lint:ignore nilerr if no active version, return latest version
 		}
+		rule = "active version"
 	default:
+		rule = "specified version"
 		v, err = GetSpecifiedVersion(vs, sv.Value)
 	}
 	if err != nil {
-		return nil, err
+		return nil, vs, rule, err
 	}
 
-	return v, nil
+	return v, vs, rule, nil
 }
 
 // OptionalServiceNameID represents a mapping between a Fastly service name and
@@ -187,12 +290,13 @@ type OptionalCustomerID struct {
 // NOTE: Will fallback to FASTLY_CUSTOMER_ID environment variable if no flag value set.
 func (sv *OptionalCustomerID) Parse() error {
 	if sv.Value == "" {
-		if e := os.Getenv(env.CustomerID); e != "" {
-			sv.Value = e
-			return nil
+		e := os.Getenv(env.CustomerID)
+		if e == "" {
+			return fsterr.ErrNoCustomerID
 		}
-		return fsterr.ErrNoCustomerID
+		sv.Value = e
 	}
+	text.RegisterID(text.IDKindCustomer, sv.Value) // --mask-ids
 	return nil
 }
 
@@ -257,6 +361,31 @@ func GetActiveVersion(vs []*fastly.Version) (*fastly.Version, error) {
 	return nil, fmt.Errorf("no active service version found")
 }
 
+// GetEditableVersion returns the most recent draft service version, i.e. one
+// that can be modified (see IsEditableVersion) and is newer than the active
+// version, or nil if there isn't one. The versions must be sorted into
+// descending order.
+//
+// NOTE: A draft older than the active version isn't returned, as changes to it
+// would be based on an outdated configuration.
+func GetEditableVersion(vs []*fastly.Version) *fastly.Version {
+	for _, v := range vs {
+		if fastly.ToValue(v.Active) {
+			return nil
+		}
+		if IsEditableVersion(v) {
+			return v
+		}
+	}
+	return nil
+}
+
+// IsEditableVersion indicates if the service version can be modified, i.e.
+// it's neither active, locked (see OptionalAutoClone) nor deleted.
+func IsEditableVersion(v *fastly.Version) bool {
+	return !fastly.ToValue(v.Active) && !fastly.ToValue(v.Locked) && v.DeletedAt == nil
+}
+
 // GetSpecifiedVersion returns the specified service version.
 func GetSpecifiedVersion(vs []*fastly.Version, version string) (*fastly.Version, error) {
 	i, err := strconv.Atoi(version)
@@ -287,6 +416,77 @@ func Content(flagval string) string {
 	return content
 }
 
+// FieldsFromFile reads a JSON or YAML mapping of flag names to file paths and
+// populates each mapped flag with the contents of its file. Relative paths are
+// resolved against the directory containing the mapping file.
+//
+// The format is detected (see DetectInputFormat) unless one of InputFormats is
+// given.
+//
+// EXAMPLE: {"public-key": "./key.pem", "access-key": "./access_key.txt"}
+//
+// NOTE: A flag can't be provided both directly and via the mapping file.
+func FieldsFromFile(path, format string, fields map[string]*OptionalString) error {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we require a user to configure their own environment.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading --%s mapping file: %w", FlagFieldFromFileName, err)
+	}
+
+	var mapping map[string]string
+	if err := DecodeInput(path, data, format, &mapping); err != nil {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid --%s mapping file: %w", FlagFieldFromFileName, err),
+			Remediation: `The mapping file should be a JSON (or YAML) object of flag names to file paths, e.g. {"public-key": "./key.pem"}.`,
+		}
+	}
+
+	supported := make([]string, 0, len(fields))
+	for name := range fields {
+		supported = append(supported, name)
+	}
+	sort.Strings(supported)
+
+	names := make([]string, 0, len(mapping))
+	for name := range mapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	dir := filepath.Dir(path)
+	for _, name := range names {
+		flag, ok := fields[strings.TrimPrefix(name, "--")]
+		if !ok {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("unsupported flag '%s' in --%s mapping file '%s'", name, FlagFieldFromFileName, path),
+				Remediation: fmt.Sprintf("Supported flags: %s.", strings.Join(supported, ", ")),
+			}
+		}
+		if flag.WasSet {
+			return fmt.Errorf("flag --%s was provided both directly and via --%s", strings.TrimPrefix(name, "--"), FlagFieldFromFileName)
+		}
+
+		fp := mapping[name]
+		if !filepath.IsAbs(fp) {
+			fp = filepath.Join(dir, fp)
+		}
+		// gosec flagged this:
+		// G304 (CWE-22): Potential file inclusion via variable
+		// Disabling as we require a user to configure their own environment.
+		/* #nosec */
+		content, err := os.ReadFile(fp)
+		if err != nil {
+			return fmt.Errorf("error reading file for --%s: %w", strings.TrimPrefix(name, "--"), err)
+		}
+		flag.Value = strings.TrimRight(string(content), "\r\n")
+		flag.WasSet = true
+	}
+	return nil
+}
+
 // IntToBool converts a binary 0|1 to a boolean.
 func IntToBool(i int) bool {
 	return i > 0
@@ -326,8 +526,67 @@ func IsCompletion(args []string) bool {
 
 // JSONOutput is a helper for adding a `--json` flag and encoding
 // values to JSON. It can be embedded into command structs.
+//
+// Commands can instead support other structured formats via the `--format`
+// flag (see RegisterFormatFlags), in which case Enabled indicates a format was
+// selected and Format is its name (FormatTemplate for --template-file).
 type JSONOutput struct {
-	Enabled bool // Set via flag.
+	Enabled bool      // Set via flag.
+	Fields  []string  // Set via the --field flag.
+	Format  string    // Set via the --format flag (empty means JSON).
+	Output  string    // Set via the global --output flag.
+	Pointer string    // Set via the global --pointer flag.
+	Redact  bool      // Set via the global --redact-output flag.
+	Style   JSONStyle // Set via the global --json-compact/--json-pretty flags.
+
+	columns      []string           // The CSV columns of the value passed to WriteOutput.
+	csv          bool               // Set via the --csv flag.
+	template     *template.Template // Parsed from the --template-file flag.
+	templateFile string             // Set via the --template-file flag.
+	yaml         bool               // Set via the --yaml flag.
+}
+
+// JSONStyle controls how WriteOutput formats its output.
+type JSONStyle int
+
+const (
+	// JSONStyleAuto uses compact output when piped, otherwise pretty output.
+	JSONStyleAuto JSONStyle = iota
+	// JSONStyleCompact writes single-line JSON (e.g. for jq).
+	JSONStyleCompact
+	// JSONStylePretty writes indented JSON.
+	JSONStylePretty
+)
+
+// SetJSONStyle sets the formatting used by WriteOutput.
+func (j *JSONOutput) SetJSONStyle(style JSONStyle) {
+	j.Style = style
+}
+
+// SetJSONPointer sets the RFC 6901 JSON Pointer of the single value
+// WriteOutput should output. It also enables JSON output.
+func (j *JSONOutput) SetJSONPointer(pointer string) {
+	j.Enabled = true
+	j.Pointer = pointer
+}
+
+// SetOutputFile sets the path of the file WriteOutput writes to (instead of
+// its out). It also enables JSON output, unless another format was selected.
+func (j *JSONOutput) SetOutputFile(path string) {
+	j.Enabled = true
+	j.Output = path
+}
+
+// SetRedactOutput makes WriteOutput replace the values of sensitive fields
+// (see text.SensitiveFields) with api.Redacted.
+func (j *JSONOutput) SetRedactOutput() {
+	j.Redact = true
+}
+
+// StructuredOutput reports whether the output is structured, i.e. --json (or
+// another format) was selected.
+func (j *JSONOutput) StructuredOutput() bool {
+	return j.Enabled
 }
 
 // JSONFlag creates a flag for enabling JSON output.
@@ -340,14 +599,148 @@ func (j *JSONOutput) JSONFlag() BoolFlagOpts {
 	}
 }
 
-// WriteJSON checks whether the enabled flag is set or not. If set,
-// then the given value is written as JSON to out. Otherwise, false is returned.
-func (j *JSONOutput) WriteJSON(out io.Writer, value any) (bool, error) {
+// WriteOutput checks whether structured output is enabled (via --json, or
+// another format). If so, the given value is written to out as JSON, unless
+// another Format was selected. Otherwise, false is returned.
+//
+// The JSON is indented unless the Style is compact, or the Style is auto and
+// out is being piped (i.e. it's a file that isn't a terminal).
+//
+// If a Pointer is set only the value it refers to is written (see
+// WriteJSONPointer).
+//
+// If Fields are set only those fields of the value are written (see
+// SelectFields).
+//
+// If Redact is set the values of sensitive fields are replaced (see
+// SetRedactOutput).
+//
+// If an Output file is set the value is written to it (see SetOutputFile)
+// rather than to out.
+//
+// If a Format other than JSON was selected, the value is written using the
+// Formatter registered for it instead.
+func (j *JSONOutput) WriteOutput(out io.Writer, value any) (bool, error) {
 	if !j.Enabled {
 		return false, nil
 	}
+	// NOTE: The CSV columns follow the order of the fields of value's type,
+	// which is lost once the fields are selected or redacted.
+	j.columns = nil
+	if j.Format == FormatCSV && j.Pointer == "" {
+		j.columns = csvColumns(reflect.TypeOf(value), j.Fields)
+	}
+	if len(j.Fields) > 0 {
+		v, err := SelectFields(value, j.Fields)
+		if err != nil {
+			return true, err
+		}
+		value = v
+	}
+	if j.Redact {
+		doc, err := decodeJSONDocument(value)
+		if err != nil {
+			return true, err
+		}
+		value = api.Redact(doc)
+	}
+	if j.Output != "" {
+		return true, j.writeOutputFile(value)
+	}
+	return true, j.writeValue(out, value)
+}
 
+// writeValue writes value in the selected format.
+func (j *JSONOutput) writeValue(out io.Writer, value any) error {
+	if j.Format != "" && j.Format != FormatJSON {
+		return j.writeFormat(out, value)
+	}
+	if j.Pointer != "" {
+		return j.WriteJSONPointer(out, value)
+	}
+	return j.encodeJSON(out, value)
+}
+
+// OutputFilePermissions is the file mode of the file written via --output, as
+// the output can contain secrets (e.g. access keys).
+const OutputFilePermissions = 0o600
+
+// writeOutputFile writes value to the Output file, replacing any existing
+// content.
+func (j *JSONOutput) writeOutputFile(value any) error {
+	f, err := filesystem.CreateFile(j.Output, OutputFilePermissions)
+	if err != nil {
+		return fmt.Errorf("error writing output file: %w", err)
+	}
+	// NOTE: The mode of an existing file isn't changed by OpenFile.
+	if err := f.Chmod(OutputFilePermissions); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("error writing output file: %w", err)
+	}
+	if err := j.writeValue(f, value); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing output file: %w", err)
+	}
+	return nil
+}
+
+// encodeJSON writes value as JSON formatted according to the Style.
+func (j *JSONOutput) encodeJSON(out io.Writer, value any) error {
 	enc := json.NewEncoder(out)
-	enc.SetIndent("", "  ")
-	return true, enc.Encode(value)
+	switch j.Style {
+	case JSONStyleCompact:
+	case JSONStylePretty:
+		enc.SetIndent("", "  ")
+	default:
+		if !isPiped(out) {
+			enc.SetIndent("", "  ")
+		}
+	}
+	return enc.Encode(value)
+}
+
+// isPiped indicates if out is a file (e.g. STDOUT) that isn't a terminal.
+func isPiped(out io.Writer) bool {
+	if s, ok := out.(*sync.Writer); ok {
+		out = s.W
+	}
+	_, ok := out.(*os.File)
+	return ok && !term.IsTerminal(out)
+}
+
+// CountOutput is a helper for adding a `--count-only` flag to list commands.
+// It can be embedded into command structs.
+type CountOutput struct {
+	CountOnly bool // Set via flag.
+}
+
+// ItemCount is the JSON representation of the --count-only output.
+type ItemCount struct {
+	Count int `json:"count"`
+}
+
+// CountFlag creates a flag for only printing the number of listed items.
+func (c *CountOutput) CountFlag() BoolFlagOpts {
+	return BoolFlagOpts{
+		Name:        FlagCountOnlyName,
+		Description: FlagCountOnlyDesc,
+		Dst:         &c.CountOnly,
+	}
+}
+
+// WriteCount checks whether the count-only flag is set or not. If set, then
+// the given count is written to out (as JSON if j is enabled). Otherwise,
+// false is returned.
+func (c *CountOutput) WriteCount(out io.Writer, count int, j JSONOutput) (bool, error) {
+	if !c.CountOnly {
+		return false, nil
+	}
+	if ok, err := j.WriteOutput(out, ItemCount{Count: count}); ok {
+		return true, err
+	}
+	_, err := fmt.Fprintln(out, count)
+	return true, err
 }
@@ -2,20 +2,35 @@ package argparser_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/fastly/kingpin"
 
+	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/env"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/versionlock"
 )
 
 func TestOptionalServiceVersionParse(t *testing.T) {
@@ -330,6 +345,7 @@ func TestServiceID(t *testing.T) {
 	cases := map[string]struct {
 		ServiceName   argparser.OptionalServiceNameID
 		Data          manifest.Data
+		Env           string
 		API           mock.API
 		WantServiceID string
 		WantError     string
@@ -351,6 +367,54 @@ func TestServiceID(t *testing.T) {
 			WantServiceID: "456",
 			WantSource:    manifest.SourceFile,
 		},
+		"service ID in environment": {
+			Env:           "789",
+			WantServiceID: "789",
+			WantSource:    manifest.SourceEnv,
+		},
+		"service-id flag overrides environment and manifest": {
+			Data: manifest.Data{
+				Flag: manifest.Flag{ServiceID: "456"},
+				File: manifest.File{ServiceID: "123"},
+			},
+			Env:           "789",
+			WantServiceID: "456",
+			WantSource:    manifest.SourceFlag,
+			WantFlag:      argparser.FlagServiceIDName,
+		},
+		"environment overrides manifest": {
+			Data: manifest.Data{
+				File: manifest.File{ServiceID: "123"},
+			},
+			Env:           "789",
+			WantServiceID: "789",
+			WantSource:    manifest.SourceEnv,
+		},
+		"empty service-id flag falls back to environment": {
+			Data: manifest.Data{
+				Flag: manifest.Flag{ServiceID: " "},
+				File: manifest.File{ServiceID: "123"},
+			},
+			Env:           "789",
+			WantServiceID: "789",
+			WantSource:    manifest.SourceEnv,
+		},
+		"empty environment falls back to manifest": {
+			Data: manifest.Data{
+				File: manifest.File{ServiceID: "123"},
+			},
+			Env:           " ",
+			WantServiceID: "123",
+			WantSource:    manifest.SourceFile,
+		},
+		"empty values in every source": {
+			Data: manifest.Data{
+				Flag: manifest.Flag{ServiceID: " "},
+				File: manifest.File{ServiceID: ""},
+			},
+			Env:       " ",
+			WantError: "error reading service: no service ID found",
+		},
 		"service-name flag with service-id flag": {
 			ServiceName: argparser.OptionalServiceNameID{argparser.OptionalString{Optional: argparser.Optional{WasSet: true}, Value: "bar"}},
 			Data: manifest.Data{
@@ -404,6 +468,7 @@ func TestServiceID(t *testing.T) {
 
 	for name, c := range cases {
 		t.Run(name, func(t *testing.T) {
+			t.Setenv(env.ServiceID, c.Env)
 			serviceID, source, flag, err := argparser.ServiceID(c.ServiceName, c.Data, c.API, nil)
 			testutil.AssertErrorContains(t, err, c.WantError)
 			if err == nil {
@@ -415,6 +480,411 @@ func TestServiceID(t *testing.T) {
 	}
 }
 
+func TestDisplayServiceIDOverrides(t *testing.T) {
+	t.Setenv(env.ServiceID, "789")
+
+	data := manifest.Data{
+		Flag: manifest.Flag{ServiceID: "456"},
+		File: manifest.File{ServiceID: "123"},
+	}
+	var buf bytes.Buffer
+	argparser.DisplayServiceIDOverrides("456", "--service-id", manifest.SourceFlag, data, &buf)
+	testutil.AssertStringContains(t, buf.String(), "Ignoring Service ID 789 (via FASTLY_SERVICE_ID) as --service-id takes precedence.")
+	testutil.AssertStringContains(t, buf.String(), "Ignoring Service ID 123 (via fastly.toml) as --service-id takes precedence.")
+
+	// Matching values aren't reported as being overridden.
+	buf.Reset()
+	data.Flag.ServiceID = ""
+	data.File.ServiceID = "789"
+	argparser.DisplayServiceIDOverrides("789", "--service-id", manifest.SourceEnv, data, &buf)
+	testutil.AssertString(t, "", buf.String())
+}
+
+func TestDescribeMany(t *testing.T) {
+	fetch := func(name string) (map[string]string, error) {
+		if name == "missing" {
+			return nil, fmt.Errorf("not found")
+		}
+		return map[string]string{"name": name}, nil
+	}
+	display := func(out io.Writer, v map[string]string) error {
+		_, err := fmt.Fprintf(out, "Name: %s\n", v["name"])
+		return err
+	}
+
+	var buf bytes.Buffer
+	err := argparser.DescribeMany(&buf, []string{"a", "missing", "b"}, argparser.JSONOutput{}, fetch, display)
+	testutil.AssertErrorContains(t, err, "failed to describe 1 of 3 resources: missing (not found)")
+	testutil.AssertString(t, "Name: a\nName: b\n", buf.String())
+
+	buf.Reset()
+	j := argparser.JSONOutput{Enabled: true, Style: argparser.JSONStyleCompact}
+	err = argparser.DescribeMany(&buf, []string{"a", "b"}, j, fetch, display)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, `[{"name":"a"},{"name":"b"}]`+"\n", buf.String())
+}
+
+func TestWatch(t *testing.T) {
+	argparser.WatchNow = func() time.Time {
+		return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+	defer func() {
+		argparser.WatchNow = time.Now
+	}()
+
+	// Without --watch the output is rendered once.
+	var buf bytes.Buffer
+	w := argparser.WatchOutput{}
+	err := w.Watch(context.Background(), &buf, nil, func(out io.Writer) error {
+		_, err := fmt.Fprintln(out, "rendered")
+		return err
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "rendered\n", buf.String())
+
+	// With --watch the output is re-rendered until the context is cancelled.
+	buf.Reset()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var renders int
+	w = argparser.WatchOutput{Enabled: true, Interval: time.Millisecond}
+	err = w.Watch(ctx, &buf, nil, func(out io.Writer) error {
+		renders++
+		if renders == 2 {
+			cancel()
+		}
+		_, err := fmt.Fprintln(out, "rendered")
+		return err
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, strings.Repeat("--- 2024-01-02T03:04:05Z ---\nrendered\n", 2), buf.String())
+
+	// With --json each render is written as a line of JSON (i.e. NDJSON),
+	// and with --yaml as a YAML document.
+	for _, testcase := range []struct {
+		format string
+		want   string
+	}{
+		{format: argparser.FormatJSON, want: strings.Repeat(`{"name":"example"}`+"\n", 2)},
+		{format: argparser.FormatYAML, want: strings.Repeat("---\nname: example\n", 2)},
+	} {
+		buf.Reset()
+		ctx, cancel := context.WithCancel(context.Background())
+		j := argparser.JSONOutput{Enabled: true, Format: testcase.format, Style: argparser.JSONStylePretty}
+		renders = 0
+		err = w.Watch(ctx, &buf, &j, func(out io.Writer) error {
+			renders++
+			if renders == 2 {
+				cancel()
+			}
+			_, err := j.WriteOutput(out, map[string]string{"name": "example"})
+			return err
+		})
+		cancel()
+		testutil.AssertNoError(t, err)
+		testutil.AssertString(t, testcase.want, buf.String())
+	}
+
+	// An invalid interval is rejected.
+	w = argparser.WatchOutput{Enabled: true}
+	err = w.Watch(context.Background(), &buf, nil, func(_ io.Writer) error { return nil })
+	testutil.AssertErrorContains(t, err, "invalid --interval value")
+}
+
+func TestFieldsFromFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "key.pem"), []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "user.txt"), []byte("alice"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		File          string
+		Format        string
+		Mapping       string
+		User          argparser.OptionalString
+		WantError     string
+		WantPublicKey string
+		WantUser      string
+	}{
+		"valid mapping": {
+			Mapping:       `{"public-key": "key.pem", "user": "user.txt"}`,
+			WantPublicKey: "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+			WantUser:      "alice",
+		},
+		"missing referenced file": {
+			Mapping:   `{"public-key": "missing.pem"}`,
+			WantError: "error reading file for --public-key",
+		},
+		"unsupported flag": {
+			Mapping:   `{"region": "key.pem"}`,
+			WantError: "unsupported flag 'region'",
+		},
+		"flag also set directly": {
+			Mapping:   `{"user": "user.txt"}`,
+			User:      argparser.OptionalString{Optional: argparser.Optional{WasSet: true}, Value: "bob"},
+			WantError: "flag --user was provided both directly and via --field-from-file",
+		},
+		"valid YAML mapping": {
+			File:          "mapping.yaml",
+			Mapping:       "public-key: key.pem\nuser: user.txt\n",
+			WantPublicKey: "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+			WantUser:      "alice",
+		},
+		"YAML content detected without extension": {
+			File:     "mapping",
+			Mapping:  "user: user.txt\n",
+			WantUser: "alice",
+		},
+		"format overrides extension": {
+			File:     "mapping.json",
+			Format:   argparser.InputFormatYAML,
+			Mapping:  "user: user.txt\n",
+			WantUser: "alice",
+		},
+		"invalid JSON": {
+			Mapping:   "{\n  \"user\": user.txt\n}",
+			WantError: "invalid --field-from-file mapping file: error parsing JSON file",
+		},
+		"invalid JSON reports position": {
+			Mapping:   "{\n  \"user\": user.txt\n}",
+			WantError: "(line 2, column 11)",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			file := c.File
+			if file == "" {
+				file = "mapping.json"
+			}
+			path := filepath.Join(dir, file)
+			if err := os.WriteFile(path, []byte(c.Mapping), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			var publicKey argparser.OptionalString
+			user := c.User
+			err := argparser.FieldsFromFile(path, c.Format, map[string]*argparser.OptionalString{
+				"public-key": &publicKey,
+				"user":       &user,
+			})
+			testutil.AssertErrorContains(t, err, c.WantError)
+			if err == nil {
+				testutil.AssertString(t, c.WantPublicKey, publicKey.Value)
+				testutil.AssertString(t, c.WantUser, user.Value)
+			}
+		})
+	}
+}
+
+func TestDecodeInput(t *testing.T) {
+	type schema struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	cases := map[string]struct {
+		Path       string
+		Data       string
+		Format     string
+		Want       schema
+		WantError  string
+		WantFormat string
+	}{
+		"JSON by extension": {
+			Path:       "input.json",
+			Data:       `{"name": "foo", "count": 2}`,
+			Want:       schema{Name: "foo", Count: 2},
+			WantFormat: argparser.InputFormatJSON,
+		},
+		"YAML by extension": {
+			Path:       "input.yml",
+			Data:       "name: foo\ncount: 2\n",
+			Want:       schema{Name: "foo", Count: 2},
+			WantFormat: argparser.InputFormatYAML,
+		},
+		"JSON by content": {
+			Path:       "-",
+			Data:       ` {"name": "foo"}`,
+			Want:       schema{Name: "foo"},
+			WantFormat: argparser.InputFormatJSON,
+		},
+		"YAML by content": {
+			Path:       "-",
+			Data:       "name: foo\n",
+			Want:       schema{Name: "foo"},
+			WantFormat: argparser.InputFormatYAML,
+		},
+		"unknown JSON field": {
+			Path:       "input.json",
+			Data:       `{"nme": "foo"}`,
+			WantError:  `unknown field "nme"`,
+			WantFormat: argparser.InputFormatJSON,
+		},
+		"unknown YAML field": {
+			Path:       "input.yaml",
+			Data:       "nme: foo\n",
+			WantError:  `unknown field "nme"`,
+			WantFormat: argparser.InputFormatYAML,
+		},
+		"JSON type error reports position": {
+			Path:       "input.json",
+			Data:       "{\n\"count\": \"two\"\n}",
+			WantError:  "error parsing JSON file 'input.json' (line 2, column 14)",
+			WantFormat: argparser.InputFormatJSON,
+		},
+		"invalid YAML": {
+			Path:       "input.yaml",
+			Data:       "name: [foo\n",
+			WantError:  "error parsing YAML file 'input.yaml'",
+			WantFormat: argparser.InputFormatYAML,
+		},
+		"unsupported format": {
+			Path:       "input.json",
+			Data:       `{}`,
+			Format:     "toml",
+			WantError:  "unsupported input format 'toml'",
+			WantFormat: argparser.InputFormatJSON,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			testutil.AssertString(t, c.WantFormat, argparser.DetectInputFormat(c.Path, []byte(c.Data)))
+
+			var got schema
+			err := argparser.DecodeInput(c.Path, []byte(c.Data), c.Format, &got)
+			testutil.AssertErrorContains(t, err, c.WantError)
+			if err == nil {
+				testutil.AssertEqual(t, c.Want, got)
+			}
+		})
+	}
+}
+
+func TestDeprecatedFlag(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		flags      global.Flags
+		wantOutput string
+	}{
+		{
+			name:       "deprecated flag used",
+			args:       []string{"foo", "--old", "bar"},
+			wantOutput: "The --old flag is deprecated and will be removed in v11.0.0. Use --new instead.",
+		},
+		{
+			name:  "deprecated flag used in quiet mode",
+			args:  []string{"foo", "--old", "bar"},
+			flags: global.Flags{Quiet: true},
+		},
+		{
+			name: "deprecated flag not used",
+			args: []string{"foo"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			text.Warnings.Reset()
+			defer text.Warnings.Reset()
+
+			var (
+				buf   bytes.Buffer
+				value string
+			)
+			app := kingpin.New("fastly", "")
+			app.Terminate(nil)
+			b := argparser.Base{
+				CmdClause: app.Command("foo", ""),
+				Globals:   &global.Data{Args: testcase.args, Flags: testcase.flags, Output: &buf},
+			}
+			b.RegisterFlag(argparser.StringFlagOpts{
+				Name: "old",
+				Dst:  &value,
+				Deprecated: &argparser.Deprecation{
+					Replacement:    "--new",
+					RemovalVersion: "v11.0.0",
+				},
+			})
+			if _, err := app.Parse(testcase.args); err != nil {
+				t.Fatal(err)
+			}
+			// The notice isn't displayed until the output mode is known.
+			testutil.AssertString(t, "", buf.String())
+			argparser.DisplayDeprecations(&buf, b.Globals)
+
+			if testcase.flags.Quiet {
+				testutil.AssertString(t, "", buf.String())
+			} else {
+				testutil.AssertStringContains(t, buf.String(), testcase.wantOutput)
+			}
+			if len(testcase.args) > 1 {
+				testutil.AssertEqual(t, []string{"The --old flag is deprecated and will be removed in v11.0.0. Use --new instead."}, text.Warnings.Messages())
+			} else {
+				testutil.AssertEqual(t, 0, len(text.Warnings.Messages()))
+			}
+		})
+	}
+}
+
+func TestWriteJSONStyle(t *testing.T) {
+	value := map[string]string{"foo": "bar"}
+
+	for _, testcase := range []struct {
+		name  string
+		style argparser.JSONStyle
+		want  string
+	}{
+		{
+			name:  "auto defaults to pretty when not piped",
+			style: argparser.JSONStyleAuto,
+			want:  "{\n  \"foo\": \"bar\"\n}\n",
+		},
+		{
+			name:  "compact",
+			style: argparser.JSONStyleCompact,
+			want:  "{\"foo\":\"bar\"}\n",
+		},
+		{
+			name:  "pretty",
+			style: argparser.JSONStylePretty,
+			want:  "{\n  \"foo\": \"bar\"\n}\n",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			j := argparser.JSONOutput{Enabled: true}
+			j.SetJSONStyle(testcase.style)
+			ok, err := j.WriteOutput(&buf, value)
+			testutil.AssertNoError(t, err)
+			testutil.AssertBool(t, true, ok)
+			testutil.AssertString(t, testcase.want, buf.String())
+		})
+	}
+
+	t.Run("auto defaults to compact when piped", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+
+		j := argparser.JSONOutput{Enabled: true}
+		if _, err := j.WriteOutput(w, value); err != nil {
+			t.Fatal(err)
+		}
+		_ = w.Close()
+
+		have, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testutil.AssertString(t, "{\"foo\":\"bar\"}\n", string(have))
+	})
+}
+
 // cloneVersionResult returns a function which returns a specific cloned version.
 func cloneVersionResult(version int) func(i *fastly.CloneVersionInput) (*fastly.Version, error) {
 	return func(i *fastly.CloneVersionInput) (*fastly.Version, error) {
@@ -431,3 +901,1005 @@ func cloneVersionResult(version int) func(i *fastly.CloneVersionInput) (*fastly.
 func errMatches(version int, err error) bool {
 	return err.Error() == fmt.Sprintf("service version %d is not editable", version)
 }
+
+func TestChanges(t *testing.T) {
+	type resource struct {
+		Name      *string    `mapstructure:"name"`
+		Period    *int       `mapstructure:"period"`
+		Path      *string    `mapstructure:"path"`
+		UpdatedAt *time.Time `mapstructure:"updated_at"`
+	}
+	now, later := time.Now(), time.Now().Add(time.Minute)
+	before := &resource{Name: fastly.ToPointer("logs"), Period: fastly.ToPointer(3600), UpdatedAt: &now}
+	after := &resource{Name: fastly.ToPointer("logs"), Period: fastly.ToPointer(60), Path: fastly.ToPointer("/"), UpdatedAt: &later}
+
+	cs := argparser.Changes(before, after)
+	testutil.AssertEqual(t, map[string]argparser.FieldChange{
+		"path":   {Old: nil, New: "/"},
+		"period": {Old: 3600, New: 60},
+	}, cs.Changed)
+
+	// A resource that wasn't fetched reports no changes.
+	testutil.AssertEqual(t, 0, len(argparser.Changes(nil, after).Changed))
+	testutil.AssertEqual(t, 0, len(argparser.Changes((*resource)(nil), after).Changed))
+
+	var buf bytes.Buffer
+	c := argparser.ChangesOutput{ShowChanges: true}
+	c.DisplayChanges(&buf, cs)
+	testutil.AssertString(t, "\nFIELD   OLD   NEW\npath    -     /\nperiod  3600  60\n", buf.String())
+
+	buf.Reset()
+	c.DisplayChanges(&buf, argparser.Changes(before, before))
+	testutil.AssertStringContains(t, buf.String(), "No fields were changed")
+
+	buf.Reset()
+	c.DiffContext = argparser.DiffContextFull
+	c.DisplayChanges(&buf, cs)
+	testutil.AssertString(t, "\nFIELD   OLD   NEW\nname    logs  logs\npath    -     /\nperiod  3600  60\n", buf.String())
+
+	buf.Reset()
+	c.DisplayChanges(&buf, argparser.Changes(before, before))
+	testutil.AssertString(t, "\nFIELD   OLD   NEW\nname    logs  logs\npath    -     -\nperiod  3600  3600\n", buf.String())
+
+	long := &resource{Path: fastly.ToPointer(strings.Repeat("x", 100))}
+	buf.Reset()
+	c.DiffContext = argparser.DiffContextChangedOnly
+	c.DisplayChanges(&buf, argparser.Changes(before, long))
+	testutil.AssertStringContains(t, buf.String(), "path    -     "+strings.Repeat("x", 59)+"…\n")
+
+	buf.Reset()
+	text.MaxValueWidth = 10
+	c.DisplayChanges(&buf, argparser.Changes(before, long))
+	testutil.AssertStringContains(t, buf.String(), "path    -     "+strings.Repeat("x", 9)+"…\n")
+	text.MaxValueWidth = 0
+
+	buf.Reset()
+	text.FullValues = true
+	defer func() { text.FullValues = false }()
+	c.DisplayChanges(&buf, argparser.Changes(before, long))
+	testutil.AssertStringContains(t, buf.String(), "path    -     "+strings.Repeat("x", 100)+"\n")
+
+	buf.Reset()
+	c.ShowChanges = false
+	c.DisplayChanges(&buf, cs)
+	testutil.AssertString(t, "", buf.String())
+
+	// The values of sensitive fields are masked, but still compared.
+	type credentials struct {
+		AccessKey *string `mapstructure:"access_key"`
+		User      *string `mapstructure:"user"`
+	}
+	secrets := argparser.Changes(
+		&credentials{AccessKey: fastly.ToPointer("old-secret"), User: fastly.ToPointer("u")},
+		&credentials{AccessKey: fastly.ToPointer("new-secret"), User: fastly.ToPointer("u")},
+	)
+	testutil.AssertEqual(t, map[string]argparser.FieldChange{
+		"access_key": {Old: "REDACTED", New: "REDACTED"},
+	}, secrets.Changed)
+	buf.Reset()
+	c.ShowChanges = true
+	c.DiffContext = argparser.DiffContextFull
+	c.DisplayChanges(&buf, secrets)
+	testutil.AssertStringDoesntContain(t, buf.String(), "secret")
+}
+
+func TestPatchFromFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	var (
+		period argparser.OptionalInt
+		path   argparser.OptionalString
+	)
+	fields := map[string]any{"period": &period, "path": &path, "log-path": argparser.PatchAlias("path")}
+
+	err := argparser.PatchFromFile(write("patch.json", `{"period": 0}`), "", fields)
+	testutil.AssertNoError(t, err)
+	testutil.AssertBool(t, true, period.WasSet)
+	testutil.AssertEqual(t, 0, period.Value)
+	testutil.AssertBool(t, false, path.WasSet)
+
+	err = argparser.PatchFromFile(write("unknown.yaml", "regionn: x\n"), "", fields)
+	testutil.AssertErrorContains(t, err, "unsupported field 'regionn'")
+
+	err = argparser.PatchFromFile(write("null.yaml", "path: null\n"), "", fields)
+	testutil.AssertErrorContains(t, err, "field 'path' in --from-file file")
+
+	path = argparser.OptionalString{}
+	err = argparser.PatchFromFile(write("alias.yaml", "log_path: /logs\n"), "", fields)
+	testutil.AssertNoError(t, err)
+	testutil.AssertBool(t, true, path.WasSet)
+	testutil.AssertString(t, "/logs", path.Value)
+
+	path = argparser.OptionalString{}
+	err = argparser.PatchFromFile(write("both.yaml", "log_path: /logs\npath: /other\n"), "", fields)
+	testutil.AssertErrorContains(t, err, "fields 'log_path' and 'path' in --from-file file")
+}
+
+func TestPaginate(t *testing.T) {
+	page := func(n int, body string) *http.Response {
+		return &http.Response{
+			Header: http.Header{
+				"Link": []string{fmt.Sprintf(`<https://api.fastly.com/service?page=%d>; rel="next", <https://api.fastly.com/service?page=3>; rel="last"`, n+1)},
+			},
+			Body: io.NopCloser(strings.NewReader(body)),
+		}
+	}
+	newPaginator := func(errs ...error) *fastly.ListPaginator[fastly.Service] {
+		return fastly.NewPaginator[fastly.Service](&mock.HTTPClient{
+			Errors: append(errs, make([]error, 3-len(errs))...),
+			Responses: []*http.Response{
+				page(1, `[{"id": "1"}, {"id": "2"}]`),
+				page(2, `[{"id": "3"}, {"id": "4"}]`),
+				{Body: io.NopCloser(strings.NewReader(`[{"id": "5"}, {"id": "6"}]`))},
+			},
+		}, fastly.ListOpts{}, "/service")
+	}
+
+	scenarios := []struct {
+		name          string
+		opts          argparser.PaginationOutput
+		errs          []error
+		wantIDs       string
+		wantTruncated bool
+		wantPartial   bool
+		wantError     string
+	}{
+		{
+			name:    "all pages",
+			wantIDs: "1,2,3,4,5,6",
+		},
+		{
+			name:          "no autopaginate",
+			opts:          argparser.PaginationOutput{NoAutopaginate: true},
+			wantIDs:       "1,2",
+			wantTruncated: true,
+		},
+		{
+			name:          "limit within a page",
+			opts:          argparser.PaginationOutput{Limit: 3},
+			wantIDs:       "1,2,3",
+			wantTruncated: true,
+		},
+		{
+			name:          "limit at a page boundary",
+			opts:          argparser.PaginationOutput{Limit: 4},
+			wantIDs:       "1,2,3,4",
+			wantTruncated: true,
+		},
+		{
+			name:    "limit matching the total",
+			opts:    argparser.PaginationOutput{Limit: 6},
+			wantIDs: "1,2,3,4,5,6",
+		},
+		{
+			name:    "limit exceeding the total",
+			opts:    argparser.PaginationOutput{Limit: 10},
+			wantIDs: "1,2,3,4,5,6",
+		},
+		{
+			name:      "first page error",
+			errs:      []error{testutil.Err},
+			wantError: testutil.Err.Error(),
+		},
+		{
+			name:        "later page error",
+			errs:        []error{nil, testutil.Err},
+			wantIDs:     "1,2",
+			wantPartial: true,
+			wantError:   "pagination stopped early at page 2",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			records, truncated, err := argparser.Paginate(testcase.opts, newPaginator(testcase.errs...), fsterr.MockLog{}, nil)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertBool(t, testcase.wantPartial, argparser.IsPartialResult(err))
+			testutil.AssertBool(t, testcase.wantTruncated, truncated)
+			ids := make([]string, 0, len(records))
+			for _, r := range records {
+				ids = append(ids, fastly.ToValue(r.ServiceID))
+			}
+			testutil.AssertString(t, testcase.wantIDs, strings.Join(ids, ","))
+		})
+	}
+}
+
+func TestPageSizeInput(t *testing.T) {
+	scenarios := []struct {
+		name        string
+		pageSize    argparser.OptionalInt
+		verbose     bool
+		wantSize    *int
+		wantOutputs []string
+	}{
+		{
+			name:        "unset uses the API's default",
+			verbose:     true,
+			wantOutputs: []string{"Page size: 100 (default)"},
+		},
+		{
+			name:        "in range",
+			pageSize:    argparser.OptionalInt{Optional: argparser.Optional{WasSet: true}, Value: 500},
+			verbose:     true,
+			wantSize:    fastly.ToPointer(500),
+			wantOutputs: []string{"Page size: 500"},
+		},
+		{
+			name:        "above the maximum is clamped",
+			pageSize:    argparser.OptionalInt{Optional: argparser.Optional{WasSet: true}, Value: 5000},
+			verbose:     true,
+			wantSize:    fastly.ToPointer(argparser.MaxPageSize),
+			wantOutputs: []string{"--page-size 5000 is outside the range the API accepts (1-1000), so 1000 is used.", "Page size: 1000"},
+		},
+		{
+			name:        "below the minimum is clamped",
+			pageSize:    argparser.OptionalInt{Optional: argparser.Optional{WasSet: true}, Value: -1},
+			wantSize:    fastly.ToPointer(argparser.MinPageSize),
+			wantOutputs: []string{"--page-size -1 is outside the range the API accepts (1-1000), so 1 is used."},
+		},
+		{
+			name:        "zero is clamped",
+			pageSize:    argparser.OptionalInt{Optional: argparser.Optional{WasSet: true}},
+			wantSize:    fastly.ToPointer(argparser.MinPageSize),
+			wantOutputs: []string{"--page-size 0 is outside the range the API accepts (1-1000), so 1 is used."},
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			g := &global.Data{Flags: global.Flags{Verbose: testcase.verbose}}
+			p := argparser.PaginationOutput{PageSize: testcase.pageSize}
+			testutil.AssertEqual(t, testcase.wantSize, p.PageSizeInput(g, &buf))
+			for _, want := range testcase.wantOutputs {
+				testutil.AssertStringContains(t, buf.String(), want)
+			}
+			if !testcase.verbose {
+				testutil.AssertStringDoesntContain(t, buf.String(), "Page size:")
+			}
+		})
+	}
+}
+
+func TestWriteJSONPointer(t *testing.T) {
+	value := map[string]any{
+		"Name":     "example",
+		"Active":   true,
+		"Deleted":  nil,
+		"a/b":      "slash",
+		"m~n":      "tilde",
+		"Versions": []map[string]any{{"Number": 1}, {"Number": 2}},
+	}
+	scenarios := []struct {
+		pointer   string
+		want      string
+		wantError string
+	}{
+		{pointer: "/Name", want: "example\n"},
+		{pointer: "/Active", want: "true\n"},
+		{pointer: "/Deleted", want: "null\n"},
+		{pointer: "/Versions/1/Number", want: "2\n"},
+		{pointer: "/Versions/0", want: `{"Number":1}` + "\n"},
+		{pointer: "/a~1b", want: "slash\n"},
+		{pointer: "/m~0n", want: "tilde\n"},
+		{pointer: "Name", wantError: `invalid JSON pointer "Name": must be empty or start with '/'`},
+		{pointer: "/Missing", wantError: `JSON pointer "/Missing" doesn't resolve: no field "Missing" at /`},
+		{pointer: "/Versions/2", wantError: "index 2 is out of range at /Versions (length 2)"},
+		{pointer: "/Versions/-", wantError: `"-" isn't a valid array index at /Versions`},
+		{pointer: "/Versions/01", wantError: `"01" isn't a valid array index at /Versions`},
+		{pointer: "/Name/first", wantError: "the value at /Name isn't an object or array"},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.pointer, func(t *testing.T) {
+			var j argparser.JSONOutput
+			j.SetJSONStyle(argparser.JSONStyleCompact)
+			j.SetJSONPointer(testcase.pointer)
+
+			var buf bytes.Buffer
+			ok, err := j.WriteOutput(&buf, value)
+			testutil.AssertBool(t, true, ok)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.want, buf.String())
+		})
+	}
+}
+
+type fieldsRecord struct {
+	Name   string `json:"name"`
+	Region string `json:"region,omitempty"`
+}
+
+func TestWriteOutputFields(t *testing.T) {
+	value := map[string]any{
+		"BucketName": "my-logs",
+		"Period":     3600,
+		"Region":     "ORD",
+	}
+	scenarios := []struct {
+		name      string
+		value     any
+		fields    []string
+		want      string
+		wantError string
+	}{
+		{name: "single field", value: value, fields: []string{"Region"}, want: `{"Region":"ORD"}` + "\n"},
+		{name: "multiple fields", value: value, fields: []string{"Region", "Period"}, want: `{"Period":3600,"Region":"ORD"}` + "\n"},
+		{name: "list", value: []any{value, value}, fields: []string{"BucketName"}, want: `[{"BucketName":"my-logs"},{"BucketName":"my-logs"}]` + "\n"},
+		{name: "unknown field", value: value, fields: []string{"Bucket"}, wantError: `unknown field "Bucket"`},
+		{name: "not an object", value: "example", fields: []string{"Region"}, wantError: "--field can't be used as the output isn't an object"},
+		{name: "empty list", value: []fieldsRecord{}, fields: []string{"name"}, want: "[]\n"},
+		{name: "empty list unknown field", value: []fieldsRecord{}, fields: []string{"Name"}, wantError: `unknown field "Name"`},
+		{name: "omitted field", value: fieldsRecord{Name: "example"}, fields: []string{"name", "region"}, want: `{"name":"example"}` + "\n"},
+		{name: "omitted field unknown field", value: fieldsRecord{Name: "example"}, fields: []string{"period"}, wantError: `unknown field "period"`},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			j := argparser.JSONOutput{Enabled: true, Fields: testcase.fields}
+			j.SetJSONStyle(argparser.JSONStyleCompact)
+
+			var buf bytes.Buffer
+			ok, err := j.WriteOutput(&buf, testcase.value)
+			testutil.AssertBool(t, true, ok)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.want, buf.String())
+		})
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	type embedded struct {
+		ID string `json:"id"`
+	}
+	type item struct {
+		embedded
+		Name    string         `json:"name"`
+		Count   int            `json:"count,omitempty"`
+		Skipped string         `json:"-"`
+		Meta    map[string]int `json:"meta"`
+		Comment *string        `json:"comment"`
+	}
+	scenarios := []struct {
+		name      string
+		value     any
+		want      string
+		wantError string
+	}{
+		{
+			name: "list of structs",
+			value: []*item{
+				{embedded: embedded{ID: "1"}, Name: "a, b", Count: 1234567, Meta: map[string]int{"x": 1}},
+				{embedded: embedded{ID: "2"}, Name: `say "hi"`, Comment: fastly.ToPointer("multi\nline")},
+			},
+			want: "id,name,count,meta,comment\n1,\"a, b\",1234567,\"{\"\"x\"\":1}\",\n2,\"say \"\"hi\"\"\",,,\"multi\nline\"\n",
+		},
+		{
+			name:  "single map",
+			value: map[string]any{"b": true, "a": 1.5},
+			want:  "a,b\n1.5,true\n",
+		},
+		{
+			name:      "not an object",
+			value:     []string{"a"},
+			wantError: "CSV output requires objects (or a list of objects)",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := argparser.WriteCSV(&buf, testcase.value)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.want, buf.String())
+		})
+	}
+}
+
+func TestWriteOutputCSV(t *testing.T) {
+	type item struct {
+		Name      string `json:"name"`
+		SecretKey string `json:"secret_key"`
+		Bucket    string `json:"bucket"`
+	}
+	value := []item{{Name: "logs", SecretKey: "abc", Bucket: "my-logs"}}
+	scenarios := []struct {
+		name   string
+		fields []string
+		redact bool
+		want   string
+	}{
+		{name: "all fields", want: "name,secret_key,bucket\nlogs,abc,my-logs\n"},
+		{name: "selected fields", fields: []string{"bucket", "name"}, want: "name,bucket\nlogs,my-logs\n"},
+		{name: "redacted", redact: true, want: "name,secret_key,bucket\nlogs,REDACTED,my-logs\n"},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			j := argparser.JSONOutput{Enabled: true, Format: argparser.FormatCSV, Fields: testcase.fields, Redact: testcase.redact}
+
+			var buf bytes.Buffer
+			ok, err := j.WriteOutput(&buf, value)
+			testutil.AssertBool(t, true, ok)
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, testcase.want, buf.String())
+		})
+	}
+}
+
+func TestBulkResult(t *testing.T) {
+	r := argparser.BulkResult{Action: "delete", Noun: "keys"}
+	for i := 11; i >= 0; i-- {
+		r.Failed(fmt.Sprintf("key-%02d", i), errors.New("whoops"))
+	}
+	r.Succeeded("a")
+	r.Skipped("z")
+
+	report := r.Report()
+	testutil.AssertEqual(t, argparser.BulkSummary{Total: 14, Succeeded: 1, Failed: 12, Skipped: 1}, report.Summary)
+	testutil.AssertString(t, "a", report.Results[0].ID)
+	testutil.AssertString(t, "z", report.Results[13].ID)
+
+	testutil.AssertErrorContains(t, r.Err(false), "failed to delete 12 of 14 keys: key-00, key-01, key-02, key-03, key-04, key-05, key-06, key-07, key-08, key-09, and 2 more")
+	testutil.AssertNoError(t, r.Err(true))
+
+	var buf bytes.Buffer
+	j := argparser.JSONOutput{Enabled: true, Style: argparser.JSONStyleCompact}
+	single := argparser.BulkResult{}
+	single.Succeeded("a")
+	testutil.AssertNoError(t, single.Render(&buf, j))
+	testutil.AssertString(t, `{"summary":{"total":1,"succeeded":1,"failed":0,"skipped":0},"results":[{"id":"a","status":"succeeded"}]}`+"\n", buf.String())
+	testutil.AssertNoError(t, single.Err(false))
+
+	abort := argparser.BulkResult{Action: "delete", Noun: "keys", OnError: argparser.OnErrorAbort}
+	abort.Succeeded("a")
+	testutil.AssertEqual(t, false, abort.Aborted())
+	abort.Failed("b", errors.New("whoops"))
+	testutil.AssertEqual(t, true, abort.Aborted())
+	abort.Skipped("c")
+	var be fsterr.BulkError
+	if !errors.As(abort.Err(false), &be) {
+		t.Fatalf("want a BulkError, got: %v", abort.Err(false))
+	}
+	testutil.AssertEqual(t, fsterr.BulkError{Action: "delete", Noun: "keys", IDs: []string{"b"}, Failed: 1, Succeeded: 1, Total: 3}, be)
+	testutil.AssertEqual(t, false, r.Aborted()) // OnError defaults to continue
+}
+
+func TestRunItem(t *testing.T) {
+	ctx := context.Background()
+	errTest := errors.New("whoops")
+
+	testutil.AssertNoError(t, argparser.RunItem(ctx, 0, func(_ context.Context) error { return nil }))
+	testutil.AssertErrorContains(t, argparser.RunItem(ctx, time.Second, func(_ context.Context) error { return errTest }), "whoops")
+
+	err := argparser.RunItem(ctx, 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	})
+	testutil.AssertErrorContains(t, err, "operation timed out after 10ms")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func TestPoolSize(t *testing.T) {
+	defaultSize := min(argparser.BulkPoolSize, runtime.GOMAXPROCS(0)*4)
+
+	rateLimit := func(remaining string) *api.RateLimit {
+		h := http.Header{}
+		h.Set(api.RateLimitRemainingHeader, remaining)
+		rl := new(api.RateLimit)
+		rl.Observe(&http.Response{Header: h})
+		return rl
+	}
+	limited := rateLimit("3")
+	exhausted := rateLimit("0")
+
+	testutil.AssertEqual(t, defaultSize, argparser.PoolSize(0, nil))
+	testutil.AssertEqual(t, defaultSize, argparser.PoolSize(-1, new(api.RateLimit)))
+	testutil.AssertEqual(t, 50, argparser.PoolSize(50, nil))
+	testutil.AssertEqual(t, 3, argparser.PoolSize(50, limited))
+	testutil.AssertEqual(t, 1, argparser.PoolSize(0, exhausted))
+}
+
+func TestRunPool(t *testing.T) {
+	items := make(chan int)
+	go func() {
+		defer close(items)
+		for i := range 100 {
+			items <- i
+		}
+	}()
+
+	var (
+		active, peak atomic.Int64
+		sum          atomic.Int64
+	)
+	err := argparser.RunPool(context.Background(), 4, items, func(_ context.Context, i int) error {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		sum.Add(int64(i))
+		if i%25 == 0 {
+			return fmt.Errorf("item %d failed", i)
+		}
+		return nil
+	})
+
+	testutil.AssertEqual(t, int64(4950), sum.Load())
+	if p := peak.Load(); p > 4 {
+		t.Errorf("want at most 4 concurrent workers, got %d", p)
+	}
+	for _, want := range []string{"item 0 failed", "item 25 failed", "item 50 failed", "item 75 failed"} {
+		testutil.AssertErrorContains(t, err, want)
+	}
+}
+
+func TestRunPoolCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The producer is unaware of the cancelation, so the pool must drain the
+	// remaining items for it to finish.
+	items := make(chan int)
+	produced := make(chan struct{})
+	go func() {
+		defer close(produced)
+		defer close(items)
+		for i := range 1000 {
+			items <- i
+		}
+	}()
+
+	var called atomic.Int64
+	err := argparser.RunPool(ctx, 4, items, func(_ context.Context, _ int) error {
+		if called.Add(1) == 10 {
+			cancel()
+		}
+		return nil
+	})
+
+	select {
+	case <-produced:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the producer was blocked after the pool was canceled")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("want context.Canceled, got: %v", err)
+	}
+	if n := called.Load(); n >= 1000 {
+		t.Errorf("want the remaining items skipped after cancelation, got %d calls", n)
+	}
+}
+
+func BenchmarkRunPool(b *testing.B) {
+	size := argparser.PoolSize(0, nil)
+	for range b.N {
+		items := make(chan int)
+		go func() {
+			defer close(items)
+			for i := range 1000 {
+				items <- i
+			}
+		}()
+		_ = argparser.RunPool(context.Background(), size, items, func(_ context.Context, _ int) error {
+			return nil
+		})
+	}
+}
+
+func TestFormatters(t *testing.T) {
+	err := argparser.RegisterFormatter("test-keys", argparser.FormatterFunc(func(out io.Writer, value any) error {
+		m, _ := value.(map[string]any)
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		_, err := fmt.Fprintln(out, strings.Join(keys, ","))
+		return err
+	}))
+	testutil.AssertNoError(t, err)
+	testutil.AssertErrorContains(t, argparser.RegisterFormatter("test-keys", nil), "output format 'test-keys' is already registered")
+	testutil.AssertErrorContains(t, argparser.RegisterFormatter(argparser.FormatJSON, nil), "output format 'json' is already registered")
+	testutil.AssertEqual(t, []string{"csv", "json", "logfmt", "test-keys", "yaml"}, argparser.FormatterNames())
+
+	value := map[string]any{"Name": "example", "Active": true, "Size": 10000000, "Ratio": 0.5}
+	scenarios := []struct {
+		format string
+		want   string
+	}{
+		{format: "", want: `{"Active":true,"Name":"example","Ratio":0.5,"Size":10000000}` + "\n"},
+		{format: argparser.FormatJSON, want: `{"Active":true,"Name":"example","Ratio":0.5,"Size":10000000}` + "\n"},
+		{format: argparser.FormatYAML, want: "Active: true\nName: example\nRatio: 0.5\nSize: 10000000\n"},
+		{format: argparser.FormatLogfmt, want: "Active=true Name=example Ratio=0.5 Size=10000000\n"},
+		{format: "test-keys", want: "Active,Name,Ratio,Size\n"},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.format, func(t *testing.T) {
+			j := argparser.JSONOutput{Enabled: true, Format: testcase.format, Style: argparser.JSONStyleCompact}
+			var buf bytes.Buffer
+			ok, err := j.WriteOutput(&buf, value)
+			testutil.AssertBool(t, true, ok)
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, testcase.want, buf.String())
+		})
+	}
+}
+
+func TestServiceDetailsVersionLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), versionlock.FileName)
+	defer func(p string) { versionlock.Path = p }(versionlock.Path)
+	versionlock.Path = path
+
+	// details parses the args of a command with a --version flag, which is
+	// required if set, and resolves the service version.
+	details := func(required bool, args ...string) (int, string, error) {
+		var (
+			buf bytes.Buffer
+			sv  argparser.OptionalServiceVersion
+		)
+		app := kingpin.New("fastly", "")
+		app.Terminate(nil)
+		b := argparser.Base{CmdClause: app.Command("foo", ""), Globals: &global.Data{}}
+		b.RegisterFlag(argparser.StringFlagOpts{
+			Name:     argparser.FlagVersionName,
+			Dst:      &sv.Value,
+			Required: required,
+		})
+		if _, err := app.Parse(append([]string{"foo"}, args...)); err != nil {
+			return 0, "", err
+		}
+		_, v, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+			APIClient:          mock.API{ListVersionsFn: testutil.ListVersions},
+			Manifest:           manifest.Data{Flag: manifest.Flag{ServiceID: "123"}},
+			Out:                &buf,
+			ServiceVersionFlag: sv,
+			VerboseMode:        true,
+		})
+		if err != nil {
+			return 0, buf.String(), err
+		}
+		return fastly.ToValue(v.Number), buf.String(), nil
+	}
+
+	// Without a lockfile the active version is used, unless --version is
+	// required.
+	v, _, err := details(false)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 1, v)
+	_, _, err = details(true)
+	testutil.AssertErrorContains(t, err, "required flag --version not provided")
+
+	testutil.AssertNoError(t, versionlock.Write(path, versionlock.Lock{ServiceID: "123", Version: 3}))
+	for _, required := range []bool{false, true} {
+		v, out, err := details(required)
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 3, v)
+		testutil.AssertStringContains(t, out, "Service version (via .fastly-version): 3")
+
+		// The --version flag takes precedence over the lockfile.
+		v, _, err = details(required, "--version", "2")
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, 2, v)
+	}
+
+	// A lockfile written for another service doesn't provide the version, which
+	// is only an error when the lockfile is why --version could be omitted.
+	testutil.AssertNoError(t, versionlock.Write(path, versionlock.Lock{ServiceID: "456", Version: 3}))
+	v, _, err = details(false)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 1, v)
+	_, _, err = details(true)
+	testutil.AssertErrorContains(t, err, "the .fastly-version file pins a version of service 456, not 123")
+
+	testutil.AssertNoError(t, os.WriteFile(path, []byte("version = 3\n"), 0o600))
+	_, _, err = details(true)
+	testutil.AssertErrorContains(t, err, "a service_id and a version (greater than zero) are required")
+}
+
+func TestResolveServiceDetails(t *testing.T) {
+	defer func(p string) { versionlock.Path = p }(versionlock.Path)
+	versionlock.Path = filepath.Join(t.TempDir(), versionlock.FileName)
+	defer func() { fsterr.ServiceResolution = "" }()
+
+	var (
+		buf bytes.Buffer
+		ac  argparser.OptionalAutoClone
+	)
+	ac.WasSet = true
+	ac.Value = true
+	_, v, r, err := argparser.ResolveServiceDetails(argparser.ServiceDetailsOpts{
+		AutoCloneFlag: ac,
+		APIClient: mock.API{
+			ListVersionsFn: testutil.ListVersions,
+			CloneVersionFn: testutil.CloneVersionResult(5),
+		},
+		Manifest: manifest.Data{
+			Flag: manifest.Flag{ServiceID: "123"},
+			File: manifest.File{ServiceID: "456"},
+		},
+		Out:         &buf,
+		VerboseMode: true,
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 5, fastly.ToValue(v.Number))
+	testutil.AssertEqual(t, argparser.ServiceResolution{
+		ServiceID:         "123",
+		ServiceIDSource:   "--service-id",
+		IgnoredServiceIDs: []argparser.ServiceIDCandidate{{ServiceID: "456", Source: manifest.Filename}},
+		VersionSource:     "default",
+		VersionRule:       "active version",
+		VersionCandidates: []argparser.VersionCandidate{
+			{Number: 4, Staging: true},
+			{Number: 3},
+			{Number: 2, Locked: true},
+			{Number: 1, Active: true},
+		},
+		Version:    5,
+		ClonedFrom: 1,
+	}, r)
+	testutil.AssertStringContains(t, buf.String(), "Service version (via active version): 1")
+
+	// The resolution is recorded for the error log.
+	testutil.AssertString(t, strings.Join([]string{
+		"Service ID: 123 (via --service-id)",
+		"Ignored Service ID: 456 (via fastly.toml)",
+		"Version source: default",
+		"Version rule: active version",
+		"Version candidates: 4 (staged), 3, 2 (locked), 1 (active)",
+		"Version: 5",
+		"Cloned from version: 1 (via --autoclone)",
+	}, "\n"), fsterr.ServiceResolution)
+
+	// The resolution so far is recorded when it fails.
+	var sv argparser.OptionalServiceVersion
+	sv.Value = "9"
+	_, _, r, err = argparser.ResolveServiceDetails(argparser.ServiceDetailsOpts{
+		APIClient:          mock.API{ListVersionsFn: testutil.ListVersions},
+		Manifest:           manifest.Data{Flag: manifest.Flag{ServiceID: "123"}},
+		Out:                io.Discard,
+		ServiceVersionFlag: sv,
+	})
+	testutil.AssertErrorContains(t, err, "specified service version not found: 9")
+	testutil.AssertEqual(t, "specified version", r.VersionRule)
+	testutil.AssertStringContains(t, fsterr.ServiceResolution, "Version source: --version (9)")
+}
+
+func TestResolveServiceDetailsEditable(t *testing.T) {
+	defer func(w io.Writer) { argparser.NoticeOutput = w }(argparser.NoticeOutput)
+	defer func() { fsterr.ServiceResolution = "" }()
+	var notices bytes.Buffer
+	argparser.NoticeOutput = &notices
+
+	var sv argparser.OptionalServiceVersion
+	sv.Value = "editable"
+	opts := argparser.ServiceDetailsOpts{
+		APIClient:          mock.API{ListVersionsFn: testutil.ListVersions},
+		Manifest:           manifest.Data{Flag: manifest.Flag{ServiceID: "123"}},
+		Out:                io.Discard,
+		ServiceVersionFlag: sv,
+	}
+
+	// The most recent editable version is used.
+	_, v, r, err := argparser.ResolveServiceDetails(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 4, fastly.ToValue(v.Number))
+	testutil.AssertString(t, "editable version", r.VersionRule)
+
+	// Without an editable version, it's only cloned with --autoclone.
+	opts.APIClient = mock.API{
+		ListVersionsFn: func(_ *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+			return []*fastly.Version{
+				{Number: fastly.ToPointer(1), Active: fastly.ToPointer(true)},
+				{Number: fastly.ToPointer(2), Locked: fastly.ToPointer(true)},
+			}, nil
+		},
+		CloneVersionFn: testutil.CloneVersionResult(3),
+	}
+	_, _, r, err = argparser.ResolveServiceDetails(opts)
+	testutil.AssertErrorContains(t, err, "service 123 has no editable version")
+	testutil.AssertString(t, "active version (no version is editable)", r.VersionRule)
+	testutil.AssertString(t, "", notices.String())
+
+	opts.AutoCloneFlag.WasSet = true
+	opts.AutoCloneFlag.Value = true
+	_, v, r, err = argparser.ResolveServiceDetails(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 3, fastly.ToValue(v.Number))
+	testutil.AssertEqual(t, 1, r.ClonedFrom)
+	testutil.AssertStringContains(t, notices.String(), "Cloned version 1 of service 123 to create editable version 3.")
+
+	// A deleted version, or a draft older than the active version, isn't
+	// editable, so the active version is cloned.
+	deleted := time.Now()
+	opts.APIClient = mock.API{
+		ListVersionsFn: func(_ *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+			return []*fastly.Version{
+				{Number: fastly.ToPointer(1)},
+				{Number: fastly.ToPointer(2), Active: fastly.ToPointer(true)},
+				{Number: fastly.ToPointer(3), DeletedAt: &deleted},
+			}, nil
+		},
+		CloneVersionFn: testutil.CloneVersionResult(4),
+	}
+	_, v, r, err = argparser.ResolveServiceDetails(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 4, fastly.ToValue(v.Number))
+	testutil.AssertEqual(t, 2, r.ClonedFrom)
+	testutil.AssertString(t, "active version (no version is editable)", r.VersionRule)
+}
+
+func TestGetEditableVersion(t *testing.T) {
+	deleted := time.Now()
+	for _, testcase := range []struct {
+		name     string
+		versions []*fastly.Version
+		want     int
+	}{
+		{
+			name: "draft newer than the active version",
+			versions: []*fastly.Version{
+				{Number: fastly.ToPointer(3)},
+				{Number: fastly.ToPointer(2), Locked: fastly.ToPointer(true)},
+				{Number: fastly.ToPointer(1), Active: fastly.ToPointer(true)},
+			},
+			want: 3,
+		},
+		{
+			name: "deleted draft is skipped",
+			versions: []*fastly.Version{
+				{Number: fastly.ToPointer(3), DeletedAt: &deleted},
+				{Number: fastly.ToPointer(2)},
+				{Number: fastly.ToPointer(1), Active: fastly.ToPointer(true)},
+			},
+			want: 2,
+		},
+		{
+			name: "draft older than the active version is skipped",
+			versions: []*fastly.Version{
+				{Number: fastly.ToPointer(2), Active: fastly.ToPointer(true)},
+				{Number: fastly.ToPointer(1)},
+			},
+		},
+		{
+			name: "no active version",
+			versions: []*fastly.Version{
+				{Number: fastly.ToPointer(2), Locked: fastly.ToPointer(true)},
+				{Number: fastly.ToPointer(1)},
+			},
+			want: 1,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			v := argparser.GetEditableVersion(testcase.versions)
+			if testcase.want == 0 {
+				if v != nil {
+					t.Fatalf("want no editable version, have %d", fastly.ToValue(v.Number))
+				}
+				return
+			}
+			if v == nil {
+				t.Fatalf("want editable version %d, have none", testcase.want)
+			}
+			testutil.AssertEqual(t, testcase.want, fastly.ToValue(v.Number))
+		})
+	}
+}
+
+func TestApplyFilter(t *testing.T) {
+	created := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	records := []*fastly.Cloudfiles{
+		{Name: fastly.ToPointer("logs"), Region: fastly.ToPointer("DFW"), GzipLevel: fastly.ToPointer(9), CreatedAt: &created},
+		{Name: fastly.ToPointer("analytics"), Region: fastly.ToPointer("ORD"), GzipLevel: fastly.ToPointer(0)},
+		{Name: fastly.ToPointer("archive"), GzipLevel: fastly.ToPointer(3)},
+	}
+
+	scenarios := []struct {
+		filter    string
+		want      []string
+		wantError string
+	}{
+		{filter: "", want: []string{"logs", "analytics", "archive"}},
+		{filter: "region=DFW,gzip_level>0", want: []string{"logs"}},
+		{filter: "gzip_level > 0", want: []string{"logs", "archive"}},
+		{filter: "gzip_level<9", want: []string{"analytics", "archive"}},
+		{filter: "region!=DFW", want: []string{"analytics", "archive"}},
+		{filter: "region=", want: []string{"archive"}},
+		{filter: "name~ar", want: []string{"archive"}},
+		{filter: "Name~a", want: []string{"analytics", "archive"}},
+		{filter: "created_at>2024-01-01T00:00:00Z", want: []string{"logs"}},
+		{filter: "region=XYZ"},
+		{filter: "zone=DFW", wantError: "invalid --filter field 'zone'"},
+		{filter: "region", wantError: "invalid --filter comparison 'region'"},
+		{filter: "=DFW", wantError: "invalid --filter comparison '=DFW'"},
+		{filter: "gzip_level>high", wantError: "'high' isn't a number"},
+		{filter: "gzip_level~9", wantError: "the ~ operator requires a string field"},
+		{filter: "created_at>yesterday", wantError: "'yesterday' isn't an RFC 3339 time"},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.filter, func(t *testing.T) {
+			got, err := argparser.ApplyFilter(argparser.ListFilter{Filter: testcase.filter}, records)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			var names []string
+			for _, r := range got {
+				names = append(names, fastly.ToValue(r.Name))
+			}
+			testutil.AssertEqual(t, testcase.want, names)
+		})
+	}
+
+	// An invalid field is reported even if there are no records.
+	_, err := argparser.ApplyFilter(argparser.ListFilter{Filter: "zone=DFW"}, []*fastly.Cloudfiles{})
+	testutil.AssertRemediationErrorContains(t, err, "region")
+
+	// No matching records are output as an empty list (rather than null).
+	got, err := argparser.ApplyFilter(argparser.ListFilter{Filter: "region=XYZ"}, records)
+	testutil.AssertNoError(t, err)
+	b, err := json.Marshal(got)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "[]", string(b))
+}
+
+func TestRejectedFields(t *testing.T) {
+	type input struct {
+		NewName *string             `url:"name,omitempty"`
+		Period  *int                `url:"period,omitempty"`
+		Path    *string             `url:"path,omitempty"`
+		Gzip    *fastly.Compatibool `url:"gzip,omitempty"`
+		Secret  *string             `url:"secret,omitempty"`
+	}
+	type resource struct {
+		Name   *string `mapstructure:"name"`
+		Period *int    `mapstructure:"period"`
+		Path   *string `mapstructure:"path"`
+		Gzip   *bool   `mapstructure:"gzip"`
+	}
+	after := &resource{Name: fastly.ToPointer("logs"), Period: fastly.ToPointer(3600), Gzip: fastly.ToPointer(true)}
+
+	in := &input{
+		NewName: fastly.ToPointer("logs"),
+		Period:  fastly.ToPointer(60),
+		Path:    fastly.ToPointer("/"),
+		Gzip:    fastly.ToPointer(fastly.Compatibool(false)),
+		Secret:  fastly.ToPointer("s3cr3t"),
+	}
+	testutil.AssertEqual(t, []string{"gzip", "period"}, argparser.RejectedFields(in, after))
+
+	in = &input{Period: fastly.ToPointer(3600), Gzip: fastly.ToPointer(fastly.Compatibool(true))}
+	testutil.AssertEqual(t, 0, len(argparser.RejectedFields(in, after)))
+
+	testutil.AssertEqual(t, 0, len(argparser.RejectedFields((*input)(nil), after)))
+	testutil.AssertEqual(t, 0, len(argparser.RejectedFields(in, nil)))
+}
+
+func TestPendingChanges(t *testing.T) {
+	type input struct {
+		NewName *string `url:"name,omitempty"`
+		Period  *int    `url:"period,omitempty"`
+		Path    *string `url:"path,omitempty"`
+		Secret  *string `url:"secret,omitempty"`
+	}
+	type resource struct {
+		Name   *string `mapstructure:"name"`
+		Period *int    `mapstructure:"period"`
+		Path   *string `mapstructure:"path"`
+	}
+	before := &resource{Name: fastly.ToPointer("logs"), Period: fastly.ToPointer(3600)}
+
+	in := &input{NewName: fastly.ToPointer("logs"), Period: fastly.ToPointer(3600)}
+	testutil.AssertEqual(t, 0, len(argparser.PendingChanges(in, before)))
+
+	// A field the resource doesn't have a value for (or doesn't include) can't
+	// be verified, so it's reported.
+	in = &input{Period: fastly.ToPointer(60), Path: fastly.ToPointer("/"), Secret: fastly.ToPointer("s3cr3t")}
+	testutil.AssertEqual(t, []string{"path", "period", "secret"}, argparser.PendingChanges(in, before))
+}
@@ -0,0 +1,342 @@
+package argparser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/fastly/kingpin"
+	"gopkg.in/yaml.v3"
+
+	"github.com/fastly/cli/pkg/text"
+)
+
+const (
+	// FormatJSON is the name of the JSON output format (also set via --json).
+	FormatJSON = "json"
+	// FormatYAML is the name of the YAML output format.
+	FormatYAML = "yaml"
+	// FormatCSV is the name of the CSV output format.
+	FormatCSV = "csv"
+	// FormatLogfmt is the name of the single-line logfmt output format.
+	FormatLogfmt = "logfmt"
+	// FormatTemplate is the name of the output format selected by the
+	// --template-file flag.
+	FormatTemplate = "template"
+)
+
+// Formatter writes a value in a structured output format selected with the
+// --format flag.
+type Formatter interface {
+	Format(out io.Writer, value any) error
+}
+
+// FormatterFunc adapts a function to the Formatter interface.
+type FormatterFunc func(out io.Writer, value any) error
+
+// Format implements the Formatter interface.
+func (f FormatterFunc) Format(out io.Writer, value any) error {
+	return f(out, value)
+}
+
+var (
+	formattersMu sync.RWMutex
+	formatters   = map[string]Formatter{
+		FormatCSV:    FormatterFunc(WriteCSV),
+		FormatLogfmt: FormatterFunc(writeLogfmt),
+		FormatYAML:   FormatterFunc(writeYAML),
+	}
+)
+
+// RegisterFormatter makes a structured output format available to the
+// commands that list it in RegisterFormatFlags.
+//
+// NOTE: The JSON format is built into JSONOutput (as it's affected by the
+// --json-compact, --json-pretty and --pointer flags) and can't be replaced.
+func RegisterFormatter(name string, f Formatter) error {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	if _, ok := formatters[name]; ok || name == FormatJSON {
+		return fmt.Errorf("output format '%s' is already registered", name)
+	}
+	formatters[name] = f
+	return nil
+}
+
+// LookupFormatter returns the registered Formatter for the named format.
+func LookupFormatter(name string) (Formatter, bool) {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	f, ok := formatters[name]
+	return f, ok
+}
+
+// FormatterNames returns the names of the registered formats (including the
+// built-in JSON format), sorted.
+func FormatterNames() []string {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	names := []string{FormatJSON}
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterFormatFlags defines the --format flag, accepting the given formats,
+// along with --json as an alias for --format json (and likewise --csv and
+// --yaml, if those formats are accepted) and --field to select the fields
+// written. It replaces the --json flag registered via JSONFlag.
+func (j *JSONOutput) RegisterFormatFlags(cmd *kingpin.CmdClause, formats ...string) {
+	for _, name := range formats {
+		if _, ok := LookupFormatter(name); !ok && name != FormatJSON {
+			panic(fmt.Sprintf("unregistered output format: %s", name))
+		}
+	}
+	cmd.Flag("format", fmt.Sprintf("Render output in a structured format. One of: %s", strings.Join(formats, ", "))).HintOptions(formats...).Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		if j.Enabled && j.Format != FormatJSON {
+			return fmt.Errorf("--%s is an alias for --format %s and can't be combined with --format %s", FlagJSONName, FormatJSON, j.Format)
+		}
+		j.Enabled = true
+		return nil
+	}).EnumVar(&j.Format, formats...)
+	cmd.Flag(FlagJSONName, fmt.Sprintf("%s (alias for --format %s)", FlagJSONDesc, FormatJSON)).Short('j').BoolVar(&j.Enabled)
+	if slices.Contains(formats, FormatCSV) {
+		j.registerFormatAlias(cmd, FormatCSV, FlagCSVDesc, &j.csv)
+	}
+	if slices.Contains(formats, FormatYAML) {
+		j.registerFormatAlias(cmd, FormatYAML, FlagYAMLDesc, &j.yaml)
+	}
+	j.RegisterFieldFlag(cmd)
+	cmd.Flag("template-file", "Render output with the Go template in the given file (the template's data is the --json output)").Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		switch {
+		case j.Format != "":
+			return fmt.Errorf("--template-file can't be combined with --format")
+		case j.Enabled:
+			return fmt.Errorf("--template-file can't be combined with --%s", FlagJSONName)
+		}
+		t, err := parseTemplateFile(j.templateFile)
+		if err != nil {
+			return err
+		}
+		j.Enabled = true
+		j.Format = FormatTemplate
+		j.template = t
+		return nil
+	}).StringVar(&j.templateFile)
+}
+
+// RegisterFieldFlag defines the --field flag to select the fields written by
+// WriteOutput. It's registered by RegisterFormatFlags, so it only needs to be
+// registered separately by a command using JSONFlag.
+func (j *JSONOutput) RegisterFieldFlag(cmd *kingpin.CmdClause) {
+	cmd.Flag("field", "Only render the given field of the structured output, as named in the --json output (repeat to select multiple). Implies --json if no other format is set").Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		// NOTE: The flag values are set before any action runs.
+		if j.Format == "" && !j.csv && !j.yaml && j.templateFile == "" {
+			j.Enabled = true
+		}
+		return nil
+	}).StringsVar(&j.Fields)
+}
+
+// RegisterYAMLFlag defines the --yaml flag for a command that otherwise only
+// supports JSON output (see JSONFlag), as an alias for --format yaml.
+func (j *JSONOutput) RegisterYAMLFlag(cmd *kingpin.CmdClause) {
+	j.registerFormatAlias(cmd, FormatYAML, FlagYAMLDesc, &j.yaml)
+}
+
+// registerFormatAlias defines a flag named after the format as an alias for
+// --format <format>.
+//
+// NOTE: The flag can be negated (e.g. --no-yaml), in which case the format
+// isn't selected. The action runs once the flag values are set, so dst holds
+// the final value.
+func (j *JSONOutput) registerFormatAlias(cmd *kingpin.CmdClause, format, desc string, dst *bool) {
+	cmd.Flag(format, fmt.Sprintf("%s (alias for --format %s)", desc, format)).Action(func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+		switch {
+		case !*dst:
+			return nil
+		case j.Format != "" && j.Format != format:
+			return fmt.Errorf("--%s is an alias for --format %s and can't be combined with --format %s", format, format, j.Format)
+		case j.Enabled && j.Format == "":
+			return fmt.Errorf("--%s can't be combined with --%s", format, FlagJSONName)
+		}
+		j.Enabled = true
+		j.Format = format
+		return nil
+	}).NegatableBoolVar(dst)
+}
+
+// parseTemplateFile parses the Go template in the file at path, so that an
+// invalid template is reported before the command runs.
+//
+// NOTE: A missing key is an error (rather than rendering "<no value>") so a
+// typo in a template isn't silently ignored.
+func parseTemplateFile(path string) (*template.Template, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the path is provided by the user.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading template file: %w", err)
+	}
+	t, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template file: %w", err)
+	}
+	return t, nil
+}
+
+// writeFormat writes value using the Formatter registered for the format (or
+// the template loaded via --template-file).
+func (j *JSONOutput) writeFormat(out io.Writer, value any) error {
+	var f Formatter
+	if j.Format == FormatTemplate && j.template != nil {
+		f = FormatterFunc(j.writeTemplate)
+	} else if rf, ok := LookupFormatter(j.Format); ok {
+		f = rf
+	} else {
+		return fmt.Errorf("unsupported output format: %s", j.Format)
+	}
+	if j.Pointer != "" {
+		v, err := ResolveJSONPointer(value, j.Pointer)
+		if err != nil {
+			return err
+		}
+		value = v
+	}
+	if j.Format == FormatCSV && j.columns != nil {
+		return writeCSV(out, value, j.columns)
+	}
+	return f.Format(out, value)
+}
+
+// writeTemplate executes the template loaded via --template-file. Like YAML,
+// the value is first converted via JSON so the template references the same
+// keys as the --json output.
+func (j *JSONOutput) writeTemplate(out io.Writer, value any) error {
+	doc, err := jsonDocument(value)
+	if err != nil {
+		return err
+	}
+	if err := j.template.Execute(out, doc); err != nil {
+		return fmt.Errorf("error executing template file: %w", err)
+	}
+	return nil
+}
+
+// jsonDocument converts value into the generic representation of its JSON
+// encoding (i.e. maps, slices and scalars).
+//
+// NOTE: Integers are decoded as int64, rather than float64, so large values
+// aren't rendered in exponent form (e.g. 1e+07).
+func jsonDocument(value any) (any, error) {
+	doc, err := decodeJSONDocument(value)
+	if err != nil {
+		return nil, err
+	}
+	return convertJSONNumbers(doc), nil
+}
+
+// convertJSONNumbers replaces each json.Number in v with an int64, if it's an
+// integer, or a float64.
+func convertJSONNumbers(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			t[k] = convertJSONNumbers(e)
+		}
+	case []any:
+		for i, e := range t {
+			t[i] = convertJSONNumbers(e)
+		}
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		if f, err := t.Float64(); err == nil {
+			return f
+		}
+		return t.String()
+	}
+	return v
+}
+
+// writeYAML writes value as YAML. The value is first converted via JSON so
+// the keys (and their casing) match the --json output.
+func writeYAML(out io.Writer, value any) error {
+	doc, err := jsonDocument(value)
+	if err != nil {
+		return err
+	}
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// writeLogfmt writes value as a single logfmt line (see text.PrintLogfmt), or
+// a line per element if value is a list (e.g. describing multiple resources).
+// Like YAML, the value is first converted via JSON so the keys match the
+// --json output, and nested keys are joined with a dot.
+func writeLogfmt(out io.Writer, value any) error {
+	doc, err := jsonDocument(value)
+	if err != nil {
+		return err
+	}
+	items, ok := doc.([]any)
+	if !ok {
+		items = []any{doc}
+	}
+	for _, item := range items {
+		var lines []text.Line
+		if _, ok := item.(map[string]any); ok {
+			lines = logfmtLines("", item, lines)
+		} else {
+			lines = logfmtLines("value", item, lines)
+		}
+		text.PrintLogfmt(out, lines)
+	}
+	return nil
+}
+
+// logfmtLines appends the flattened key/value pairs of v to lines, sorted by
+// key.
+func logfmtLines(key string, v any, lines []text.Line) []text.Line {
+	join := func(k string) string {
+		if key == "" {
+			return k
+		}
+		return key + "." + k
+	}
+	switch t := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			lines = logfmtLines(join(k), t[k], lines)
+		}
+	case []any:
+		for i, e := range t {
+			lines = logfmtLines(join(strconv.Itoa(i)), e, lines)
+		}
+	default:
+		lines = append(lines, text.Line{Key: key, Value: v})
+	}
+	return lines
+}
@@ -0,0 +1,111 @@
+package argparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Supported --input-format values.
+const (
+	InputFormatJSON = "json"
+	InputFormatYAML = "yaml"
+)
+
+// InputFormats is the list of supported --input-format values.
+var InputFormats = []string{InputFormatJSON, InputFormatYAML}
+
+// DetectInputFormat returns the format of a file based on its extension,
+// falling back to inspecting its content (a JSON document must start with
+// either '{' or '['), otherwise YAML is assumed.
+func DetectInputFormat(path string, data []byte) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return InputFormatJSON
+	case ".yaml", ".yml":
+		return InputFormatYAML
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return InputFormatJSON
+	}
+	return InputFormatYAML
+}
+
+// DecodeInput decodes the file content into v using the given format (one of
+// InputFormats), or if format is empty the format is detected using
+// DetectInputFormat.
+//
+// The value v acts as the schema: its `json` struct tags are used for both
+// JSON and YAML input, and unknown fields are rejected. Decoding errors report
+// the line and column (where available) so the problem can be located.
+func DecodeInput(path string, data []byte, format string, v any) error {
+	if format == "" {
+		format = DetectInputFormat(path, data)
+	}
+
+	switch format {
+	case InputFormatJSON:
+		return decodeJSONInput(path, data, v)
+	case InputFormatYAML:
+		// NOTE: YAML is decoded into a generic document and then re-encoded as
+		// JSON so the same struct tags and validation apply to both formats.
+		var doc any
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("error parsing YAML file '%s': %w", path, err)
+		}
+		j, err := json.Marshal(doc)
+		if err != nil {
+			return fmt.Errorf("error parsing YAML file '%s': %w", path, err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(j))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(v); err != nil {
+			return fmt.Errorf("error parsing YAML file '%s': %w", path, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported input format '%s' (supported: %s)", format, strings.Join(InputFormats, ", "))
+}
+
+// decodeJSONInput decodes JSON data into v, annotating any error with the
+// line and column it occurred at.
+func decodeJSONInput(path string, data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err == nil {
+		return nil
+	}
+
+	var (
+		offset    int64 = -1
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	}
+	if offset < 1 || offset > int64(len(data)) {
+		return fmt.Errorf("error parsing JSON file '%s': %w", path, err)
+	}
+	// NOTE: The offset is the number of bytes read, so the last byte read is
+	// the one the error occurred at.
+	line, col := lineColumn(data[:offset-1])
+	return fmt.Errorf("error parsing JSON file '%s' (line %d, column %d): %w", path, line, col, err)
+}
+
+// lineColumn returns the 1-based line and column of the byte following data.
+func lineColumn(data []byte) (line, col int) {
+	line = 1 + bytes.Count(data, []byte("\n"))
+	col = len(data) - bytes.LastIndexByte(data, '\n')
+	return line, col
+}
@@ -0,0 +1,179 @@
+package argparser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/fastly/kingpin"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+const (
+	// DefaultPageSize is the number of records fetched per request when
+	// --page-size isn't set (as defaulted by the API client library).
+	DefaultPageSize = 100
+	// MinPageSize is the smallest page size the API accepts.
+	MinPageSize = 1
+	// MaxPageSize is the largest page size the API accepts.
+	MaxPageSize = 1000
+)
+
+// PaginationOutput is a helper for adding `--no-autopaginate`, `--limit` and
+// `--page-size` flags to paginated list commands. It can be embedded into
+// command structs.
+type PaginationOutput struct {
+	Limit          int         // Set via flag.
+	NoAutopaginate bool        // Set via flag.
+	PageSize       OptionalInt // Set via flag.
+}
+
+// LimitRecordsFlag creates a flag for capping the total number of records
+// fetched across all pages. A negative value is rejected.
+func (p *PaginationOutput) LimitRecordsFlag() IntFlagOpts {
+	return IntFlagOpts{
+		Action: func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+			if p.Limit < 0 {
+				return fmt.Errorf("--%s must not be negative (use 0 for no limit): %d", FlagLimitRecordsName, p.Limit)
+			}
+			return nil
+		},
+		Name:        FlagLimitRecordsName,
+		Description: FlagLimitRecordsDesc,
+		Dst:         &p.Limit,
+	}
+}
+
+// NoAutopaginateFlag creates a flag for fetching only the first page.
+//
+// NOTE: Kingpin parses a bool flag whose name starts with "no-" as a negated
+// flag (i.e. false), so the value is set by the action instead.
+func (p *PaginationOutput) NoAutopaginateFlag() BoolFlagOpts {
+	return BoolFlagOpts{
+		Action: func(_ *kingpin.ParseElement, _ *kingpin.ParseContext) error {
+			p.NoAutopaginate = true
+			return nil
+		},
+		Name:        FlagNoAutopaginateName,
+		Description: FlagNoAutopaginateDesc,
+		Dst:         &p.NoAutopaginate,
+	}
+}
+
+// PageSizeFlag creates a flag for the number of records fetched per request.
+func (p *PaginationOutput) PageSizeFlag() IntFlagOpts {
+	return IntFlagOpts{
+		Action:      p.PageSize.Set,
+		Name:        FlagPageSizeName,
+		Description: FlagPageSizeDesc,
+		Dst:         &p.PageSize.Value,
+	}
+}
+
+// PerPageFlag creates the --per-page flag, an alias of --page-size kept for
+// the commands that defined it before --page-size was introduced.
+func (p *PaginationOutput) PerPageFlag() IntFlagOpts {
+	return IntFlagOpts{
+		Action:      p.PageSize.Set,
+		Name:        "per-page",
+		Description: "Number of records per page (alias of --" + FlagPageSizeName + ")",
+		Dst:         &p.PageSize.Value,
+	}
+}
+
+// PageSizeInput returns the page size to set on the input of a paginated list
+// call, or nil if --page-size isn't set (so the API's default is used).
+//
+// A value outside the range the API accepts (including zero or a negative
+// value) is clamped to it, with a warning. The effective page size is
+// displayed in verbose mode.
+func (p *PaginationOutput) PageSizeInput(g *global.Data, out io.Writer) *int {
+	if !p.PageSize.WasSet {
+		if g.Verbose() {
+			text.Output(out, "Page size: %d (default)", DefaultPageSize)
+			text.Break(out)
+		}
+		return nil
+	}
+
+	size := min(max(p.PageSize.Value, MinPageSize), MaxPageSize)
+	if size != p.PageSize.Value {
+		msg := fmt.Sprintf("--%s %d is outside the range the API accepts (%d-%d), so %d is used.", FlagPageSizeName, p.PageSize.Value, MinPageSize, MaxPageSize, size)
+		if g.Flags.Quiet {
+			text.Warnings.Add(msg)
+		} else {
+			text.Warning(out, "%s\n\n", msg)
+		}
+	}
+	if g.Verbose() {
+		text.Output(out, "Page size: %d", size)
+		text.Break(out)
+	}
+	return &size
+}
+
+// DisplayTruncated writes a notice to out explaining the results were
+// truncated (i.e. more records are available than were fetched).
+func (p *PaginationOutput) DisplayTruncated(out io.Writer, shown int, truncated bool) {
+	if !truncated {
+		return
+	}
+	if p.Limit > 0 && shown == p.Limit {
+		text.Info(out, "\nResults are limited to %d by --%s. More results are available: increase (or remove) --%s to fetch them.", shown, FlagLimitRecordsName, FlagLimitRecordsName)
+		return
+	}
+	text.Info(out, "\nOnly the first page of results was fetched. More results are available: remove --%s (or use --page) to fetch them.", FlagNoAutopaginateName)
+}
+
+// Paginate fetches the pages from the paginator, respecting the
+// --no-autopaginate and --limit flags, and reports whether the results were
+// truncated because more records are available.
+//
+// If the first page can't be fetched its error is returned as-is. If a later
+// page fails, the records already retrieved are returned alongside a
+// fsterr.PaginationError so they can still be displayed.
+//
+// The errors are recorded in errLog along with the logContext.
+func Paginate[T any](p PaginationOutput, paginator *fastly.ListPaginator[T], errLog fsterr.LogInterface, logContext map[string]any) (records []*T, truncated bool, err error) {
+	var pages int
+	for paginator.HasNext() {
+		data, err := paginator.GetNext()
+		if err != nil {
+			ctx := map[string]any{
+				"Page":            paginator.CurrentPage,
+				"Remaining Pages": paginator.Remaining(),
+			}
+			for k, v := range logContext {
+				ctx[k] = v
+			}
+			errLog.AddWithContext(err, ctx)
+			if pages == 0 {
+				return nil, false, err
+			}
+			// NOTE: Display the pages already retrieved rather than discarding them.
+			return records, false, fsterr.PaginationError{Page: paginator.CurrentPage, Err: err}
+		}
+		pages++
+		records = append(records, data...)
+
+		if p.Limit > 0 && len(records) >= p.Limit {
+			truncated = len(records) > p.Limit || paginator.HasNext()
+			return records[:p.Limit], truncated, nil
+		}
+		if p.NoAutopaginate {
+			return records, paginator.HasNext(), nil
+		}
+	}
+	return records, false, nil
+}
+
+// IsPartialResult indicates if the error returned by Paginate occurred after
+// one or more pages were retrieved (and so the records should be displayed).
+func IsPartialResult(err error) bool {
+	var pe fsterr.PaginationError
+	return errors.As(err, &pe)
+}
@@ -0,0 +1,155 @@
+package argparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// PatchFromFile reads a JSON or YAML object of flag names to values and sets
+// each of the corresponding flags. Only the fields present in the file are
+// set, which allows a partial update of a resource: a field set to its zero
+// value (e.g. `gzip-level: 0`) is still applied, while an absent field is left
+// untouched.
+//
+// The fields map is the schema: each key is a flag name and each value is the
+// *OptionalString or *OptionalInt the flag populates, or a PatchAlias of
+// another key. Keys may use either hyphens or underscores (e.g. gzip-level or
+// gzip_level).
+//
+// NOTE: A flag can't be provided both directly and via the patch file.
+func PatchFromFile(path, format string, fields map[string]any) error {
+	data, err := readPatchFile(path)
+	if err != nil {
+		return err
+	}
+
+	var patch map[string]json.RawMessage
+	if err := DecodeInput(path, data, format, &patch); err != nil {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid --%s file: %w", FlagFromFileName, err),
+			Remediation: `The file should be a JSON (or YAML) object of flag names to values, e.g. {"period": 60}.`,
+		}
+	}
+	return ApplyPatch(path, patch, fields)
+}
+
+// PatchAlias is the value of a fields entry (see PatchFromFile) that's another
+// name for the flag it holds, e.g. the API's name for a field whose flag is
+// named differently (bucket_name for --bucket).
+type PatchAlias string
+
+// PatchesFromFile reads a JSON or YAML file that's either an object of flag
+// names to values (a single patch, see PatchFromFile) or a list of them, for a
+// command that handles each item of a list separately (e.g. creating multiple
+// resources). list reports whether the file is a list.
+//
+// Each patch is applied via ApplyPatch.
+func PatchesFromFile(path, format string) (patches []map[string]json.RawMessage, list bool, err error) {
+	data, err := readPatchFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var doc json.RawMessage
+	if err := DecodeInput(path, data, format, &doc); err != nil {
+		return nil, false, fmt.Errorf("invalid --%s file: %w", FlagFromFileName, err)
+	}
+	if trimmed := bytes.TrimSpace(doc); len(trimmed) > 0 && trimmed[0] == '[' {
+		list = true
+		err = json.Unmarshal(doc, &patches)
+	} else {
+		var patch map[string]json.RawMessage
+		err = json.Unmarshal(doc, &patch)
+		patches = []map[string]json.RawMessage{patch}
+	}
+	if err != nil {
+		return nil, false, fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid --%s file: %w", FlagFromFileName, err),
+			Remediation: `The file should be a JSON (or YAML) object of flag names to values, e.g. {"period": 60}, or a list of them.`,
+		}
+	}
+	return patches, list, nil
+}
+
+// readPatchFile reads the --from-file file at path.
+func readPatchFile(path string) ([]byte, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we require a user to configure their own environment.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading --%s file: %w", FlagFromFileName, err)
+	}
+	return data, nil
+}
+
+// ApplyPatch sets each of the flags named in patch (read from the --from-file
+// file at path) to its value. The fields map is the schema (see
+// PatchFromFile).
+func ApplyPatch(path string, patch map[string]json.RawMessage, fields map[string]any) error {
+	supported := make([]string, 0, len(fields))
+	for name := range fields {
+		supported = append(supported, name)
+	}
+	sort.Strings(supported)
+
+	keys := make([]string, 0, len(patch))
+	for k := range patch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// applied is the key that set each flag, so a flag set by a key and its
+	// alias is reported.
+	applied := make(map[string]string, len(keys))
+	for _, k := range keys {
+		name := strings.ReplaceAll(k, "_", "-")
+		field, ok := fields[name]
+		if alias, isAlias := field.(PatchAlias); isAlias {
+			name = string(alias)
+			field, ok = fields[name]
+		}
+		if !ok {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("unsupported field '%s' in --%s file '%s'", k, FlagFromFileName, path),
+				Remediation: fmt.Sprintf("Supported fields: %s.", strings.Join(supported, ", ")),
+			}
+		}
+		if other, ok := applied[name]; ok {
+			return fmt.Errorf("fields '%s' and '%s' in --%s file '%s' both set --%s", other, k, FlagFromFileName, path, name)
+		}
+		applied[name] = k
+		if string(patch[k]) == "null" {
+			return fmt.Errorf("field '%s' in --%s file '%s' can't be null", k, FlagFromFileName, path)
+		}
+
+		var (
+			optional *Optional
+			dst      any
+			kind     string
+		)
+		switch f := field.(type) {
+		case *OptionalString:
+			optional, dst, kind = &f.Optional, &f.Value, "a string"
+		case *OptionalInt:
+			optional, dst, kind = &f.Optional, &f.Value, "an integer"
+		default:
+			return fmt.Errorf("unsupported field type %T for --%s", field, name)
+		}
+		if optional.WasSet {
+			return fmt.Errorf("flag --%s was provided both directly and via --%s", name, FlagFromFileName)
+		}
+		if err := json.Unmarshal(patch[k], dst); err != nil {
+			return fmt.Errorf("field '%s' in --%s file '%s' must be %s", k, FlagFromFileName, path, kind)
+		}
+		optional.WasSet = true
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package argparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// ParseJSONPointer splits an RFC 6901 JSON Pointer (e.g. /Versions/0/Number)
+// into its unescaped reference tokens. The empty pointer refers to the whole
+// document and so has no tokens.
+func ParseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid JSON pointer %q: must be empty or start with '/'", pointer),
+			Remediation: "Use RFC 6901 syntax, e.g. --pointer /ServiceID or --pointer /Versions/0/Number.",
+		}
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		// NOTE: ~1 must be replaced before ~0 (e.g. ~01 is a literal ~1).
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// ResolveJSONPointer returns the value the pointer refers to within value,
+// once value has been marshaled to JSON.
+func ResolveJSONPointer(value any, pointer string) (any, error) {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	for i, t := range tokens {
+		at := "/" + strings.Join(escapeJSONPointer(tokens[:i]), "/")
+		switch v := doc.(type) {
+		case map[string]any:
+			next, ok := v[t]
+			if !ok {
+				return nil, unresolvedPointer(pointer, fmt.Errorf("no field %q at %s", t, at))
+			}
+			doc = next
+		case []any:
+			idx, err := strconv.Atoi(t)
+			if err != nil || idx < 0 || (len(t) > 1 && t[0] == '0') {
+				return nil, unresolvedPointer(pointer, fmt.Errorf("%q isn't a valid array index at %s", t, at))
+			}
+			if idx >= len(v) {
+				return nil, unresolvedPointer(pointer, fmt.Errorf("index %d is out of range at %s (length %d)", idx, at, len(v)))
+			}
+			doc = v[idx]
+		default:
+			return nil, unresolvedPointer(pointer, fmt.Errorf("the value at %s isn't an object or array", at))
+		}
+	}
+	return doc, nil
+}
+
+// WriteJSONPointer resolves the pointer against value and writes the result
+// to out. Strings, numbers and booleans are written bare (i.e. without quotes)
+// so they can be captured by a shell, while objects and arrays are written as
+// JSON.
+func (j *JSONOutput) WriteJSONPointer(out io.Writer, value any) error {
+	v, err := ResolveJSONPointer(value, j.Pointer)
+	if err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case string:
+		_, err = fmt.Fprintln(out, v)
+		return err
+	case json.Number, bool:
+		_, err = fmt.Fprintln(out, v)
+		return err
+	case nil:
+		_, err = fmt.Fprintln(out, "null")
+		return err
+	}
+	return j.encodeJSON(out, v)
+}
+
+// escapeJSONPointer escapes reference tokens for display.
+func escapeJSONPointer(tokens []string) []string {
+	escaped := make([]string, len(tokens))
+	for i, t := range tokens {
+		escaped[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1")
+	}
+	return escaped
+}
+
+// unresolvedPointer wraps the reason a pointer doesn't resolve.
+func unresolvedPointer(pointer string, err error) error {
+	return fsterr.RemediationError{
+		Inner:       fmt.Errorf("JSON pointer %q doesn't resolve: %w", pointer, err),
+		Remediation: "Check the pointer against the command's --json output.",
+	}
+}
@@ -0,0 +1,85 @@
+package argparser
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"runtime"
+	"sync"
+	"syscall"
+
+	"github.com/fastly/cli/pkg/api"
+)
+
+// BulkPoolSize is the most workers a bulk operation uses by default. It's
+// deliberately small so that a bulk operation doesn't exhaust the API rate
+// limit (or trigger it with a burst of requests).
+const BulkPoolSize int = 16
+
+// bulkWorkersPerCPU is the number of workers per CPU used by default. The
+// workers mostly wait on the network, so there can be several per CPU.
+const bulkWorkersPerCPU int = 4
+
+// PoolSize returns the number of workers a bulk operation should use.
+//
+// concurrency is the --concurrency value: when it's zero (or less) the
+// default is BulkPoolSize, reduced on a host with few CPUs. The result is
+// capped by the number of modifying requests the API reported are remaining
+// (when rl has observed a rate limit), and is never less than one.
+func PoolSize(concurrency int, rl *api.RateLimit) int {
+	size := concurrency
+	if size <= 0 {
+		size = min(BulkPoolSize, runtime.GOMAXPROCS(0)*bulkWorkersPerCPU)
+	}
+	if remaining, ok := rl.Remaining(); ok {
+		size = min(size, remaining)
+	}
+	return max(size, 1)
+}
+
+// BulkContext returns a context for a bulk operation, which is canceled when
+// the user interrupts the process (Ctrl-C) or it's terminated, so that no
+// further items are processed and those processed so far can be reported.
+//
+// The caller must call stop once the operation is complete.
+func BulkContext() (ctx context.Context, stop context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// RunPool calls fn for each item received from items, using size workers,
+// until items is closed. It returns the errors returned by fn, joined (see
+// errors.Join).
+//
+// If ctx is canceled the workers stop calling fn and the remaining items are
+// drained (so the producer isn't blocked), then ctx.Err() is included in the
+// returned error. The producer should stop sending once ctx is done.
+func RunPool[T any](ctx context.Context, size int, items <-chan T, fn func(ctx context.Context, item T) error) error {
+	var (
+		errs []error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	for range max(size, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range items {
+				if ctx.Err() != nil {
+					continue
+				}
+				if err := fn(ctx, item); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
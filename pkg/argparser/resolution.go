@@ -0,0 +1,121 @@
+package argparser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+)
+
+// maxDisplayedVersionCandidates is the number of (most recent) candidate
+// versions included when a ServiceResolution is formatted as text.
+const maxDisplayedVersionCandidates = 10
+
+// ServiceResolution records how ServiceDetails resolved the Service ID and
+// Service Version, so "wrong service/version" issues can be diagnosed.
+type ServiceResolution struct {
+	// ServiceID is the resolved Service ID.
+	ServiceID string `json:"service_id"`
+	// ServiceIDSource is where the Service ID came from (e.g. --service-id).
+	ServiceIDSource string `json:"service_id_source"`
+	// IgnoredServiceIDs are the different Service IDs defined by lower
+	// priority sources, which were overridden.
+	IgnoredServiceIDs []ServiceIDCandidate `json:"ignored_service_ids,omitempty"`
+	// VersionSource is where the requested version came from (--version, the
+	// .fastly-version lockfile or the default).
+	VersionSource string `json:"version_source,omitempty"`
+	// VersionInput is the requested version (e.g. 3 or latest), if any.
+	VersionInput string `json:"version_input,omitempty"`
+	// VersionRule describes how the version was chosen from the candidates
+	// (e.g. the active version).
+	VersionRule string `json:"version_rule,omitempty"`
+	// VersionCandidates are the service's versions, most recent first.
+	VersionCandidates []VersionCandidate `json:"version_candidates,omitempty"`
+	// Version is the resolved Service Version (zero if it wasn't resolved).
+	Version int `json:"version,omitempty"`
+	// ClonedFrom is the version that was cloned (via --autoclone) to produce
+	// Version, if any.
+	ClonedFrom int `json:"cloned_from,omitempty"`
+}
+
+// ServiceIDCandidate is a Service ID defined by a particular source.
+type ServiceIDCandidate struct {
+	ServiceID string `json:"service_id"`
+	Source    string `json:"source"`
+}
+
+// VersionCandidate is a service version considered by ServiceDetails.
+type VersionCandidate struct {
+	Number  int  `json:"number"`
+	Active  bool `json:"active"`
+	Locked  bool `json:"locked"`
+	Staging bool `json:"staging"`
+}
+
+// newVersionCandidates converts the service versions into candidates.
+func newVersionCandidates(vs []*fastly.Version) []VersionCandidate {
+	candidates := make([]VersionCandidate, 0, len(vs))
+	for _, v := range vs {
+		candidates = append(candidates, VersionCandidate{
+			Number:  fastly.ToValue(v.Number),
+			Active:  fastly.ToValue(v.Active),
+			Locked:  fastly.ToValue(v.Locked),
+			Staging: fastly.ToValue(v.Staging),
+		})
+	}
+	return candidates
+}
+
+// String formats the resolution as text (e.g. for the error log).
+func (r ServiceResolution) String() string {
+	lines := []string{fmt.Sprintf("Service ID: %s (via %s)", r.ServiceID, r.ServiceIDSource)}
+	for _, c := range r.IgnoredServiceIDs {
+		lines = append(lines, fmt.Sprintf("Ignored Service ID: %s (via %s)", c.ServiceID, c.Source))
+	}
+	if r.VersionSource != "" {
+		source := r.VersionSource
+		if r.VersionInput != "" {
+			source = fmt.Sprintf("%s (%s)", source, r.VersionInput)
+		}
+		lines = append(lines, fmt.Sprintf("Version source: %s", source))
+	}
+	if r.VersionRule != "" {
+		lines = append(lines, fmt.Sprintf("Version rule: %s", r.VersionRule))
+	}
+	if len(r.VersionCandidates) > 0 {
+		var candidates []string
+		for i, c := range r.VersionCandidates {
+			if i == maxDisplayedVersionCandidates {
+				candidates = append(candidates, fmt.Sprintf("(and %d more)", len(r.VersionCandidates)-i))
+				break
+			}
+			candidates = append(candidates, c.String())
+		}
+		lines = append(lines, fmt.Sprintf("Version candidates: %s", strings.Join(candidates, ", ")))
+	}
+	if r.Version > 0 {
+		lines = append(lines, fmt.Sprintf("Version: %d", r.Version))
+	}
+	if r.ClonedFrom > 0 {
+		lines = append(lines, fmt.Sprintf("Cloned from version: %d (via --autoclone)", r.ClonedFrom))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// String formats the candidate's number along with its state (if any).
+func (c VersionCandidate) String() string {
+	var states []string
+	if c.Active {
+		states = append(states, "active")
+	}
+	if c.Locked {
+		states = append(states, "locked")
+	}
+	if c.Staging {
+		states = append(states, "staged")
+	}
+	if len(states) == 0 {
+		return fmt.Sprint(c.Number)
+	}
+	return fmt.Sprintf("%d (%s)", c.Number, strings.Join(states, ", "))
+}
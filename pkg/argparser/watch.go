@@ -0,0 +1,95 @@
+package argparser
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/fastly/kingpin"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/internal/term"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DefaultWatchInterval is how often a watched resource is re-fetched.
+const DefaultWatchInterval = 5 * time.Second
+
+// clearScreen moves the cursor to the top left and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+// WatchNow is exposed so that we may mock it from our test file.
+var WatchNow = time.Now
+
+// WatchOutput is a helper for adding `--watch` and `--interval` flags to
+// describe commands. It can be embedded into command structs.
+type WatchOutput struct {
+	Enabled  bool          // Set via flag.
+	Interval time.Duration // Set via flag.
+}
+
+// RegisterWatchFlags defines the --watch and --interval flags.
+func (w *WatchOutput) RegisterWatchFlags(cmd *kingpin.CmdClause) {
+	cmd.Flag("watch", "Re-fetch and re-render the output on an interval until interrupted").BoolVar(&w.Enabled)
+	cmd.Flag("interval", "How often to re-fetch the output when using --watch (e.g. 10s)").Default(DefaultWatchInterval.String()).DurationVar(&w.Interval)
+}
+
+// Watch calls render once, or if --watch is set, repeatedly every interval
+// until the context is cancelled or the user interrupts the process (Ctrl-C).
+//
+// When out is a TTY the screen is cleared between renders, otherwise each
+// render is appended to out with a timestamp header. Structured output (i.e.
+// j is enabled) is instead streamed so it can be parsed: JSON is written as a
+// single line per render (i.e. NDJSON), and YAML as a document per render.
+func (w *WatchOutput) Watch(ctx context.Context, out io.Writer, j *JSONOutput, render func(io.Writer) error) error {
+	if !w.Enabled {
+		return render(out)
+	}
+	if w.Interval <= 0 {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid --interval value: %s", w.Interval),
+			Remediation: "Provide a positive duration, e.g. --interval 5s",
+		}
+	}
+
+	structured := j != nil && j.Enabled
+	if structured && (j.Format == "" || j.Format == FormatJSON) {
+		j.SetJSONStyle(JSONStyleCompact)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tty := term.IsTerminal(out)
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		switch {
+		case structured:
+			if j.Format == FormatYAML {
+				fmt.Fprintln(out, "---")
+			}
+		case tty:
+			fmt.Fprint(out, clearScreen)
+		default:
+			text.Output(out, "--- %s ---", WatchNow().UTC().Format(time.RFC3339))
+		}
+		if err := render(out); err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExponentialBackoff computes exponentially increasing delays between attempts
+// of an operation.
+type ExponentialBackoff struct {
+	// Base is the delay before the first retry.
+	Base time.Duration
+	// Max caps the delay between attempts (zero means uncapped).
+	Max time.Duration
+	// Jitter is the fraction (0.0 to 1.0) of each delay that is randomised.
+	// e.g. 0.5 with a delay of 2s results in a delay between 1s and 2s.
+	Jitter float64
+	// MaxAttempts is the maximum number of attempts (zero means unlimited).
+	MaxAttempts int
+	// Budget caps the time spent waiting between attempts, shared with other
+	// operations (nil means unlimited).
+	Budget *Budget
+}
+
+// randFloat returns a pseudo-random number in the half-open interval [0.0,1.0).
+//
+// NOTE: This is a package level variable so the test suite can replace it.
+var randFloat = rand.Float64
+
+// Delay returns the delay to wait after the given (zero-indexed) failed
+// attempt. The delay never exceeds Max and is never less than (1-Jitter) of the
+// un-jittered delay.
+func (b ExponentialBackoff) Delay(attempt int) time.Duration {
+	if attempt < 0 {
+		attempt = 0
+	}
+
+	d := b.Base
+	for i := 0; i < attempt; i++ {
+		// Stop doubling once Max is reached, or before the value overflows.
+		if (b.Max > 0 && d >= b.Max) || d > math.MaxInt64/2 {
+			break
+		}
+		d *= 2
+	}
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+
+	jitter := b.Jitter
+	switch {
+	case jitter <= 0:
+		return d
+	case jitter > 1:
+		jitter = 1
+	}
+	return d - time.Duration(jitter*randFloat()*float64(d))
+}
+
+// Retry calls fn until it succeeds, returns a permanent error, MaxAttempts is
+// reached, the Budget is exhausted or the context is cancelled.
+//
+// If fn returns a RetryAfterError whose delay is longer than the computed
+// backoff delay, then the requested delay is used instead.
+func (b ExponentialBackoff) Retry(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		var pe PermanentError
+		if errors.As(err, &pe) {
+			return pe.Err
+		}
+		if b.MaxAttempts > 0 && attempt+1 >= b.MaxAttempts {
+			return err
+		}
+
+		delay := b.Delay(attempt)
+		var rae RetryAfterError
+		if errors.As(err, &rae) && rae.After > delay {
+			delay = rae.After
+		}
+		if !b.Budget.Reserve(delay) {
+			return BudgetExhaustedError{Err: err, Limit: b.Budget.Limit}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w (last error: %w)", ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+// PermanentError indicates the operation failed in a way that shouldn't be
+// retried.
+type PermanentError struct {
+	Err error
+}
+
+// Unwrap returns the inner error.
+func (pe PermanentError) Unwrap() error {
+	return pe.Err
+}
+
+// Error returns the inner error string.
+func (pe PermanentError) Error() string {
+	if pe.Err == nil {
+		return ""
+	}
+	return pe.Err.Error()
+}
+
+// Permanent wraps err so that Retry stops and returns it immediately.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return PermanentError{Err: err}
+}
+
+// RetryAfterError indicates the delay requested by the remote server (e.g. via
+// a Retry-After header) before the operation should be retried.
+type RetryAfterError struct {
+	Err   error
+	After time.Duration
+}
+
+// Unwrap returns the inner error.
+func (rae RetryAfterError) Unwrap() error {
+	return rae.Err
+}
+
+// Error returns the inner error string.
+func (rae RetryAfterError) Error() string {
+	if rae.Err == nil {
+		return ""
+	}
+	return rae.Err.Error()
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or a HTTP date.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
@@ -0,0 +1,221 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/fastly/cli/pkg/backoff"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestDelayBounds(t *testing.T) {
+	property := func(base, max uint32, jitter uint8, attempt uint8) bool {
+		b := backoff.ExponentialBackoff{
+			Base:   time.Duration(base),
+			Max:    time.Duration(max) + 1,
+			Jitter: float64(jitter%101) / 100,
+		}
+		d := b.Delay(int(attempt))
+
+		// Compute the un-jittered delay independently of the implementation.
+		want := b.Base
+		for i := 0; i < int(attempt) && (b.Max == 0 || want < b.Max); i++ {
+			want *= 2
+		}
+		if b.Max > 0 && want > b.Max {
+			want = b.Max
+		}
+
+		lower := time.Duration((1 - b.Jitter) * float64(want))
+		return d >= 0 && d <= want && d >= lower-1
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 5000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDelayNoOverflow(t *testing.T) {
+	b := backoff.ExponentialBackoff{Base: time.Hour}
+	for attempt := 0; attempt < 200; attempt++ {
+		if d := b.Delay(attempt); d < 0 {
+			t.Fatalf("attempt %d: want non-negative delay, have %s", attempt, d)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errFoo := errors.New("foo")
+
+	for _, testcase := range []struct {
+		name         string
+		backoff      backoff.ExponentialBackoff
+		failures     int
+		err          error
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name:         "succeeds first attempt",
+			backoff:      backoff.ExponentialBackoff{Base: time.Millisecond, MaxAttempts: 3},
+			wantAttempts: 1,
+		},
+		{
+			name:         "succeeds after retries",
+			backoff:      backoff.ExponentialBackoff{Base: time.Millisecond, MaxAttempts: 3},
+			failures:     2,
+			err:          errFoo,
+			wantAttempts: 3,
+		},
+		{
+			name:         "max attempts reached",
+			backoff:      backoff.ExponentialBackoff{Base: time.Millisecond, MaxAttempts: 3},
+			failures:     5,
+			err:          errFoo,
+			wantAttempts: 3,
+			wantErr:      errFoo,
+		},
+		{
+			name:         "permanent error",
+			backoff:      backoff.ExponentialBackoff{Base: time.Millisecond, MaxAttempts: 3},
+			failures:     5,
+			err:          backoff.Permanent(errFoo),
+			wantAttempts: 1,
+			wantErr:      errFoo,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var attempts int
+			err := testcase.backoff.Retry(context.Background(), func() error {
+				attempts++
+				if attempts <= testcase.failures {
+					return testcase.err
+				}
+				return nil
+			})
+			testutil.AssertEqual(t, testcase.wantAttempts, attempts)
+			if !errors.Is(err, testcase.wantErr) || (testcase.wantErr == nil && err != nil) {
+				t.Fatalf("want error %v, have %v", testcase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRetryCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := backoff.ExponentialBackoff{Base: time.Hour}
+
+	var attempts int
+	done := make(chan error, 1)
+	go func() {
+		done <- b.Retry(ctx, func() error {
+			attempts++
+			return errors.New("foo")
+		})
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("want context.Canceled, have %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Retry didn't return after the context was cancelled")
+	}
+	testutil.AssertEqual(t, 1, attempts)
+
+	// A context that's already cancelled shouldn't call fn at all.
+	attempts = 0
+	err := b.Retry(ctx, func() error {
+		attempts++
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("want context.Canceled, have %v", err)
+	}
+	testutil.AssertEqual(t, 0, attempts)
+}
+
+func TestRetryBudget(t *testing.T) {
+	errFoo := errors.New("foo")
+	budget := backoff.NewBudget(3 * time.Millisecond)
+	b := backoff.ExponentialBackoff{Base: time.Millisecond, Budget: budget}
+
+	// The delays of 1ms and 2ms fit the budget, but the next (4ms) doesn't.
+	var attempts int
+	err := b.Retry(context.Background(), func() error {
+		attempts++
+		return errFoo
+	})
+	testutil.AssertEqual(t, 3, attempts)
+	var bee backoff.BudgetExhaustedError
+	if !errors.As(err, &bee) || !errors.Is(err, errFoo) {
+		t.Fatalf("want BudgetExhaustedError wrapping %v, have %v", errFoo, err)
+	}
+	testutil.AssertEqual(t, "foo (not retried: the retry budget of 3ms is exhausted)", err.Error())
+	testutil.AssertBool(t, true, budget.Exhausted())
+
+	// Once exhausted, another operation sharing the budget isn't retried.
+	attempts = 0
+	err = b.Retry(context.Background(), func() error {
+		attempts++
+		return errFoo
+	})
+	testutil.AssertEqual(t, 1, attempts)
+	if !errors.As(err, &bee) {
+		t.Fatalf("want BudgetExhaustedError, have %v", err)
+	}
+	testutil.AssertEqual(t, "limit 3ms, spent 3ms waiting between retries, 2 retries denied", budget.Summary())
+
+	// A nil budget is unlimited.
+	testutil.AssertBool(t, true, backoff.NewBudget(0).Reserve(time.Hour))
+	testutil.AssertBool(t, false, backoff.NewBudget(0).Exhausted())
+}
+
+func TestRetryAfter(t *testing.T) {
+	b := backoff.ExponentialBackoff{Base: time.Nanosecond, MaxAttempts: 2}
+	after := 50 * time.Millisecond
+
+	var attempts int
+	start := time.Now()
+	err := b.Retry(context.Background(), func() error {
+		attempts++
+		if attempts == 1 {
+			return backoff.RetryAfterError{Err: errors.New("rate limited"), After: after}
+		}
+		return nil
+	})
+	testutil.AssertNoError(t, err)
+	if elapsed := time.Since(start); elapsed < after {
+		t.Fatalf("want Retry-After delay of at least %s, have %s", after, elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, testcase := range []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "empty", value: ""},
+		{name: "seconds", value: "120", want: 2 * time.Minute, wantOK: true},
+		{name: "negative seconds", value: "-1"},
+		{name: "http date", value: "Mon, 01 Jan 2024 12:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		{name: "http date in the past", value: "Mon, 01 Jan 2024 11:00:00 GMT", wantOK: true},
+		{name: "invalid", value: "soon"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			have, ok := backoff.ParseRetryAfter(testcase.value, now)
+			testutil.AssertEqual(t, testcase.wantOK, ok)
+			testutil.AssertEqual(t, testcase.want, have)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package backoff
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Budget caps the total time spent waiting between retries across all the
+// operations that share it (e.g. a whole CLI invocation, see --retry-budget).
+// Once a retry would exceed the limit the budget is exhausted, and no further
+// retries are allowed.
+//
+// A nil *Budget is unlimited.
+type Budget struct {
+	// Limit is the total time that can be spent waiting between retries.
+	Limit time.Duration
+
+	mu     sync.Mutex
+	spent  time.Duration
+	denied int
+}
+
+// NewBudget returns a Budget with the given limit, or nil (i.e. unlimited) if
+// the limit isn't positive.
+func NewBudget(limit time.Duration) *Budget {
+	if limit <= 0 {
+		return nil
+	}
+	return &Budget{Limit: limit}
+}
+
+// Reserve reports whether a retry after waiting d is allowed, and if so
+// deducts d from the budget.
+func (b *Budget) Reserve(d time.Duration) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.denied > 0 || b.spent+d > b.Limit {
+		b.denied++
+		return false
+	}
+	b.spent += d
+	return true
+}
+
+// Exhausted reports whether a retry has been denied.
+func (b *Budget) Exhausted() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.denied > 0
+}
+
+// Summary describes the use of the budget, e.g.
+//
+//	limit 30s, spent 28s waiting between retries, 3 retries denied
+func (b *Budget) Summary() string {
+	if b == nil {
+		return ""
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return fmt.Sprintf("limit %s, spent %s waiting between retries, %d retries denied", b.Limit, b.spent, b.denied)
+}
+
+// BudgetExhaustedError indicates the operation wasn't retried because the
+// shared retry Budget was exhausted.
+type BudgetExhaustedError struct {
+	Err   error
+	Limit time.Duration
+}
+
+// Unwrap returns the inner error.
+func (bee BudgetExhaustedError) Unwrap() error {
+	return bee.Err
+}
+
+// Error returns the inner error string, noting it wasn't retried.
+func (bee BudgetExhaustedError) Error() string {
+	return fmt.Sprintf("%v (not retried: the retry budget of %s is exhausted)", bee.Err, bee.Limit)
+}
@@ -0,0 +1,3 @@
+// Package backoff contains an exponential backoff (with jitter) implementation
+// for retrying operations.
+package backoff
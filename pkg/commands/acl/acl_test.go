@@ -199,7 +199,26 @@ func TestACLDescribe(t *testing.T) {
 				GetACLFn:       getACL,
 			},
 			Args:       "--name foobar --service-id 123 --version 3",
-			WantOutput: "\nService ID: 123\nService Version: 3\n\nName: foobar\nID: 456\n\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nUpdated at: 2021-06-15 23:00:00 +0000 UTC\nDeleted at: 2021-06-15 23:00:00 +0000 UTC\n",
+			WantOutput: "\nService ID: 123\nService Version: 3\n\nName: foobar\nID: 456\n\nCreated at: 2021-06-15T23:00:00Z\nUpdated at: 2021-06-15T23:00:00Z\nDeleted at: 2021-06-15T23:00:00Z\n",
+		},
+		{
+			Name: "validate --yaml flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetACLFn:       getACL,
+			},
+			Args:           "--name foobar --service-id 123 --version 3 --yaml",
+			WantOutput:     "Name: foobar\nServiceID: \"123\"\nServiceVersion: 3\n",
+			DontWantOutput: "Service ID: 123",
+		},
+		{
+			Name: "validate --field flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetACLFn:       getACL,
+			},
+			Args:       "--name foobar --service-id 123 --version 3 --field Name --field ACLID --json-compact",
+			WantOutput: `{"ACLID":"456","Name":"foobar"}`,
 		},
 		{
 			Name: "validate missing --autoclone flag is OK",
@@ -208,7 +227,7 @@ func TestACLDescribe(t *testing.T) {
 				GetACLFn:       getACL,
 			},
 			Args:       "--name foobar --service-id 123 --version 1",
-			WantOutput: "\nService ID: 123\nService Version: 1\n\nName: foobar\nID: 456\n\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nUpdated at: 2021-06-15 23:00:00 +0000 UTC\nDeleted at: 2021-06-15 23:00:00 +0000 UTC\n",
+			WantOutput: "\nService ID: 123\nService Version: 1\n\nName: foobar\nID: 456\n\nCreated at: 2021-06-15T23:00:00Z\nUpdated at: 2021-06-15T23:00:00Z\nDeleted at: 2021-06-15T23:00:00Z\n",
 		},
 	}
 
@@ -262,7 +281,7 @@ func TestACLList(t *testing.T) {
 				ListACLsFn:     listACLs,
 			},
 			Args:       "--service-id 123 --verbose --version 1",
-			WantOutput: "Fastly API endpoint: https://api.fastly.com\nFastly API token provided via config file (profile: user)\n\nService ID (via --service-id): 123\n\nService Version: 1\n\nName: foo\nID: 456\n\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nUpdated at: 2021-06-15 23:00:00 +0000 UTC\nDeleted at: 2021-06-15 23:00:00 +0000 UTC\n\nName: bar\nID: 789\n\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nUpdated at: 2021-06-15 23:00:00 +0000 UTC\nDeleted at: 2021-06-15 23:00:00 +0000 UTC\n\n",
+			WantOutput: "Fastly API endpoint: https://api.fastly.com\nFastly API token provided via config file (profile: user)\n\nService ID (via --service-id): 123\n\nService Version: 1\n\nName: foo\nID: 456\n\nCreated at: 2021-06-15T23:00:00Z\nUpdated at: 2021-06-15T23:00:00Z\nDeleted at: 2021-06-15T23:00:00Z\n\nName: bar\nID: 789\n\nCreated at: 2021-06-15T23:00:00Z\nUpdated at: 2021-06-15T23:00:00Z\nDeleted at: 2021-06-15T23:00:00Z\n\n",
 		},
 	}
 
@@ -31,6 +31,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -89,7 +90,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -124,13 +125,13 @@ func (c *ListCommand) printVerbose(out io.Writer, serviceVersion int, as []*fast
 		fmt.Fprintf(out, "ID: %s\n\n", fastly.ToValue(a.ACLID))
 
 		if a.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", a.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *a.CreatedAt))
 		}
 		if a.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", a.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *a.UpdatedAt))
 		}
 		if a.DeletedAt != nil {
-			fmt.Fprintf(out, "Deleted at: %s\n", a.DeletedAt)
+			fmt.Fprintf(out, "Deleted at: %s\n", text.FormatTime(out, *a.DeletedAt))
 		}
 
 		fmt.Fprintf(out, "\n")
@@ -151,7 +151,7 @@ func TestACLEntryDescribe(t *testing.T) {
 				GetACLEntryFn: getACLEntry,
 			},
 			Args:       "--acl-id 123 --id 456 --service-id 123",
-			WantOutput: "\nService ID: 123\nACL ID: 123\nID: 456\nIP: 127.0.0.1\nSubnet: 0\nNegated: false\nComment: \n\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nUpdated at: 2021-06-15 23:00:00 +0000 UTC\nDeleted at: 2021-06-15 23:00:00 +0000 UTC\n",
+			WantOutput: "\nService ID: 123\nACL ID: 123\nID: 456\nIP: 127.0.0.1\nSubnet: 0\nNegated: false\nComment: \n\nCreated at: 2021-06-15T23:00:00Z\nUpdated at: 2021-06-15T23:00:00Z\nDeleted at: 2021-06-15T23:00:00Z\n",
 		},
 	}
 
@@ -283,6 +283,8 @@ Fastly API token provided via config file (profile: user)
 
 Service ID (via --service-id): 123
 
+Page size: 1
+
 ACL ID: 123
 ID: 456
 IP: 127.0.0.1
@@ -290,9 +292,9 @@ Subnet: 0
 Negated: false
 Comment: foo
 
-Created at: 2021-06-15 23:00:00 +0000 UTC
-Updated at: 2021-06-15 23:00:00 +0000 UTC
-Deleted at: 2021-06-15 23:00:00 +0000 UTC
+Created at: 2021-06-15T23:00:00Z
+Updated at: 2021-06-15T23:00:00Z
+Deleted at: 2021-06-15T23:00:00Z
 
 ACL ID: 123
 ID: 789
@@ -301,9 +303,9 @@ Subnet: 0
 Negated: true
 Comment: bar
 
-Created at: 2021-06-15 23:00:00 +0000 UTC
-Updated at: 2021-06-15 23:00:00 +0000 UTC
-Deleted at: 2021-06-15 23:00:00 +0000 UTC
+Created at: 2021-06-15T23:00:00Z
+Updated at: 2021-06-15T23:00:00Z
+Deleted at: 2021-06-15T23:00:00Z
 
 `
 
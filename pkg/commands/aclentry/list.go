@@ -25,7 +25,12 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	c.CmdClause.Flag("acl-id", "Alphanumeric string identifying a ACL").Required().StringVar(&c.aclID)
 
 	// Optional.
-	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlagBool(c.CountFlag())          // --count-only
+	c.RegisterFlagBool(c.JSONFlag())           // --json
+	c.RegisterFieldFlag(c.CmdClause)           // --field
+	c.RegisterFlagInt(c.LimitRecordsFlag())    // --limit
+	c.RegisterFlagBool(c.NoAutopaginateFlag()) // --no-autopaginate
+	c.RegisterFlagInt(c.PageSizeFlag())        // --page-size
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -41,7 +46,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	c.CmdClause.Flag("direction", "Direction in which to sort results").Default(argparser.PaginationDirection[0]).HintOptions(argparser.PaginationDirection...).EnumVar(&c.direction, argparser.PaginationDirection...)
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.page)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.perPage)
+	c.RegisterFlagInt(c.PerPageFlag()) // --per-page
 	c.CmdClause.Flag("sort", "Field on which to sort").Default("created").StringVar(&c.sort)
 
 	return &c
@@ -50,12 +55,13 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 // ListCommand calls the Fastly API to list appropriate resources.
 type ListCommand struct {
 	argparser.Base
+	argparser.CountOutput
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	aclID       string
 	direction   string
 	page        int
-	perPage     int
 	serviceName argparser.OptionalServiceNameID
 	sort        string
 }
@@ -75,24 +81,29 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 	}
 
 	input := c.constructInput(serviceID)
+	input.PerPage = c.PageSizeInput(c.Globals, out)
 	paginator := c.Globals.APIClient.GetACLEntries(input)
 
-	var o []*fastly.ACLEntry
-	for paginator.HasNext() {
-		data, err := paginator.GetNext()
+	o, truncated, pageErr := argparser.Paginate(c.PaginationOutput, paginator, c.Globals.ErrLog, map[string]any{
+		"ACL ID":     c.aclID,
+		"Service ID": serviceID,
+	})
+	if pageErr != nil && !argparser.IsPartialResult(pageErr) {
+		return pageErr
+	}
+
+	if ok, err := c.WriteCount(out, len(o), c.JSONOutput); ok {
 		if err != nil {
-			c.Globals.ErrLog.AddWithContext(err, map[string]any{
-				"ACL ID":          c.aclID,
-				"Service ID":      serviceID,
-				"Remaining Pages": paginator.Remaining(),
-			})
 			return err
 		}
-		o = append(o, data...)
+		return pageErr
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
-		return err
+	if ok, err := c.WriteOutput(out, o); ok {
+		if err != nil {
+			return err
+		}
+		return pageErr
 	}
 
 	if c.Globals.Verbose() {
@@ -103,7 +114,8 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 			return err
 		}
 	}
-	return nil
+	c.DisplayTruncated(out, len(o), truncated)
+	return pageErr
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
@@ -117,9 +129,6 @@ func (c *ListCommand) constructInput(serviceID string) *fastly.GetACLEntriesInpu
 	if c.page > 0 {
 		input.Page = fastly.ToPointer(c.page)
 	}
-	if c.perPage > 0 {
-		input.PerPage = fastly.ToPointer(c.perPage)
-	}
 	input.ServiceID = serviceID
 	if c.sort != "" {
 		input.Sort = fastly.ToPointer(c.sort)
@@ -140,13 +149,13 @@ func (c *ListCommand) printVerbose(out io.Writer, as []*fastly.ACLEntry) {
 		fmt.Fprintf(out, "Comment: %s\n\n", fastly.ToValue(a.Comment))
 
 		if a.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", a.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *a.CreatedAt))
 		}
 		if a.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", a.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *a.UpdatedAt))
 		}
 		if a.DeletedAt != nil {
-			fmt.Fprintf(out, "Deleted at: %s\n", a.DeletedAt)
+			fmt.Fprintf(out, "Deleted at: %s\n", text.FormatTime(out, *a.DeletedAt))
 		}
 
 		fmt.Fprintf(out, "\n")
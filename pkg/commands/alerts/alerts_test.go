@@ -516,6 +516,6 @@ ABC            SVC         name  stats   status_5xx  above_threshold  10
 
 var listAlertHistoryEmptyOutput = `HISTORY ID  DEFINITION ID  STATUS  START  END`
 
-var listAlertsHistoryOutput = `HISTORY ID  DEFINITION ID  STATUS  START                          END
-ABC         ABC            active  2024-05-01 12:00:11 +0000 UTC  2024-05-01 12:00:11 +0000 UTC
+var listAlertsHistoryOutput = `HISTORY ID  DEFINITION ID  STATUS  START                 END
+ABC         ABC            active  2024-05-01T12:00:11Z  2024-05-01T12:00:11Z
 `
@@ -92,8 +92,8 @@ func printVerbose(out io.Writer, as []*fastly.AlertDefinition) {
 
 func printHistory(out io.Writer, history *fastly.AlertHistory) {
 	if history != nil {
-		start := history.Start.UTC().String()
-		end := history.End.UTC().String()
+		start := text.FormatTime(out, history.Start)
+		end := text.FormatTime(out, history.End)
 		fmt.Fprintf(out, "History ID: %s\n", history.ID)
 		fmt.Fprintf(out, "Definition:\n")
 		printDefinition(out, 4, &history.Definition)
@@ -110,8 +110,8 @@ func printHistorySummary(out io.Writer, as []*fastly.AlertHistory) {
 	t := text.NewTable(out)
 	t.AddHeader("HISTORY ID", "DEFINITION ID", "STATUS", "START", "END")
 	for _, a := range as {
-		start := a.Start.UTC().String()
-		end := a.End.UTC().String()
+		start := text.FormatTime(out, a.Start)
+		end := text.FormatTime(out, a.End)
 		t.AddLine(
 			a.ID,
 			a.DefinitionID,
@@ -25,6 +25,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -49,7 +51,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, definition); ok {
+	if ok, err := c.WriteOutput(out, definition); ok {
 		return err
 	}
 
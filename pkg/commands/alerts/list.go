@@ -23,6 +23,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("cursor", "Pagination cursor (Use 'next_cursor' value from list output)").Action(c.cursor.Set).StringVar(&c.cursor.Value)
 	c.CmdClause.Flag("limit", "Maximum number of items to list").Action(c.limit.Set).IntVar(&c.limit.Value)
 	c.CmdClause.Flag("name", "Name of the definition").Action(c.definitionName.Set).StringVar(&c.definitionName.Value)
@@ -63,7 +64,7 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 			return err
 		}
 
-		if ok, err := c.WriteJSON(out, definitions); ok {
+		if ok, err := c.WriteOutput(out, definitions); ok {
 			// No pagination prompt w/ JSON output.
 			return err
 		}
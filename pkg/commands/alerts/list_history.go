@@ -23,6 +23,7 @@ func NewListHistoryCommand(parent argparser.Registerer, g *global.Data) *ListHis
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("after", "After filter history record that either started or ended after a specific date").Action(c.after.Set).StringVar(&c.after.Value)
 	c.CmdClause.Flag("before", "Before filter history record that either started or ended before a specific date").Action(c.before.Set).StringVar(&c.before.Value)
 	c.CmdClause.Flag("cursor", "Pagination cursor (Use 'next_cursor' value from list output)").Action(c.cursor.Set).StringVar(&c.cursor.Value)
@@ -70,7 +71,7 @@ func (c *ListHistoryCommand) Exec(in io.Reader, out io.Writer) error {
 			return err
 		}
 
-		if ok, err := c.WriteJSON(out, history); ok {
+		if ok, err := c.WriteOutput(out, history); ok {
 			return err
 		}
 
@@ -35,6 +35,7 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	c.CmdClause.Flag("ignoreBelow", "IgnoreBelow is the threshold for the denominator value used in evaluations that calculate a rate or ratio. Usually used to filter out noise.").Action(c.ignoreBelow.Set).Float64Var(&c.ignoreBelow.Value)
 	c.CmdClause.Flag("integrations", "Integrations are a list of integrations used to notify when alert fires.").Action(c.integrations.Set).StringsVar(&c.integrations.Value)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -70,7 +71,7 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, definition); ok {
+	if ok, err := c.WriteOutput(out, definition); ok {
 		return err
 	}
 
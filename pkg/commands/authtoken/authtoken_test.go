@@ -41,7 +41,7 @@ func TestAuthTokenCreate(t *testing.T) {
 				},
 			},
 			Args:       "--password secure --token 123",
-			WantOutput: "Created token '123abc' (name: Example, id: 123, scope: foobar, expires: 2021-06-15 23:00:00 +0000 UTC)",
+			WantOutput: "Created token '123abc' (name: Example, id: 123, scope: foobar, expires: 2021-06-15T23:00:00Z)",
 		},
 		{
 			Name: "validate CreateToken API success with all flags",
@@ -57,7 +57,7 @@ func TestAuthTokenCreate(t *testing.T) {
 				},
 			},
 			Args:       "--expires 2021-09-15T23:00:00Z --name Testing --password secure --scope purge_all --scope global:read --services a,b,c --token 123",
-			WantOutput: "Created token '123abc' (name: Testing, id: 123, scope: purge_all global:read, expires: 2021-09-15 23:00:00 +0000 UTC)",
+			WantOutput: "Created token '123abc' (name: Testing, id: 123, scope: purge_all global:read, expires: 2021-09-15T23:00:00Z)",
 		},
 	}
 
@@ -286,9 +286,9 @@ Services: a, b
 Scope: purge_all global:read
 IP: 127.0.0.1
 
-Created at: 2021-06-15 23:00:00 +0000 UTC
-Last used at: 2021-06-15 23:00:00 +0000 UTC
-Expires at: 2021-06-15 23:00:00 +0000 UTC`
+Created at: 2021-06-15T23:00:00Z
+Last used at: 2021-06-15T23:00:00Z
+Expires at: 2021-06-15T23:00:00Z`
 }
 
 func listTokenOutputVerbose() string {
@@ -303,9 +303,9 @@ Services: a, b
 Scope: purge_all global:read
 IP: 127.0.0.1
 
-Created at: 2021-06-15 23:00:00 +0000 UTC
-Last used at: 2021-06-15 23:00:00 +0000 UTC
-Expires at: 2021-06-15 23:00:00 +0000 UTC
+Created at: 2021-06-15T23:00:00Z
+Last used at: 2021-06-15T23:00:00Z
+Expires at: 2021-06-15T23:00:00Z
 
 ID: 456
 Name: Bar
@@ -314,9 +314,9 @@ Services: a, b
 Scope: global
 IP: 127.0.0.2
 
-Created at: 2021-06-15 23:00:00 +0000 UTC
-Last used at: 2021-06-15 23:00:00 +0000 UTC
-Expires at: 2021-06-15 23:00:00 +0000 UTC
+Created at: 2021-06-15T23:00:00Z
+Last used at: 2021-06-15T23:00:00Z
+Expires at: 2021-06-15T23:00:00Z
 
 `
 }
@@ -71,7 +71,7 @@ func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
 
 	expires := "never"
 	if r.ExpiresAt != nil {
-		expires = r.ExpiresAt.String()
+		expires = text.FormatTime(out, *r.ExpiresAt)
 	}
 
 	text.Success(out, "Created token '%s' (name: %s, id: %s, scope: %s, expires: %s)", fastly.ToValue(r.AccessToken), fastly.ToValue(r.Name), fastly.ToValue(r.TokenID), fastly.ToValue(r.Scope), expires)
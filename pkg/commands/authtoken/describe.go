@@ -10,6 +10,7 @@ import (
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
 )
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -22,6 +23,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 	c.CmdClause = parent.Command("describe", "Get the current API token").Alias("get")
 
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	return &c
 }
 
@@ -43,7 +46,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -60,13 +63,13 @@ func (c *DescribeCommand) print(out io.Writer, t *fastly.Token) error {
 	fmt.Fprintf(out, "IP: %s\n\n", fastly.ToValue(t.IP))
 
 	if t.CreatedAt != nil {
-		fmt.Fprintf(out, "Created at: %s\n", t.CreatedAt)
+		fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *t.CreatedAt))
 	}
 	if t.LastUsedAt != nil {
-		fmt.Fprintf(out, "Last used at: %s\n", t.LastUsedAt)
+		fmt.Fprintf(out, "Last used at: %s\n", text.FormatTime(out, *t.LastUsedAt))
 	}
 	if t.ExpiresAt != nil {
-		fmt.Fprintf(out, "Expires at: %s\n", t.ExpiresAt)
+		fmt.Fprintf(out, "Expires at: %s\n", text.FormatTime(out, *t.ExpiresAt))
 	}
 	return nil
 }
@@ -29,6 +29,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 		Action:      c.customerID.Set,
 	})
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	return &c
 }
 
@@ -69,7 +70,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		}
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -105,13 +106,13 @@ func (c *ListCommand) printVerbose(out io.Writer, rs []*fastly.Token) {
 		fmt.Fprintf(out, "IP: %s\n\n", fastly.ToValue(r.IP))
 
 		if r.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 		}
 		if r.LastUsedAt != nil {
-			fmt.Fprintf(out, "Last used at: %s\n", r.LastUsedAt)
+			fmt.Fprintf(out, "Last used at: %s\n", text.FormatTime(out, *r.LastUsedAt))
 		}
 		if r.ExpiresAt != nil {
-			fmt.Fprintf(out, "Expires at: %s\n", r.ExpiresAt)
+			fmt.Fprintf(out, "Expires at: %s\n", text.FormatTime(out, *r.ExpiresAt))
 		}
 	}
 	fmt.Fprintf(out, "\n")
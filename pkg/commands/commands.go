@@ -67,6 +67,7 @@ import (
 	"github.com/fastly/cli/pkg/commands/shellcomplete"
 	"github.com/fastly/cli/pkg/commands/sso"
 	"github.com/fastly/cli/pkg/commands/stats"
+	"github.com/fastly/cli/pkg/commands/telemetry"
 	tlsconfig "github.com/fastly/cli/pkg/commands/tls/config"
 	tlscustom "github.com/fastly/cli/pkg/commands/tls/custom"
 	tlscustomactivation "github.com/fastly/cli/pkg/commands/tls/custom/activation"
@@ -144,6 +145,7 @@ func Define( // nolint:revive // function-length
 	computeUpdate := compute.NewUpdateCommand(computeCmdRoot.CmdClause, data)
 	computeValidate := compute.NewValidateCommand(computeCmdRoot.CmdClause, data)
 	configCmdRoot := config.NewRootCommand(app, data)
+	configShow := config.NewShowCommand(configCmdRoot, data)
 	configstoreCmdRoot := configstore.NewRootCommand(app, data)
 	configstoreCreate := configstore.NewCreateCommand(configstoreCmdRoot.CmdClause, data)
 	configstoreDelete := configstore.NewDeleteCommand(configstoreCmdRoot.CmdClause, data)
@@ -226,11 +228,18 @@ func Define( // nolint:revive // function-length
 	loggingBigQueryList := bigquery.NewListCommand(loggingBigQueryCmdRoot.CmdClause, data)
 	loggingBigQueryUpdate := bigquery.NewUpdateCommand(loggingBigQueryCmdRoot.CmdClause, data)
 	loggingCloudfilesCmdRoot := cloudfiles.NewRootCommand(loggingCmdRoot.CmdClause, data)
+	loggingCloudfilesClone := cloudfiles.NewCloneCommand(loggingCloudfilesCmdRoot.CmdClause, data)
 	loggingCloudfilesCreate := cloudfiles.NewCreateCommand(loggingCloudfilesCmdRoot.CmdClause, data)
 	loggingCloudfilesDelete := cloudfiles.NewDeleteCommand(loggingCloudfilesCmdRoot.CmdClause, data)
 	loggingCloudfilesDescribe := cloudfiles.NewDescribeCommand(loggingCloudfilesCmdRoot.CmdClause, data)
+	loggingCloudfilesExport := cloudfiles.NewExportCommand(loggingCloudfilesCmdRoot.CmdClause, data)
 	loggingCloudfilesList := cloudfiles.NewListCommand(loggingCloudfilesCmdRoot.CmdClause, data)
+	loggingCloudfilesMigrateFormat := cloudfiles.NewMigrateFormatCommand(loggingCloudfilesCmdRoot.CmdClause, data)
+	loggingCloudfilesRename := cloudfiles.NewRenameCommand(loggingCloudfilesCmdRoot.CmdClause, data)
+	loggingCloudfilesRotateCredentials := cloudfiles.NewRotateCredentialsCommand(loggingCloudfilesCmdRoot.CmdClause, data)
+	loggingCloudfilesTest := cloudfiles.NewTestCommand(loggingCloudfilesCmdRoot.CmdClause, data)
 	loggingCloudfilesUpdate := cloudfiles.NewUpdateCommand(loggingCloudfilesCmdRoot.CmdClause, data)
+	loggingCloudfilesValidateBucket := cloudfiles.NewValidateBucketCommand(loggingCloudfilesCmdRoot.CmdClause, data)
 	loggingDatadogCmdRoot := datadog.NewRootCommand(loggingCmdRoot.CmdClause, data)
 	loggingDatadogCreate := datadog.NewCreateCommand(loggingDatadogCmdRoot.CmdClause, data)
 	loggingDatadogDelete := datadog.NewDeleteCommand(loggingDatadogCmdRoot.CmdClause, data)
@@ -339,6 +348,7 @@ func Define( // nolint:revive // function-length
 	loggingPapertrailDescribe := papertrail.NewDescribeCommand(loggingPapertrailCmdRoot.CmdClause, data)
 	loggingPapertrailList := papertrail.NewListCommand(loggingPapertrailCmdRoot.CmdClause, data)
 	loggingPapertrailUpdate := papertrail.NewUpdateCommand(loggingPapertrailCmdRoot.CmdClause, data)
+	loggingRegions := logging.NewRegionsCommand(loggingCmdRoot.CmdClause, data)
 	loggingS3CmdRoot := s3.NewRootCommand(loggingCmdRoot.CmdClause, data)
 	loggingS3Create := s3.NewCreateCommand(loggingS3CmdRoot.CmdClause, data)
 	loggingS3Delete := s3.NewDeleteCommand(loggingS3CmdRoot.CmdClause, data)
@@ -380,6 +390,8 @@ func Define( // nolint:revive // function-length
 	profileCmdRoot := profile.NewRootCommand(app, data)
 	profileCreate := profile.NewCreateCommand(profileCmdRoot.CmdClause, data, ssoCmdRoot)
 	profileDelete := profile.NewDeleteCommand(profileCmdRoot.CmdClause, data)
+	profileExport := profile.NewExportCommand(profileCmdRoot.CmdClause, data)
+	profileImport := profile.NewImportCommand(profileCmdRoot.CmdClause, data)
 	profileList := profile.NewListCommand(profileCmdRoot.CmdClause, data)
 	profileSwitch := profile.NewSwitchCommand(profileCmdRoot.CmdClause, data, ssoCmdRoot)
 	profileToken := profile.NewTokenCommand(profileCmdRoot.CmdClause, data)
@@ -408,6 +420,7 @@ func Define( // nolint:revive // function-length
 	secretstoreentryDelete := secretstoreentry.NewDeleteCommand(secretstoreentryCmdRoot.CmdClause, data)
 	secretstoreentryList := secretstoreentry.NewListCommand(secretstoreentryCmdRoot.CmdClause, data)
 	serviceCmdRoot := service.NewRootCommand(app, data)
+	serviceChangelog := service.NewChangelogCommand(serviceCmdRoot.CmdClause, data)
 	serviceCreate := service.NewCreateCommand(serviceCmdRoot.CmdClause, data)
 	serviceDelete := service.NewDeleteCommand(serviceCmdRoot.CmdClause, data)
 	serviceDescribe := service.NewDescribeCommand(serviceCmdRoot.CmdClause, data)
@@ -422,10 +435,12 @@ func Define( // nolint:revive // function-length
 	serviceauthUpdate := serviceauth.NewUpdateCommand(serviceauthCmdRoot.CmdClause, data)
 	serviceVersionCmdRoot := serviceversion.NewRootCommand(app, data)
 	serviceVersionActivate := serviceversion.NewActivateCommand(serviceVersionCmdRoot.CmdClause, data)
+	serviceVersionBatch := serviceversion.NewBatchCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionClone := serviceversion.NewCloneCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionDeactivate := serviceversion.NewDeactivateCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionList := serviceversion.NewListCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionLock := serviceversion.NewLockCommand(serviceVersionCmdRoot.CmdClause, data)
+	serviceVersionPin := serviceversion.NewPinCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionStage := serviceversion.NewStageCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionUnstage := serviceversion.NewUnstageCommand(serviceVersionCmdRoot.CmdClause, data)
 	serviceVersionUpdate := serviceversion.NewUpdateCommand(serviceVersionCmdRoot.CmdClause, data)
@@ -433,6 +448,9 @@ func Define( // nolint:revive // function-length
 	statsHistorical := stats.NewHistoricalCommand(statsCmdRoot.CmdClause, data)
 	statsRealtime := stats.NewRealtimeCommand(statsCmdRoot.CmdClause, data)
 	statsRegions := stats.NewRegionsCommand(statsCmdRoot.CmdClause, data)
+	telemetryCmdRoot := telemetry.NewRootCommand(app, data)
+	telemetryDisable := telemetry.NewDisableCommand(telemetryCmdRoot.CmdClause, data)
+	telemetryStatus := telemetry.NewStatusCommand(telemetryCmdRoot.CmdClause, data)
 	tlsConfigCmdRoot := tlsconfig.NewRootCommand(app, data)
 	tlsConfigDescribe := tlsconfig.NewDescribeCommand(tlsConfigCmdRoot.CmdClause, data)
 	tlsConfigList := tlsconfig.NewListCommand(tlsConfigCmdRoot.CmdClause, data)
@@ -542,6 +560,7 @@ func Define( // nolint:revive // function-length
 		computeUpdate,
 		computeValidate,
 		configCmdRoot,
+		configShow,
 		configstoreCmdRoot,
 		configstoreCreate,
 		configstoreDelete,
@@ -621,11 +640,18 @@ func Define( // nolint:revive // function-length
 		loggingBigQueryList,
 		loggingBigQueryUpdate,
 		loggingCloudfilesCmdRoot,
+		loggingCloudfilesClone,
 		loggingCloudfilesCreate,
 		loggingCloudfilesDelete,
 		loggingCloudfilesDescribe,
+		loggingCloudfilesExport,
 		loggingCloudfilesList,
+		loggingCloudfilesMigrateFormat,
+		loggingCloudfilesRename,
+		loggingCloudfilesRotateCredentials,
+		loggingCloudfilesTest,
 		loggingCloudfilesUpdate,
+		loggingCloudfilesValidateBucket,
 		loggingCmdRoot,
 		loggingDatadogCmdRoot,
 		loggingDatadogCreate,
@@ -735,6 +761,7 @@ func Define( // nolint:revive // function-length
 		loggingPapertrailDescribe,
 		loggingPapertrailList,
 		loggingPapertrailUpdate,
+		loggingRegions,
 		loggingS3CmdRoot,
 		loggingS3Create,
 		loggingS3Delete,
@@ -776,6 +803,8 @@ func Define( // nolint:revive // function-length
 		profileCmdRoot,
 		profileCreate,
 		profileDelete,
+		profileExport,
+		profileImport,
 		profileList,
 		profileSwitch,
 		profileToken,
@@ -802,6 +831,7 @@ func Define( // nolint:revive // function-length
 		secretstoreentryDelete,
 		secretstoreentryList,
 		serviceCmdRoot,
+		serviceChangelog,
 		serviceCreate,
 		serviceDelete,
 		serviceDescribe,
@@ -815,11 +845,13 @@ func Define( // nolint:revive // function-length
 		serviceauthList,
 		serviceauthUpdate,
 		serviceVersionActivate,
+		serviceVersionBatch,
 		serviceVersionClone,
 		serviceVersionCmdRoot,
 		serviceVersionDeactivate,
 		serviceVersionList,
 		serviceVersionLock,
+		serviceVersionPin,
 		serviceVersionStage,
 		serviceVersionUnstage,
 		serviceVersionUpdate,
@@ -828,6 +860,9 @@ func Define( // nolint:revive // function-length
 		statsHistorical,
 		statsRealtime,
 		statsRegions,
+		telemetryCmdRoot,
+		telemetryDisable,
+		telemetryStatus,
 		tlsConfigCmdRoot,
 		tlsConfigDescribe,
 		tlsConfigList,
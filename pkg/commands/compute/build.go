@@ -178,6 +178,11 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
+	dest := filepath.Join("pkg", fmt.Sprintf("%s.tar.gz", pkgName))
+	if err := filesystem.CheckClobber(dest); err != nil {
+		return err
+	}
+
 	var toolchain string
 	err = spinner.Process("Identifying toolchain", func(_ *text.SpinnerWrapper) error {
 		toolchain, err = identifyToolchain(c)
@@ -236,7 +241,6 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		text.Info(out, "There was an error downloading the wasm-tools (used for binary annotations) but we don't let that block you building your project. For reference here is the error (in case you want to let us know about it): %s\n\n", wasmtoolsErr.Error())
 	}
 
-	dest := filepath.Join("pkg", fmt.Sprintf("%s.tar.gz", pkgName))
 	err = spinner.Process("Creating package archive", func(_ *text.SpinnerWrapper) error {
 		// IMPORTANT: The minimum package requirement is `fastly.toml` and `main.wasm`.
 		//
@@ -791,11 +795,47 @@ func CreatePackageArchive(files []string, destination string) error {
 		}
 	}
 
+	return writeTarGz([]string{dir}, destination)
+}
+
+// writeTarGz writes a gzipped tarball of the sources to destination, creating
+// its directory if it doesn't exist.
+//
+// NOTE: The archive is written to a temporary file and then copied to
+// destination via filesystem.CreateFile, so --no-clobber is honoured.
+func writeTarGz(sources []string, destination string) (err error) {
+	tmp, err := os.CreateTemp("", "fastly-package-*.tar.gz")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %w", err)
+	}
+	_ = tmp.Close()
+	defer os.Remove(tmp.Name())
+
 	tar := archiver.NewTarGz()
-	tar.OverwriteExisting = true //
-	tar.MkdirAll = true          // make destination directory if it doesn't exist
+	tar.OverwriteExisting = true // replace the (empty) temporary file
+	if err := tar.Archive(sources, tmp.Name()); err != nil {
+		return err
+	}
 
-	return tar.Archive([]string{dir}, destination)
+	if err := os.MkdirAll(filepath.Dir(destination), 0o755); err != nil {
+		return fmt.Errorf("making folder for destination: %w", err)
+	}
+	src, err := os.Open(tmp.Name())
+	if err != nil {
+		return err
+	}
+	defer src.Close() // #nosec G307
+	dst, err := filesystem.CreateFile(destination, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := dst.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	_, err = io.Copy(dst, src)
+	return err
 }
 
 // FileNameWithoutExtension returns a filename with its extension stripped.
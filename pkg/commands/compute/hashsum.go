@@ -39,11 +39,17 @@ type HashsumCommand struct {
 
 // NewHashsumCommand returns a usable command registered under the parent.
 // Deprecated: Use NewHashFilesCommand instead.
+//
+// FIXME: Remove `hashsum` subcommand before v11.0.0 is released.
 func NewHashsumCommand(parent argparser.Registerer, g *global.Data, build *BuildCommand) *HashsumCommand {
 	var c HashsumCommand
 	c.buildCmd = build
 	c.Globals = g
 	c.CmdClause = parent.Command("hashsum", "Generate a SHA512 digest from a Compute package").Hidden()
+	c.Deprecate(argparser.Deprecation{
+		Replacement:    "`fastly compute hash-files`",
+		RemovalVersion: "v11.0.0",
+	})
 	c.CmdClause.Flag("dir", "Project directory to build (default: current directory)").Short('C').Action(c.dir.Set).StringVar(&c.dir.Value)
 	c.CmdClause.Flag("env", "The manifest environment config to use (e.g. 'stage' will attempt to read 'fastly.stage.toml')").Action(c.env.Set).StringVar(&c.env.Value)
 	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
@@ -61,11 +67,6 @@ func NewHashsumCommand(parent argparser.Registerer, g *global.Data, build *Build
 
 // Exec implements the command interface.
 func (c *HashsumCommand) Exec(in io.Reader, out io.Writer) (err error) {
-	if !c.Globals.Flags.Quiet {
-		// FIXME: Remove `hashsum` subcommand before v11.0.0 is released.
-		text.Warning(out, "This command is deprecated. Use `fastly compute hash-files` instead.")
-	}
-
 	// No point in building a package if the user provides a package path.
 	if !c.SkipBuild && c.PackagePath == "" {
 		err = c.Build(in, out)
@@ -6,8 +6,6 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/mholt/archiver/v3"
-
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/filesystem"
@@ -52,6 +50,10 @@ func (c *PackCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
+	if err = filesystem.CheckClobber("pkg/package.tar.gz"); err != nil {
+		return err
+	}
+
 	bin := "pkg/package/bin/main.wasm"
 	bindir := filepath.Dir(bin)
 
@@ -117,13 +119,11 @@ func (c *PackCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 	}
 
 	return spinner.Process("Creating package.tar.gz file", func(_ *text.SpinnerWrapper) error {
-		tar := archiver.NewTarGz()
-		tar.OverwriteExisting = true
 		{
 			dir := "pkg/package"
 			src := []string{dir}
 			dst := fmt.Sprintf("%s.tar.gz", dir)
-			if err = tar.Archive(src, dst); err != nil {
+			if err = writeTarGz(src, dst); err != nil {
 				c.Globals.ErrLog.AddWithContext(err, map[string]any{
 					"Tar source":      dir,
 					"Tar destination": dst,
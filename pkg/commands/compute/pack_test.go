@@ -19,6 +19,7 @@ func TestPack(t *testing.T) {
 		name          string
 		args          []string
 		manifest      string
+		existingFile  string
 		wantError     string
 		wantOutput    []string
 		expectedFiles [][]string
@@ -52,6 +53,39 @@ func TestPack(t *testing.T) {
 			name = "precompiled"`,
 			wantError: "error copying wasm binary",
 		},
+		{
+			name: "existing package is overwritten by default",
+			args: args("compute pack --wasm-binary ./main.wasm"),
+			manifest: `
+			manifest_version = 2
+			name = "mypackagename"`,
+			existingFile: "old package",
+			wantOutput: []string{
+				"Creating package.tar.gz file",
+			},
+		},
+		{
+			name: "existing package with --no-clobber",
+			args: args("compute pack --wasm-binary ./main.wasm --no-clobber"),
+			manifest: `
+			manifest_version = 2
+			name = "mypackagename"`,
+			existingFile: "old package",
+			wantError:    "refusing to overwrite existing file 'pkg/package.tar.gz' (--no-clobber)",
+		},
+		{
+			name: "no existing package with --no-clobber",
+			args: args("compute pack --wasm-binary ./main.wasm --no-clobber"),
+			manifest: `
+			manifest_version = 2
+			name = "mypackagename"`,
+			wantOutput: []string{
+				"Creating package.tar.gz file",
+			},
+			expectedFiles: [][]string{
+				{"pkg", "package.tar.gz"},
+			},
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			// We're going to chdir to a test environment,
@@ -69,6 +103,7 @@ func TestPack(t *testing.T) {
 				},
 				Write: []testutil.FileIO{
 					{Src: testcase.manifest, Dst: manifest.Filename},
+					{Src: testcase.existingFile, Dst: filepath.Join("pkg", "package.tar.gz")},
 				},
 			})
 			defer os.RemoveAll(rootdir)
@@ -102,6 +137,15 @@ func TestPack(t *testing.T) {
 					t.Fatalf("the specified file is not in the expected location: %v", err)
 				}
 			}
+
+			if testcase.existingFile != "" {
+				data, err := os.ReadFile(filepath.Join(rootdir, "pkg", "package.tar.gz"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				clobbered := string(data) != testcase.existingFile
+				testutil.AssertEqual(t, testcase.wantError == "", clobbered)
+			}
 		})
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	root "github.com/fastly/cli/pkg/commands/config"
+	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/testutil"
 )
 
@@ -56,3 +57,51 @@ func TestConfig(t *testing.T) {
 
 	testutil.RunCLIScenarios(t, []string{root.CommandName}, scenarios)
 }
+
+func TestConfigShowEffective(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Name: "validate settings are resolved from their defaults and the config file",
+			Args: "--effective",
+			WantOutputs: []string{
+				"SETTING           VALUE                        SOURCE",
+				"api_endpoint      https://api.fastly.com       default",
+				"api_version                                    not set",
+				"debug_mode        false                        default",
+				"profile           user                         default",
+				"token             REDACTED                     config file (profile: user)",
+			},
+			DontWantOutput: "mock-token",
+		},
+		{
+			Name:    "validate settings are resolved from flags and the environment",
+			Args:    "--effective --api-version 2024-01-01 --debug-mode --token abc",
+			EnvVars: map[string]string{"FASTLY_SERVICE_ID": "123"},
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.Env.APIEndpoint = "https://example.com"
+				opts.Env.NoUpdateCheck = "true"
+			},
+			WantOutputs: []string{
+				"api_endpoint      https://example.com          environment (FASTLY_API_ENDPOINT)",
+				"api_version       2024-01-01                   flag",
+				"debug_mode        true                         flag",
+				"no_update_check   true                         environment (FASTLY_NO_UPDATE_CHECK)",
+				"service_id        123                          environment (FASTLY_SERVICE_ID)",
+				"token             REDACTED                     flag",
+			},
+			DontWantOutput: "abc",
+		},
+		{
+			Name:       "validate settings are rendered as JSON",
+			Args:       "--effective --json --json-compact",
+			WantOutput: `{"name":"token","value":"REDACTED","source":"config file (profile: user)"}`,
+		},
+		{
+			Name:      "validate --json requires --effective",
+			Args:      "--json",
+			WantError: "invalid flag combination, --json requires --effective",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "show"}, scenarios)
+}
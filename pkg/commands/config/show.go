@@ -0,0 +1,162 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/env"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/lookup"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ShowCommand displays the CLI configuration file, or the effective settings
+// resolved from all sources (flags, environment, files and defaults).
+//
+// NOTE: It's the default subcommand, so `fastly config` (along with its
+// --location and --reset flags) continues to work.
+type ShowCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	effective bool
+	root      *RootCommand
+}
+
+// Setting is a resolved configuration value along with where it came from.
+type Setting struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+// NewShowCommand returns a usable command registered under the parent.
+func NewShowCommand(parent *RootCommand, g *global.Data) *ShowCommand {
+	c := ShowCommand{root: parent}
+	c.Globals = g
+	c.CmdClause = parent.CmdClause.Command("show", "Display the CLI configuration file, or the effective settings the CLI would use").Default()
+
+	// Optional.
+	c.CmdClause.Flag("effective", "Display the settings resolved from flags, environment variables, files and defaults (and the source of each)").BoolVar(&c.effective)
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ShowCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.JSONOutput.Enabled && !c.effective {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid flag combination, --json requires --effective"),
+			Remediation: "Use --json with --effective, or omit --json to display the configuration file.",
+		}
+	}
+
+	if !c.effective {
+		return c.root.Exec(in, out)
+	}
+
+	settings := EffectiveSettings(c.Globals)
+	if ok, err := c.WriteOutput(out, settings); ok {
+		return err
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("SETTING", "VALUE", "SOURCE")
+	for _, s := range settings {
+		tw.AddLine(s.Name, s.Value, s.Source)
+	}
+	tw.Print()
+	return nil
+}
+
+// EffectiveSettings resolves the settings the CLI uses, via the same global.Data
+// methods used when executing a command. The API token is redacted.
+func EffectiveSettings(g *global.Data) []Setting {
+	profileName, profileSource := g.ProfileName()
+	// NOTE: The default profile is resolved to its actual name (if one exists).
+	if name, _, err := g.Profile(); err == nil {
+		profileName = name
+	} else if profileSource == lookup.SourceDefault {
+		profileName, profileSource = "", lookup.SourceUndefined
+	}
+
+	token, tokenSource := g.Token()
+	tokenSourceName := sourceName(tokenSource, "config file", env.APIToken)
+	if tokenSource == lookup.SourceFile && profileName != "" {
+		tokenSourceName = fmt.Sprintf("config file (profile: %s)", profileName)
+	}
+	if token != "" {
+		token = "REDACTED"
+	}
+
+	apiEndpoint, apiEndpointSource := g.APIEndpoint()
+	accountEndpoint, accountEndpointSource := g.AccountEndpoint()
+	apiVersion, apiVersionSource := g.APIVersion()
+	serviceID, serviceIDSource := g.Manifest.ServiceID()
+
+	return []Setting{
+		{Name: "account_endpoint", Value: accountEndpoint, Source: sourceName(accountEndpointSource, "config file", env.AccountEndpoint)},
+		{Name: "api_endpoint", Value: apiEndpoint, Source: sourceName(apiEndpointSource, "config file", env.APIEndpoint)},
+		{Name: "api_version", Value: apiVersion, Source: sourceName(apiVersionSource, "config file", "")},
+		{Name: "config_path", Value: g.ConfigPath, Source: sourceName(lookup.SourceDefault, "", "")},
+		boolSetting("debug_mode", g.Flags.Debug, g.Env.DebugMode, env.DebugMode),
+		boolSetting("no_update_check", g.Flags.NoUpdateCheck, g.Env.NoUpdateCheck, env.NoUpdateCheck),
+		{Name: "profile", Value: profileName, Source: sourceName(profileSource, "fastly.toml", "")},
+		{Name: "service_id", Value: serviceID, Source: manifestSourceName(serviceIDSource)},
+		{Name: "token", Value: token, Source: tokenSourceName},
+	}
+}
+
+// boolSetting resolves a setting that can be enabled by a flag or by an
+// environment variable (the flag takes precedence).
+func boolSetting(name string, flag bool, envValue, envName string) Setting {
+	if flag {
+		return Setting{Name: name, Value: "true", Source: sourceName(lookup.SourceFlag, "", "")}
+	}
+	if v, err := strconv.ParseBool(envValue); err == nil && v {
+		return Setting{Name: name, Value: "true", Source: sourceName(lookup.SourceEnvironment, "", envName)}
+	}
+	return Setting{Name: name, Value: "false", Source: sourceName(lookup.SourceDefault, "", "")}
+}
+
+// sourceName describes the source of a setting, where file names the file
+// lookup.SourceFile refers to and envVar the environment variable (if any)
+// lookup.SourceEnvironment refers to.
+func sourceName(s lookup.Source, file, envVar string) string {
+	switch s {
+	case lookup.SourceFlag:
+		return "flag"
+	case lookup.SourceEnvironment:
+		if envVar != "" {
+			return fmt.Sprintf("environment (%s)", envVar)
+		}
+		return "environment"
+	case lookup.SourceFile:
+		return file
+	case lookup.SourceDefault:
+		return "default"
+	case lookup.SourceUndefined:
+	}
+	return "not set"
+}
+
+// manifestSourceName describes the source of a setting resolved via the
+// manifest package.
+func manifestSourceName(s manifest.Source) string {
+	switch s {
+	case manifest.SourceFlag:
+		return "flag"
+	case manifest.SourceEnv:
+		return fmt.Sprintf("environment (%s)", env.ServiceID)
+	case manifest.SourceFile:
+		return "fastly.toml"
+	case manifest.SourceUndefined:
+	}
+	return "not set"
+}
@@ -26,6 +26,7 @@ func NewDeleteCommand(parent argparser.Registerer, g *global.Data) *DeleteComman
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -57,7 +58,7 @@ func (c *DeleteCommand) Exec(_ io.Reader, out io.Writer) error {
 			c.input.StoreID,
 			true,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
@@ -26,6 +26,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlagBool(argparser.BoolFlagOpts{
 		Name:        "metadata",
 		Short:       'm',
@@ -78,7 +80,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 			Metadata:    csm,
 		}
 
-		if ok, err := c.WriteJSON(out, data); ok {
+		if ok, err := c.WriteOutput(out, data); ok {
 			return err
 		}
 	}
@@ -26,6 +26,7 @@ func NewListServicesCommand(parent argparser.Registerer, g *global.Data) *ListSe
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -49,7 +50,7 @@ func (c *ListServicesCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
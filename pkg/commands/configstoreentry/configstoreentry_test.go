@@ -9,6 +9,7 @@ import (
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
+	"github.com/fastly/cli/pkg/argparser"
 	root "github.com/fastly/cli/pkg/commands/configstoreentry"
 	fstfmt "github.com/fastly/cli/pkg/fmt"
 	"github.com/fastly/cli/pkg/mock"
@@ -105,10 +106,6 @@ func TestDeleteEntryCommand(t *testing.T) {
 			Args:      "--store-id " + storeID,
 			WantError: "invalid command, neither --all or --key provided",
 		},
-		{
-			Args:      "--json --all --store-id " + storeID,
-			WantError: "invalid flag combination, --all and --json",
-		},
 		{
 			Args:      "--key a-key --all --store-id " + storeID,
 			WantError: "invalid flag combination, --all and --key",
@@ -163,6 +160,9 @@ Deleting key: key-01
 Deleting key: key-02
 
 SUCCESS: Deleted all keys from Config Store '%s'
+
+TOTAL  SUCCEEDED  FAILED  SKIPPED
+3      3          0       0
 `, storeID),
 		},
 		{
@@ -175,7 +175,65 @@ SUCCESS: Deleted all keys from Config Store '%s'
 					return errors.New("whoops")
 				},
 			},
-			WantError: "failed to delete keys: key-00, key-01, key-02",
+			WantError:  "failed to delete 3 of 3 keys: key-00, key-01, key-02",
+			WantOutput: "TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      0          3       0\n\nFAILED  ERROR\nkey-00  whoops\n",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --ignore-errors", storeID),
+			API: mock.API{
+				ListConfigStoreItemsFn: func(i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+					return testItems, nil
+				},
+				DeleteConfigStoreItemFn: func(i *fastly.DeleteConfigStoreItemInput) error {
+					if i.Key == "key-01" {
+						return errors.New("whoops")
+					}
+					return nil
+				},
+			},
+			WantOutput:     "TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      2          1       0\n\nFAILED  ERROR\nkey-01  whoops\n",
+			DontWantOutput: "SUCCESS",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --on-error abort", storeID),
+			API: mock.API{
+				ListConfigStoreItemsFn: func(i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+					return testItems, nil
+				},
+				DeleteConfigStoreItemFn: func(i *fastly.DeleteConfigStoreItemInput) error {
+					return errors.New("whoops")
+				},
+			},
+			WantError:  "failed to delete 1 of 3 keys: key-00",
+			WantOutput: "TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      0          1       2\n",
+		},
+		{
+			Args:      fmt.Sprintf("--store-id %s --all --auto-yes --on-error retry", storeID),
+			WantError: "enum value must be one of continue,abort, got 'retry'",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --json", storeID),
+			API: mock.API{
+				ListConfigStoreItemsFn: func(i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+					return testItems, nil
+				},
+				DeleteConfigStoreItemFn: func(i *fastly.DeleteConfigStoreItemInput) error {
+					if i.Key == "key-01" {
+						return errors.New("whoops")
+					}
+					return nil
+				},
+			},
+			WantError: "failed to delete 1 of 3 keys: key-01",
+			WantOutput: fstfmt.EncodeJSON(argparser.BulkReport{
+				Summary: argparser.BulkSummary{Total: 3, Succeeded: 2, Failed: 1},
+				Results: []argparser.BulkItem{
+					{ID: "key-00", Status: argparser.BulkSucceeded},
+					{ID: "key-01", Status: argparser.BulkFailed, Error: "whoops"},
+					{ID: "key-02", Status: argparser.BulkSucceeded},
+				},
+			}),
+			DontWantOutput: "Deleting key",
 		},
 	}
 
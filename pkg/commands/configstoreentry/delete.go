@@ -1,23 +1,20 @@
 package configstoreentry
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"strings"
-	"sync"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/prompt"
 	"github.com/fastly/cli/pkg/text"
 )
 
-// deleteKeysConcurrencyLimit is used to limit the concurrency when deleting ALL keys.
-// This is effectively the 'thread pool' size.
-const deleteKeysConcurrencyLimit int = 100
-
 // batchLimit is used to split the list of items into batches.
 // The batch size of 100 aligns with the KV Store pagination default limit.
 const batchLimit int = 100
@@ -38,14 +35,18 @@ func NewDeleteCommand(parent argparser.Registerer, g *global.Data) *DeleteComman
 	// Optional.
 	c.CmdClause.Flag("all", "Delete all entries within the store").Short('a').BoolVar(&c.deleteAll)
 	c.CmdClause.Flag("batch-size", "Key batch processing size (ignored when set without the --all flag)").Short('b').Action(c.batchSize.Set).IntVar(&c.batchSize.Value)
-	c.CmdClause.Flag("concurrency", "Control thread pool size (ignored when set without the --all flag)").Short('c').Action(c.concurrency.Set).IntVar(&c.concurrency.Value)
+	c.CmdClause.Flag(argparser.FlagConcurrencyName, argparser.FlagConcurrencyDesc+" (ignored when set without the --all flag)").Short('c').IntVar(&c.concurrency)
+	c.CmdClause.Flag(argparser.FlagIgnoreErrorsName, argparser.FlagIgnoreErrorsDesc+" (ignored when set without the --all flag)").BoolVar(&c.ignoreErrors)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        "key",
 		Short:       'k',
 		Description: "Item name",
 		Dst:         &c.input.Key,
 	})
+	c.CmdClause.Flag(argparser.FlagOnErrorName, argparser.FlagOnErrorDesc+" (ignored when set without the --all flag)").Default(argparser.OnErrorContinue).HintOptions(argparser.OnErrorBehaviours...).EnumVar(&c.onError, argparser.OnErrorBehaviours...)
+	c.CmdClause.Flag(argparser.FlagTimeoutPerItemName, argparser.FlagTimeoutPerItemDesc+" (ignored when set without the --all flag)").DurationVar(&c.timeoutPerItem)
 
 	return &c
 }
@@ -55,10 +56,13 @@ type DeleteCommand struct {
 	argparser.Base
 	argparser.JSONOutput
 
-	batchSize   argparser.OptionalInt
-	concurrency argparser.OptionalInt
-	deleteAll   bool
-	input       fastly.DeleteConfigStoreItemInput
+	batchSize      argparser.OptionalInt
+	concurrency    int
+	deleteAll      bool
+	ignoreErrors   bool
+	input          fastly.DeleteConfigStoreItemInput
+	onError        string
+	timeoutPerItem time.Duration
 }
 
 // Exec invokes the application logic for the command.
@@ -66,10 +70,6 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 	if c.Globals.Verbose() && c.JSONOutput.Enabled {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
-	// TODO: Support --json for bulk deletions.
-	if c.deleteAll && c.JSONOutput.Enabled {
-		return fsterr.ErrInvalidDeleteAllJSONKeyCombo
-	}
 	if c.deleteAll && c.input.Key != "" {
 		return fsterr.ErrInvalidDeleteAllKeyCombo
 	}
@@ -80,7 +80,7 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 	if c.deleteAll {
 		if !c.Globals.Flags.AutoYes && !c.Globals.Flags.NonInteractive {
 			text.Warning(out, "This will delete ALL entries from your store!\n\n")
-			cont, err := text.AskYesNo(out, "Are you sure you want to continue? [y/N]: ", in)
+			cont, err := prompt.Confirm(out, in, "Are you sure you want to continue?", false)
 			if err != nil {
 				return err
 			}
@@ -108,7 +108,7 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 			c.input.Key,
 			true,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
@@ -125,18 +125,8 @@ func (c *DeleteCommand) deleteAllKeys(out io.Writer) error {
 		return fmt.Errorf("failed to acquire list of Config Store items: %w", err)
 	}
 
-	var (
-		mu sync.Mutex
-		wg sync.WaitGroup
-	)
-	poolSize := deleteKeysConcurrencyLimit
-	if c.concurrency.WasSet {
-		poolSize = c.concurrency.Value
-	}
-	semaphore := make(chan struct{}, poolSize)
-
+	result := argparser.BulkResult{Action: "delete", Noun: "keys", OnError: c.onError}
 	total := len(items)
-	failedKeys := []string{}
 
 	batchSize := batchLimit
 	if c.batchSize.WasSet {
@@ -146,39 +136,58 @@ func (c *DeleteCommand) deleteAllKeys(out io.Writer) error {
 	// With KV Store we have pagination support and so that natively provides us a
 	// predefined 'batch' size. Because we don't have pagination with the Config
 	// Store it means we'll define our own batch size which the user can override.
-	for i := 0; i < total; i += batchSize {
-		end := i + batchSize
-		if end > total {
-			end = total
+	batches := make(chan []*fastly.ConfigStoreItem)
+
+	// NOTE: If the user interrupts the process (Ctrl-C) no further keys are
+	// deleted, and the keys deleted so far are reported.
+	ctx, stop := argparser.BulkContext()
+	defer stop()
+
+	go func() {
+		defer close(batches)
+		for i := 0; i < total; i += batchSize {
+			select {
+			case batches <- items[i:min(i+batchSize, total)]:
+			case <-ctx.Done():
+				return
+			}
 		}
-		seg := items[i:end]
-
-		wg.Add(1)
-		go func(items []*fastly.ConfigStoreItem) {
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
-			defer wg.Done()
-
-			for _, item := range items {
+	}()
+
+	poolSize := argparser.PoolSize(c.concurrency, c.Globals.RateLimit)
+	err = argparser.RunPool(ctx, poolSize, batches, func(ctx context.Context, items []*fastly.ConfigStoreItem) error {
+		for _, item := range items {
+			if result.Aborted() || ctx.Err() != nil {
+				result.Skipped(item.Key)
+				continue
+			}
+			if !c.JSONOutput.Enabled {
 				text.Output(out, "Deleting key: %s", item.Key)
-				err := c.Globals.APIClient.DeleteConfigStoreItem(&fastly.DeleteConfigStoreItemInput{StoreID: c.input.StoreID, Key: item.Key})
-				if err != nil {
-					c.Globals.ErrLog.Add(fmt.Errorf("failed to delete key '%s': %s", item.Key, err))
-					mu.Lock()
-					failedKeys = append(failedKeys, item.Key)
-					mu.Unlock()
-				}
 			}
-		}(seg)
+			err := argparser.RunItem(ctx, c.timeoutPerItem, func(_ context.Context) error {
+				return c.Globals.APIClient.DeleteConfigStoreItem(&fastly.DeleteConfigStoreItemInput{StoreID: c.input.StoreID, Key: item.Key})
+			})
+			if err != nil {
+				c.Globals.ErrLog.Add(fmt.Errorf("failed to delete key '%s': %s", item.Key, err))
+				result.Failed(item.Key, err)
+				continue
+			}
+			result.Succeeded(item.Key)
+		}
+		return nil
+	})
+	if err != nil {
+		if err := result.Render(out, c.JSONOutput); err != nil {
+			return err
+		}
+		return fmt.Errorf("error deleting keys: %w", err)
 	}
 
-	wg.Wait()
-	close(semaphore)
-
-	if len(failedKeys) > 0 {
-		return fmt.Errorf("failed to delete keys: %s", strings.Join(failedKeys, ", "))
+	if !c.JSONOutput.Enabled && result.Report().Summary.Failed == 0 {
+		text.Success(out, "\nDeleted all keys from Config Store '%s'", c.input.StoreID)
 	}
-
-	text.Success(out, "\nDeleted all keys from Config Store '%s'", c.input.StoreID)
-	return nil
+	if err := result.Render(out, c.JSONOutput); err != nil {
+		return err
+	}
+	return result.Err(c.ignoreErrors)
 }
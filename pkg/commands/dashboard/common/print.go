@@ -56,8 +56,8 @@ func PrintDashboard(out io.Writer, indent uint, dashboard *fastly.ObservabilityC
 
 	text.Indent(out, level, "Meta:")
 	level += indentStep
-	text.Indent(out, level, "Created at: %s", dashboard.CreatedAt)
-	text.Indent(out, level, "Updated at: %s", dashboard.UpdatedAt)
+	text.Indent(out, level, "Created at: %s", text.FormatTime(out, dashboard.CreatedAt))
+	text.Indent(out, level, "Updated at: %s", text.FormatTime(out, dashboard.UpdatedAt))
 	text.Indent(out, level, "Created by: %s", dashboard.CreatedBy)
 	text.Indent(out, level, "Updated by: %s", dashboard.UpdatedBy)
 }
@@ -22,6 +22,7 @@ func NewCreateCommand(parent argparser.Registerer, globals *global.Data) *Create
 
 	// Optional flags
 	c.RegisterFlagBool(c.JSONFlag())                                                                                                  // --json
+	c.RegisterFieldFlag(c.CmdClause)                                                                                                  // --field
 	c.CmdClause.Flag("description", "A short description of the dashboard").Action(c.description.Set).StringVar(&c.description.Value) // --description
 
 	return &c
@@ -48,7 +49,7 @@ func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, dashboard); ok {
+	if ok, err := c.WriteOutput(out, dashboard); ok {
 		return err
 	}
 
@@ -22,6 +22,7 @@ func NewDeleteCommand(parent argparser.Registerer, globals *global.Data) *Delete
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -54,7 +55,7 @@ func (c *DeleteCommand) Exec(_ io.Reader, out io.Writer) error {
 			c.dashboardID,
 			true,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
@@ -22,6 +22,8 @@ func NewDescribeCommand(parent argparser.Registerer, globals *global.Data) *Desc
 
 	// Optional flags
 	c.RegisterFlagBool(c.JSONFlag())
+	c.RegisterYAMLFlag(c.CmdClause)
+	c.RegisterFieldFlag(c.CmdClause)
 	return &c
 }
 
@@ -45,7 +47,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, dashboard); ok {
+	if ok, err := c.WriteOutput(out, dashboard); ok {
 		return err
 	}
 
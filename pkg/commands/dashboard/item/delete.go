@@ -24,6 +24,7 @@ func NewDeleteCommand(parent argparser.Registerer, globals *global.Data) *Delete
 
 	// Optional flags
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -74,7 +75,7 @@ func (c *DeleteCommand) Exec(_ io.Reader, out io.Writer) error {
 			success,
 			d,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
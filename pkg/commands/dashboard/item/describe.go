@@ -24,6 +24,8 @@ func NewDescribeCommand(parent argparser.Registerer, globals *global.Data) *Desc
 
 	// Optional flags
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -57,7 +59,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 	}
 
 	if c.JSONOutput.Enabled {
-		c.WriteJSON(out, di)
+		c.WriteOutput(out, di)
 	} else {
 		common.PrintItem(out, 0, di)
 	}
@@ -24,6 +24,7 @@ func NewUpdateCommand(parent argparser.Registerer, globals *global.Data) *Update
 
 	// Optional flags
 	c.RegisterFlagBool(c.JSONFlag())                                                                                                                                                                                               // --json
+	c.RegisterFieldFlag(c.CmdClause)                                                                                                                                                                                               // --field
 	c.CmdClause.Flag("title", "A human-readable title for the dashboard item").Action(c.title.Set).StringVar(&c.title.Value)                                                                                                       // --title
 	c.CmdClause.Flag("subtitle", "A human-readable subtitle for the dashboard item. Often a description of the visualization").Action(c.subtitle.Set).StringVar(&c.subtitle.Value)                                                 // --subtitle
 	c.CmdClause.Flag("span", `The number of columns for the dashboard item to span. Dashboards are rendered on a 12-column grid on "desktop" screen sizes`).Action(c.span.Set).IntVar(&c.span.Value)                               // --span
@@ -79,7 +80,7 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, d); ok {
+	if ok, err := c.WriteOutput(out, d); ok {
 		return err
 	}
 
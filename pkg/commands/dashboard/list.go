@@ -21,6 +21,7 @@ func NewListCommand(parent argparser.Registerer, globals *global.Data) *ListComm
 
 	// Optional Flags
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("cursor", "Pagination cursor (Use 'next_cursor' value from list output)").Action(c.cursor.Set).StringVar(&c.cursor.Value)
 	c.CmdClause.Flag("limit", "Maximum number of items to list").Action(c.limit.Set).IntVar(&c.limit.Value)
 	c.CmdClause.Flag("order", "Sort by one of the following [asc, desc]").Action(c.order.Set).StringVar(&c.order.Value)
@@ -94,7 +95,7 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 		return nil
 	}
 
-	if ok, err := c.WriteJSON(out, dashboards); ok {
+	if ok, err := c.WriteOutput(out, dashboards); ok {
 		// No pagination prompt w/ JSON output.
 		return err
 	}
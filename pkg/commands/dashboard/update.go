@@ -22,6 +22,7 @@ func NewUpdateCommand(parent argparser.Registerer, globals *global.Data) *Update
 
 	// Optional flags
 	c.RegisterFlagBool(c.JSONFlag())                                                                                                  // --json
+	c.RegisterFieldFlag(c.CmdClause)                                                                                                  // --field
 	c.CmdClause.Flag("name", "A human-readable name for the dashboard").Short('n').Action(c.name.Set).StringVar(&c.name.Value)        // --name
 	c.CmdClause.Flag("description", "A short description of the dashboard").Action(c.description.Set).StringVar(&c.description.Value) // --description
 
@@ -50,7 +51,7 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, dashboard); ok {
+	if ok, err := c.WriteOutput(out, dashboard); ok {
 		return err
 	}
 
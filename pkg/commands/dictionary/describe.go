@@ -41,6 +41,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -138,7 +140,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 
 		o := &container{Dictionary: dictionary, DictionaryInfo: info, Items: items}
 
-		if ok, err := c.WriteJSON(out, o); ok {
+		if ok, err := c.WriteOutput(out, o); ok {
 			return err
 		}
 	}
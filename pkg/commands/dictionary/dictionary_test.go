@@ -396,8 +396,8 @@ Version: 4
 ID: 456
 Name: dict-1
 Write Only: false
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
 `) + "\n"
 
 var describeDictionaryOutput = strings.TrimSpace(`
@@ -406,8 +406,8 @@ Version: 1
 ID: 456
 Name: dict-1
 Write Only: false
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
 `) + "\n"
 
 var describeDictionaryOutputDeleted = strings.TrimSpace(`
@@ -416,9 +416,9 @@ Version: 1
 ID: 456
 Name: dict-1
 Write Only: false
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
-Deleted (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
+Deleted: 2001-02-03T04:05:08Z
 `) + "\n"
 
 var describeDictionaryOutputVerbose = strings.TrimSpace(`
@@ -431,8 +431,8 @@ Version: 1
 ID: 456
 Name: dict-1
 Write Only: false
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
 Digest: digest_hash
 Item Count: 2
 Item 1/2:
@@ -449,11 +449,11 @@ Version: 1
 ID: 456
 Name: dict-1
 Write Only: false
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
 ID: 456
 Name: dict-2
 Write Only: false
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
 `) + "\n"
@@ -315,8 +315,8 @@ var describeDictionaryItemOutput = "\n" + `Service ID: 123
 Dictionary ID: 456
 Item Key: foo
 Item Value: bar
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
 `
 
 var updateDictionaryItemOutput = `SUCCESS: Updated dictionary item (service 123)
@@ -324,8 +324,8 @@ var updateDictionaryItemOutput = `SUCCESS: Updated dictionary item (service 123)
 Dictionary ID: 456
 Item Key: foo
 Item Value: bar
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
 `
 
 func describeDictionaryItemOKDeleted(i *fastly.GetDictionaryItemInput) (*fastly.DictionaryItem, error) {
@@ -345,9 +345,9 @@ Service ID: 123
 Dictionary ID: 456
 Item Key: foo-deleted
 Item Value: bar
-Created (UTC): 2001-02-03 04:05
-Last edited (UTC): 2001-02-03 04:05
-Deleted (UTC): 2001-02-03 04:06
+Created: 2001-02-03T04:05:06Z
+Last edited: 2001-02-03T04:05:07Z
+Deleted: 2001-02-03T04:06:08Z
 `) + "\n"
 
 var listDictionaryItemsOutput = "\n" + strings.TrimSpace(`
@@ -356,16 +356,16 @@ Item: 1/2
 	Dictionary ID: 123
 	Item Key: foo
 	Item Value: bar
-	Created (UTC): 2021-06-15 23:00
-	Last edited (UTC): 2021-06-15 23:00
+	Created: 2021-06-15T23:00:00Z
+	Last edited: 2021-06-15T23:00:00Z
 
 Item: 2/2
 	Dictionary ID: 456
 	Item Key: baz
 	Item Value: qux
-	Created (UTC): 2021-06-15 23:00
-	Last edited (UTC): 2021-06-15 23:00
-	Deleted (UTC): 2021-06-15 23:00
+	Created: 2021-06-15T23:00:00Z
+	Last edited: 2021-06-15T23:00:00Z
+	Deleted: 2021-06-15T23:00:00Z
 `) + "\n\n"
 
 func createDictionaryItemOK(i *fastly.CreateDictionaryItemInput) (*fastly.DictionaryItem, error) {
@@ -15,13 +15,15 @@ import (
 // ListCommand calls the Fastly API to list dictionary items.
 type ListCommand struct {
 	argparser.Base
+	argparser.CountOutput
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
-	direction     string
-	input         fastly.GetDictionaryItemsInput
-	page, perPage int
-	serviceName   argparser.OptionalServiceNameID
-	sort          string
+	direction   string
+	input       fastly.GetDictionaryItemsInput
+	page        int
+	serviceName argparser.OptionalServiceNameID
+	sort        string
 }
 
 // NewListCommand returns a usable command registered under the parent.
@@ -38,9 +40,14 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	// Optional.
 	c.CmdClause.Flag("direction", "Direction in which to sort results").Default(argparser.PaginationDirection[0]).HintOptions(argparser.PaginationDirection...).EnumVar(&c.direction, argparser.PaginationDirection...)
-	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlagBool(c.CountFlag())          // --count-only
+	c.RegisterFlagBool(c.JSONFlag())           // --json
+	c.RegisterFieldFlag(c.CmdClause)           // --field
+	c.RegisterFlagInt(c.LimitRecordsFlag())    // --limit
+	c.RegisterFlagBool(c.NoAutopaginateFlag()) // --no-autopaginate
+	c.RegisterFlagInt(c.PageSizeFlag())        // --page-size
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.page)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.perPage)
+	c.RegisterFlagInt(c.PerPageFlag()) // --per-page
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -73,27 +80,31 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 
 	c.input.Direction = &c.direction
 	c.input.Page = &c.page
-	c.input.PerPage = &c.perPage
+	c.input.PerPage = c.PageSizeInput(c.Globals, out)
 	c.input.ServiceID = serviceID
 	c.input.Sort = &c.sort
 	paginator := c.Globals.APIClient.GetDictionaryItems(&c.input)
 
-	var o []*fastly.DictionaryItem
-	for paginator.HasNext() {
-		data, err := paginator.GetNext()
+	o, truncated, pageErr := argparser.Paginate(c.PaginationOutput, paginator, c.Globals.ErrLog, map[string]any{
+		"Dictionary ID": c.input.DictionaryID,
+		"Service ID":    serviceID,
+	})
+	if pageErr != nil && !argparser.IsPartialResult(pageErr) {
+		return pageErr
+	}
+
+	if ok, err := c.WriteCount(out, len(o), c.JSONOutput); ok {
 		if err != nil {
-			c.Globals.ErrLog.AddWithContext(err, map[string]any{
-				"Dictionary ID":   c.input.DictionaryID,
-				"Service ID":      serviceID,
-				"Remaining Pages": paginator.Remaining(),
-			})
 			return err
 		}
-		o = append(o, data...)
+		return pageErr
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
-		return err
+	if ok, err := c.WriteOutput(out, o); ok {
+		if err != nil {
+			return err
+		}
+		return pageErr
 	}
 
 	if !c.Globals.Verbose() {
@@ -104,6 +115,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		text.PrintDictionaryItem(out, "\t", dictionary)
 		text.Break(out)
 	}
+	c.DisplayTruncated(out, len(o), truncated)
 
-	return nil
+	return pageErr
 }
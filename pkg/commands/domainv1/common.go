@@ -33,8 +33,8 @@ func printVerbose(out io.Writer, data []v1.Data) {
 		if d.ServiceID != nil {
 			fmt.Fprintf(out, "Service ID: %s\n", *d.ServiceID)
 		}
-		fmt.Fprintf(out, "Created at: %s\n", d.CreatedAt)
-		fmt.Fprintf(out, "Updated at: %s\n", d.UpdatedAt)
+		fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, d.CreatedAt))
+		fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, d.UpdatedAt))
 		fmt.Fprintf(out, "\n")
 	}
 }
@@ -38,6 +38,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	c.CmdClause.Flag("cursor", "Cursor value from the next_cursor field of a previous response, used to retrieve the next page").Action(c.cursor.Set).StringVar(&c.cursor.Value)
 	c.CmdClause.Flag("fqdn", "Filters results by the FQDN using a fuzzy/partial match").Action(c.fqdn.Set).StringVar(&c.fqdn.Value)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("limit", "Limit how many results are returned").Action(c.limit.Set).IntVar(&c.limit.Value)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Action:      c.serviceID.Set,
@@ -92,7 +93,7 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 			return err
 		}
 
-		if ok, err := c.WriteJSON(out, cl); ok {
+		if ok, err := c.WriteOutput(out, cl); ok {
 			// No pagination prompt w/ JSON output.
 			return err
 		}
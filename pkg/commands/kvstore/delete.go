@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/fastly/cli/pkg/commands/kvstoreentry"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/prompt"
 	"github.com/fastly/cli/pkg/text"
 )
 
@@ -19,10 +21,12 @@ type DeleteCommand struct {
 	argparser.Base
 	argparser.JSONOutput
 
-	deleteAll bool
-	maxErrors int
-	poolSize  int
-	Input     fastly.DeleteKVStoreInput
+	deleteAll      bool
+	maxErrors      int
+	onError        string
+	poolSize       int
+	timeoutPerItem time.Duration
+	Input          fastly.DeleteKVStoreInput
 }
 
 // NewDeleteCommand returns a usable command registered under the parent.
@@ -39,9 +43,12 @@ func NewDeleteCommand(parent argparser.Registerer, g *global.Data) *DeleteComman
 
 	// Optional.
 	c.CmdClause.Flag("all", "Delete all entries within the store").Short('a').BoolVar(&c.deleteAll)
-	c.CmdClause.Flag("concurrency", "The thread pool size (ignored when set without the --all flag)").Default(strconv.Itoa(kvstoreentry.DeleteKeysPoolSize)).Short('r').IntVar(&c.poolSize)
+	c.CmdClause.Flag(argparser.FlagConcurrencyName, argparser.FlagConcurrencyDesc+" (ignored when set without the --all flag)").Short('r').IntVar(&c.poolSize)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
-	c.CmdClause.Flag("max-errors", "The number of errors to accept before stopping (ignored when set without the --all flag)").Default(strconv.Itoa(kvstoreentry.DeleteKeysMaxErrors)).Short('m').IntVar(&c.maxErrors)
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	c.CmdClause.Flag("max-errors", "The number of errors to accept before stopping, or 0 to stop on the first error (ignored when set without the --all flag)").Default(strconv.Itoa(kvstoreentry.DeleteKeysMaxErrors)).Short('m').IntVar(&c.maxErrors)
+	c.CmdClause.Flag(argparser.FlagOnErrorName, argparser.FlagOnErrorDesc+" (ignored when set without the --all flag)").Default(argparser.OnErrorContinue).HintOptions(argparser.OnErrorBehaviours...).EnumVar(&c.onError, argparser.OnErrorBehaviours...)
+	c.CmdClause.Flag(argparser.FlagTimeoutPerItemName, argparser.FlagTimeoutPerItemDesc+" (ignored when set without the --all flag)").DurationVar(&c.timeoutPerItem)
 	return &c
 }
 
@@ -54,7 +61,7 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 	if c.deleteAll {
 		if !c.Globals.Flags.AutoYes && !c.Globals.Flags.NonInteractive {
 			text.Warning(out, "This will delete ALL entries from your store!\n\n")
-			cont, err := text.AskYesNo(out, "Are you sure you want to continue? [y/N]: ", in)
+			cont, err := prompt.Confirm(out, in, "Are you sure you want to continue?", false)
 			if err != nil {
 				return err
 			}
@@ -67,10 +74,12 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 			Base: argparser.Base{
 				Globals: c.Globals,
 			},
-			DeleteAll: c.deleteAll,
-			MaxErrors: c.maxErrors,
-			PoolSize:  c.poolSize,
-			StoreID:   c.Input.StoreID,
+			DeleteAll:      c.deleteAll,
+			MaxErrors:      c.maxErrors,
+			OnError:        c.onError,
+			PoolSize:       c.poolSize,
+			StoreID:        c.Input.StoreID,
+			TimeoutPerItem: c.timeoutPerItem,
 		}
 		if err := dc.DeleteAllKeys(out); err != nil {
 			return err
@@ -92,7 +101,7 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 			c.Input.StoreID,
 			true,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
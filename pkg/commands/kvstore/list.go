@@ -28,6 +28,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -49,7 +50,7 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 			return err
 		}
 
-		if ok, err := c.WriteJSON(out, o); ok {
+		if ok, err := c.WriteOutput(out, o); ok {
 			// No pagination prompt w/ JSON output.
 			// FIXME: This should be fixed here and for Secrets Store.
 			return err
@@ -1,7 +1,7 @@
 package kvstoreentry
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"io"
 	"io/fs"
@@ -9,12 +9,13 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
-	"sync/atomic"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/backoff"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/runtime"
@@ -39,8 +40,11 @@ func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateComman
 	c.CmdClause.Flag("dir-concurrency", "Limit the number of concurrent network resources allocated").Default("50").IntVar(&c.dirConcurrency)
 	c.CmdClause.Flag("file", `Path to a file containing individual JSON objects (e.g., {"key":"...","value":"base64_encoded_value"}) separated by new-line delimiter`).StringVar(&c.filePath)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("key", "Key name").Short('k').StringVar(&c.Input.Key)
+	c.CmdClause.Flag(argparser.FlagOnErrorName, argparser.FlagOnErrorDesc+" (ignored when set without the --dir flag)").Default(argparser.OnErrorContinue).HintOptions(argparser.OnErrorBehaviours...).EnumVar(&c.onError, argparser.OnErrorBehaviours...)
 	c.CmdClause.Flag("stdin", "Read new-line separated JSON stream via STDIN").BoolVar(&c.stdin)
+	c.CmdClause.Flag(argparser.FlagTimeoutPerItemName, argparser.FlagTimeoutPerItemDesc+" (ignored when set without the --dir flag)").DurationVar(&c.timeoutPerItem)
 	c.CmdClause.Flag("value", "Value").StringVar(&c.Input.Value)
 
 	return &c
@@ -55,7 +59,9 @@ type CreateCommand struct {
 	dirConcurrency int
 	dirPath        string
 	filePath       string
+	onError        string
 	stdin          bool
+	timeoutPerItem time.Duration
 
 	Input fastly.InsertKVStoreKeyInput
 }
@@ -100,7 +106,7 @@ func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
 			c.Input.StoreID,
 			c.Input.Key,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
@@ -211,18 +217,18 @@ func (c *CreateCommand) ProcessDir(in io.Reader, out io.Writer) error {
 	filesVerboseOutput := make(chan string, filesTotal)
 
 	var (
-		processingErrors []ProcessErr
-		filesProcessed   uint64
-		// NOTE: mu protects access to the 'processingErrors' shared resource.
-		// We create multiple goroutines (one for each file) and each one has the
-		// potential to mutate the slice by appending new errors to it.
-		mu sync.Mutex
-		wg sync.WaitGroup
+		result = argparser.BulkResult{Action: "insert", Noun: "files", OnError: c.onError}
+		wg     sync.WaitGroup
 	)
 
+	// NOTE: The spinner is only updated from this goroutine, which exits once
+	// every worker has reported it has processed its file.
+	counted := make(chan struct{})
 	go func() {
+		defer close(counted)
+		var filesProcessed int
 		for range processed {
-			atomic.AddUint64(&filesProcessed, 1)
+			filesProcessed++
 			spinner.Message(fmt.Sprintf(msg, "Processing", filesProcessed, filesTotal) + "...")
 		}
 	}()
@@ -231,79 +237,67 @@ func (c *CreateCommand) ProcessDir(in io.Reader, out io.Writer) error {
 		wg.Add(1)
 
 		go func(file fs.DirEntry) {
+			// NOTE: Deferred calls run in reverse order, so the file is reported
+			// as processed before the WaitGroup is released.
+			defer wg.Done()
+
 			// Restrict resource allocation if concurrency limit is exceeded.
 			sem <- struct{}{}
 			defer func() {
 				processed <- struct{}{}
 				<-sem
 			}()
-			defer wg.Done()
 
 			filename := file.Name()
 			filePath := filepath.Join(path, filename)
 
-			if c.Globals.Verbose() {
-				filesVerboseOutput <- filename
-			}
-
-			// G304 (CWE-22): Potential file inclusion via variable
-			// #nosec
-			f, err := os.Open(filePath)
-			if err != nil {
-				mu.Lock()
-				processingErrors = append(processingErrors, ProcessErr{
-					File: filePath,
-					Err:  err,
-				})
-				mu.Unlock()
+			// With --on-error abort the remaining files are skipped once a file
+			// has failed.
+			if result.Aborted() {
+				result.Skipped(filePath)
 				return
 			}
 
-			lr, err := fastly.FileLengthReader(f)
-			if err != nil {
-				mu.Lock()
-				processingErrors = append(processingErrors, ProcessErr{
-					File: filePath,
-					Err:  err,
-				})
-				mu.Unlock()
-				return
+			if c.Globals.Verbose() {
+				filesVerboseOutput <- filename
 			}
 
 			opts := insertKeyOptions{
 				client: c.Globals.APIClient,
 				id:     c.Input.StoreID,
 				key:    filename,
-				file:   lr,
+				path:   filePath,
 			}
 
-			err = insertKey(opts)
-			if err != nil {
+			err := argparser.RunItem(context.Background(), c.timeoutPerItem, func(ctx context.Context) error {
 				// In case the network connection is lost due to exhaustion of
-				// resources, then try one more time to make the request.
-				//
-				// NOTE: you can't type assert the error as it's not exported.
-				// https://github.com/golang/go/issues/54173
-				if strings.Contains(err.Error(), "net/http: cannot rewind body after connection loss") {
-					err = insertKey(opts)
-					if err == nil {
-						return
+				// resources, or the request failed transiently, then try one
+				// more time to make the request. Any other error (e.g. a 4xx
+				// response) isn't retried.
+				return backoff.ExponentialBackoff{MaxAttempts: 2, Budget: c.Globals.RetryBudget}.Retry(ctx, func() error {
+					err := insertKey(opts)
+					// NOTE: you can't type assert the error as it's not exported.
+					// https://github.com/golang/go/issues/54173
+					if err != nil && !strings.Contains(err.Error(), "net/http: cannot rewind body after connection loss") && !fsterr.IsRetryable(err) {
+						return backoff.Permanent(err)
 					}
-				}
-				mu.Lock()
-				processingErrors = append(processingErrors, ProcessErr{
-					File: filePath,
-					Err:  err,
+					return err
 				})
-				mu.Unlock()
+			})
+			if err != nil {
+				result.Failed(filePath, err)
 				return
 			}
+			result.Succeeded(filePath)
 		}(file)
 	}
 
 	wg.Wait()
+	close(processed)
+	<-counted
 
-	spinner.StopMessage(fmt.Sprintf(msg, "Processed", atomic.LoadUint64(&filesProcessed)-uint64(len(processingErrors)), filesTotal))
+	report := result.Report()
+	spinner.StopMessage(fmt.Sprintf(msg, "Processed", report.Summary.Succeeded, filesTotal))
 	err = spinner.Stop()
 	if err != nil {
 		return err
@@ -317,17 +311,14 @@ func (c *CreateCommand) ProcessDir(in io.Reader, out io.Writer) error {
 		}
 	}
 
-	if len(processingErrors) == 0 {
-		text.Success(out, "\nInserted %d keys into KV Store", len(filteredFiles))
+	if report.Summary.Failed == 0 && !c.JSONOutput.Enabled {
+		text.Success(out, "\nInserted %d keys into KV Store", report.Summary.Succeeded)
 		return nil
 	}
-
-	text.Break(out)
-	for _, err := range processingErrors {
-		fmt.Printf("File: %s\nError: %s\n\n", err.File, err.Err.Error())
+	if err := result.Render(out, c.JSONOutput); err != nil {
+		return err
 	}
-
-	return errors.New("failed to process all the provided files (see error log above ⬆️)")
+	return result.Err(false)
 }
 
 // PromptWindowsUser ensures a user understands that we only filter files whose
@@ -366,7 +357,7 @@ func (c *CreateCommand) CallBatchEndpoint(in io.Reader, out io.Writer) error {
 		}
 
 		if c.JSONOutput.Enabled {
-			_, err := c.WriteJSON(out, r)
+			_, err := c.WriteOutput(out, r)
 			return err
 		}
 
@@ -387,7 +378,7 @@ func (c *CreateCommand) CallBatchEndpoint(in io.Reader, out io.Writer) error {
 	}
 
 	if c.JSONOutput.Enabled {
-		_, err := c.WriteJSON(out, result{Success: true})
+		_, err := c.WriteOutput(out, result{Success: true})
 		return err
 	}
 
@@ -398,9 +389,29 @@ func (c *CreateCommand) CallBatchEndpoint(in io.Reader, out io.Writer) error {
 	return nil
 }
 
+// insertKey inserts the content of the file at opts.path as the value of the
+// key.
+//
+// NOTE: The file is opened for every call, as a retried request can't reuse
+// the body consumed by the previous attempt.
 func insertKey(opts insertKeyOptions) error {
+	// G304 (CWE-22): Potential file inclusion via variable
+	// #nosec
+	f, err := os.Open(opts.path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	lr, err := fastly.FileLengthReader(f)
+	if err != nil {
+		return err
+	}
+
 	return opts.client.InsertKVStoreKey(&fastly.InsertKVStoreKeyInput{
-		Body:    opts.file,
+		Body:    lr,
 		StoreID: opts.id,
 		Key:     opts.key,
 	})
@@ -410,11 +421,5 @@ type insertKeyOptions struct {
 	client api.Interface
 	id     string
 	key    string
-	file   fastly.LengthReader
-}
-
-// ProcessErr represents an error related to processing individual files.
-type ProcessErr struct {
-	File string
-	Err  error
+	path   string
 }
@@ -1,24 +1,23 @@
 package kvstoreentry
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/prompt"
 	"github.com/fastly/cli/pkg/text"
 )
 
-// DeleteKeysPoolSize is the goroutine/thread-pool size.
-// Each pool will take a 'key' from a channel and issue a DELETE request.
-const DeleteKeysPoolSize int = 100
-
 // DeleteKeysMaxErrors is the maximum number of errors we'll allow before
 // stopping the goroutines from executing.
 const DeleteKeysMaxErrors int = 100
@@ -30,10 +29,13 @@ type DeleteCommand struct {
 	key argparser.OptionalString
 
 	// NOTE: Public fields can be set via `kv-store delete`.
-	DeleteAll bool
-	MaxErrors int
-	PoolSize  int
-	StoreID   string
+	DeleteAll      bool
+	IgnoreErrors   bool
+	MaxErrors      int
+	OnError        string
+	PoolSize       int
+	StoreID        string
+	TimeoutPerItem time.Duration
 }
 
 // NewDeleteCommand returns a usable command registered under the parent.
@@ -50,10 +52,14 @@ func NewDeleteCommand(parent argparser.Registerer, g *global.Data) *DeleteComman
 
 	// Optional.
 	c.CmdClause.Flag("all", "Delete all entries within the store").Short('a').BoolVar(&c.DeleteAll)
-	c.CmdClause.Flag("concurrency", "The thread pool size (ignored when set without the --all flag)").Default(strconv.Itoa(DeleteKeysPoolSize)).Short('r').IntVar(&c.PoolSize)
+	c.CmdClause.Flag(argparser.FlagConcurrencyName, argparser.FlagConcurrencyDesc+" (ignored when set without the --all flag)").Short('r').IntVar(&c.PoolSize)
+	c.CmdClause.Flag(argparser.FlagIgnoreErrorsName, argparser.FlagIgnoreErrorsDesc+" (ignored when set without the --all flag)").BoolVar(&c.IgnoreErrors)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("key", "Key name").Short('k').Action(c.key.Set).StringVar(&c.key.Value)
-	c.CmdClause.Flag("max-errors", "The number of errors to accept before stopping (ignored when set without the --all flag)").Default(strconv.Itoa(DeleteKeysMaxErrors)).Short('m').IntVar(&c.MaxErrors)
+	c.CmdClause.Flag("max-errors", "The number of errors to accept before skipping the remaining keys, or 0 to skip them on the first error (ignored when set without the --all flag)").Default(strconv.Itoa(DeleteKeysMaxErrors)).Short('m').IntVar(&c.MaxErrors)
+	c.CmdClause.Flag(argparser.FlagOnErrorName, argparser.FlagOnErrorDesc+" (ignored when set without the --all flag)").Default(argparser.OnErrorContinue).HintOptions(argparser.OnErrorBehaviours...).EnumVar(&c.OnError, argparser.OnErrorBehaviours...)
+	c.CmdClause.Flag(argparser.FlagTimeoutPerItemName, argparser.FlagTimeoutPerItemDesc+" (ignored when set without the --all flag)").DurationVar(&c.TimeoutPerItem)
 
 	return &c
 }
@@ -63,21 +69,23 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 	if c.Globals.Verbose() && c.JSONOutput.Enabled {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
-	// TODO: Support --json for bulk deletions.
-	if c.DeleteAll && c.JSONOutput.Enabled {
-		return fsterr.ErrInvalidDeleteAllJSONKeyCombo
-	}
 	if c.DeleteAll && c.key.WasSet {
 		return fsterr.ErrInvalidDeleteAllKeyCombo
 	}
 	if !c.DeleteAll && !c.key.WasSet {
 		return fsterr.ErrMissingDeleteAllKeyCombo
 	}
+	if c.MaxErrors < 0 {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid --max-errors value: %d", c.MaxErrors),
+			Remediation: "Provide the number of errors to accept before skipping the remaining keys, or 0 to skip them on the first error.",
+		}
+	}
 
 	if c.DeleteAll {
 		if !c.Globals.Flags.AutoYes && !c.Globals.Flags.NonInteractive {
 			text.Warning(out, "This will delete ALL entries from your store!\n\n")
-			cont, err := text.AskYesNo(out, "Are you sure you want to continue? [y/N]: ", in)
+			cont, err := prompt.Confirm(out, in, "Are you sure you want to continue?", false)
 			if err != nil {
 				return err
 			}
@@ -110,7 +118,7 @@ func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
 			c.StoreID,
 			true,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
@@ -124,8 +132,14 @@ func (c *DeleteCommand) DeleteAllKeys(out io.Writer) error {
 	spinnerMessage := "Deleting keys"
 	var spinner text.Spinner
 
+	// NOTE: The spinner would corrupt the JSON report.
+	spinnerOut := out
+	if c.JSONOutput.Enabled {
+		spinnerOut = io.Discard
+	}
+
 	var err error
-	spinner, err = text.NewSpinner(out)
+	spinner, err = text.NewSpinner(spinnerOut)
 	if err != nil {
 		return err
 	}
@@ -139,12 +153,12 @@ func (c *DeleteCommand) DeleteAllKeys(out io.Writer) error {
 		StoreID: c.StoreID,
 	})
 
-	errorsCh := make(chan string, c.MaxErrors)
 	keysCh := make(chan string, 1000) // number correlates to pagination page size
 
 	var (
 		deleteCount atomic.Uint64
-		failedKeys  []string
+		failCount   atomic.Int64
+		result      = argparser.BulkResult{Action: "delete", Noun: "keys", OnError: c.OnError}
 		wg          sync.WaitGroup
 	)
 
@@ -153,8 +167,14 @@ func (c *DeleteCommand) DeleteAllKeys(out io.Writer) error {
 	// 1. Pushing keys from pagination data into a key channel.
 	// 2. Pulling keys from key channel and issuing API DELETE call.
 	//
-	// We have a limit on the number of errors. Once that limit is reached we'll
-	// stop the 2. set of goroutines.
+	// We have a limit on the number of errors. Once that limit is reached (or
+	// any key fails with --on-error abort) the remaining keys are skipped
+	// (rather than deleted).
+
+	// NOTE: If the user interrupts the process (Ctrl-C) no further keys are
+	// deleted, and the keys deleted so far are reported.
+	ctx, stop := argparser.BulkContext()
+	defer stop()
 
 	wg.Add(1)
 	go func() {
@@ -162,52 +182,77 @@ func (c *DeleteCommand) DeleteAllKeys(out io.Writer) error {
 		defer close(keysCh)
 		for p.Next() {
 			for _, key := range p.Keys() {
-				keysCh <- key
+				select {
+				case keysCh <- key:
+				case <-ctx.Done():
+					return
+				}
 			}
 		}
 	}()
 
-	for range c.PoolSize {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for key := range keysCh {
-				err := c.Globals.APIClient.DeleteKVStoreKey(&fastly.DeleteKVStoreKeyInput{StoreID: c.StoreID, Key: key})
-				if err != nil {
-					select {
-					case errorsCh <- key:
-					default:
-						return // channel is blocked
-					}
-				}
-				spinner.Message(spinnerMessage + "..." + strconv.FormatUint(deleteCount.Add(1), 10))
-			}
-		}()
-	}
+	// NOTE: Failed keys are recorded in the result (rather than returned to
+	// the pool) so the pool only returns an error if it was canceled.
+	poolSize := argparser.PoolSize(c.PoolSize, c.Globals.RateLimit)
+	poolErr := argparser.RunPool(ctx, poolSize, keysCh, func(ctx context.Context, key string) error {
+		if c.maxErrorsReached(failCount.Load()) || result.Aborted() {
+			result.Skipped(key)
+			return nil
+		}
+		err := argparser.RunItem(ctx, c.TimeoutPerItem, func(_ context.Context) error {
+			return c.Globals.APIClient.DeleteKVStoreKey(&fastly.DeleteKVStoreKeyInput{StoreID: c.StoreID, Key: key})
+		})
+		if err != nil {
+			failCount.Add(1)
+			result.Failed(key, err)
+			return nil
+		}
+		result.Succeeded(key)
+		spinner.Message(spinnerMessage + "..." + strconv.FormatUint(deleteCount.Add(1), 10))
+		return nil
+	})
 
 	wg.Wait()
-
-	close(errorsCh)
-	for err := range errorsCh {
-		failedKeys = append(failedKeys, err)
+	if poolErr != nil {
+		spinner.StopFailMessage("Interrupted after deleting keys: " + strconv.FormatUint(deleteCount.Load(), 10))
+		_ = spinner.StopFail()
+		if err := result.Render(out, c.JSONOutput); err != nil {
+			return err
+		}
+		return fmt.Errorf("error deleting keys: %w", poolErr)
 	}
 
 	spinnerMessage = "Deleted keys: " + strconv.FormatUint(deleteCount.Load(), 10)
 
-	if len(failedKeys) > 0 {
+	if failCount.Load() > 0 {
 		spinner.StopFailMessage(spinnerMessage)
 		err := spinner.StopFail()
 		if err != nil {
 			return fmt.Errorf("failed to stop spinner: %w", err)
 		}
-		return fmt.Errorf("failed to delete %d keys", len(failedKeys))
+	} else {
+		spinner.StopMessage(spinnerMessage)
+		if err := spinner.Stop(); err != nil {
+			return fmt.Errorf("failed to stop spinner: %w", err)
+		}
+		if !c.JSONOutput.Enabled {
+			text.Success(out, "\nDeleted all keys from KV Store '%s'", c.StoreID)
+		}
 	}
 
-	spinner.StopMessage(spinnerMessage)
-	if err := spinner.Stop(); err != nil {
-		return fmt.Errorf("failed to stop spinner: %w", err)
+	if err := result.Render(out, c.JSONOutput); err != nil {
+		return err
 	}
+	if c.maxErrorsReached(failCount.Load()) && result.Report().Summary.Skipped > 0 && !c.JSONOutput.Enabled {
+		text.Break(out)
+		text.Info(out, "The remaining keys were skipped once %d keys failed to be deleted (see --max-errors).", failCount.Load())
+	}
+	return result.Err(c.IgnoreErrors)
+}
 
-	text.Success(out, "\nDeleted all keys from KV Store '%s'", c.StoreID)
-	return nil
+// maxErrorsReached reports whether the remaining keys should be skipped, as
+// the number of failed keys reached --max-errors (0 skips them on the first
+// error).
+func (c *DeleteCommand) maxErrorsReached(failed int64) bool {
+	return failed > 0 && failed >= int64(c.MaxErrors)
 }
@@ -3,16 +3,26 @@ package kvstoreentry_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
 	root "github.com/fastly/cli/pkg/commands/kvstoreentry"
 	fstfmt "github.com/fastly/cli/pkg/fmt"
+	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/threadsafe"
 )
 
 func TestCreateCommand(t *testing.T) {
@@ -22,6 +32,11 @@ func TestCreateCommand(t *testing.T) {
 		itemValue = "the-value"
 	)
 
+	// attempts counts the requests made to insert a key.
+	var attempts atomic.Int32
+	// body is the value sent by the last request made to insert a key.
+	var body atomic.Value
+
 	scenarios := []testutil.CLIScenario{
 		{
 			Args:      "--key a-key --value a-value",
@@ -99,6 +114,88 @@ func TestCreateCommand(t *testing.T) {
 			},
 			WantOutput: "SUCCESS: Inserted 2 keys into KV Store",
 		},
+		{
+			Name:  "validate the failed files are counted",
+			Args:  fmt.Sprintf("--store-id %s --dir %s --dir-allow-hidden", storeID, filepath.Join("testdata", "example")),
+			Stdin: []string{"y"},
+			API: mock.API{
+				InsertKVStoreKeyFn: func(i *fastly.InsertKVStoreKeyInput) error {
+					if i.Key == "foo.txt" {
+						return nil
+					}
+					return errors.New("invalid request")
+				},
+			},
+			WantOutputs: []string{
+				"Processed 1 of 2 files",
+				".hiddenfile  invalid request",
+			},
+			WantError: "failed to insert 1 of 2 files",
+		},
+		{
+			Name:  "validate the remaining files are skipped with --on-error abort",
+			Args:  fmt.Sprintf("--store-id %s --dir %s --dir-allow-hidden --dir-concurrency 1 --on-error abort --json", storeID, filepath.Join("testdata", "example")),
+			Stdin: []string{"y"},
+			API: mock.API{
+				InsertKVStoreKeyFn: func(_ *fastly.InsertKVStoreKeyInput) error {
+					return errors.New("invalid request")
+				},
+			},
+			WantOutput: `"summary": {
+    "total": 2,
+    "succeeded": 0,
+    "failed": 1,
+    "skipped": 1
+  }`,
+			WantError: "failed to insert 1 of 2 files",
+		},
+		{
+			Name:  "validate a 4xx response isn't retried",
+			Args:  fmt.Sprintf("--store-id %s --dir %s", storeID, filepath.Join("testdata", "example")),
+			Stdin: []string{"y"},
+			API: mock.API{
+				InsertKVStoreKeyFn: func(_ *fastly.InsertKVStoreKeyInput) error {
+					attempts.Add(1)
+					return &fastly.HTTPError{StatusCode: http.StatusBadRequest}
+				},
+			},
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				attempts.Store(0)
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, int32(1), attempts.Load())
+			},
+			WantError: "failed to insert 1 of 1 files",
+		},
+		{
+			Name:  "validate a refused connection is retried",
+			Args:  fmt.Sprintf("--store-id %s --dir %s", storeID, filepath.Join("testdata", "example")),
+			Stdin: []string{"y"},
+			API: mock.API{
+				InsertKVStoreKeyFn: func(i *fastly.InsertKVStoreKeyInput) error {
+					// The request body is consumed by every attempt.
+					b, err := io.ReadAll(i.Body)
+					if err != nil {
+						return err
+					}
+					body.Store(string(b))
+					if attempts.Add(1) == 1 {
+						return &url.Error{Op: "Put", URL: "https://api.fastly.com", Err: syscall.ECONNREFUSED}
+					}
+					return nil
+				},
+			},
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				attempts.Store(0)
+				body.Store("")
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, int32(2), attempts.Load())
+				// The retried request sends the whole file again.
+				testutil.AssertString(t, "FOO\n", body.Load().(string))
+			},
+			WantOutput: "SUCCESS: Inserted 1 keys into KV Store",
+		},
 	}
 
 	testutil.RunCLIScenarios(t, []string{root.CommandName, "create"}, scenarios)
@@ -119,10 +216,6 @@ func TestDeleteCommand(t *testing.T) {
 			Args:      "--store-id " + storeID,
 			WantError: "invalid command, neither --all or --key provided",
 		},
-		{
-			Args:      "--json --all --store-id " + storeID,
-			WantError: "invalid flag combination, --all and --json",
-		},
 		{
 			Args:      "--key a-key --all --store-id " + storeID,
 			WantError: "invalid flag combination, --all and --key",
@@ -167,7 +260,11 @@ func TestDeleteCommand(t *testing.T) {
 					return nil
 				},
 			},
-			WantOutput: "Deleting keys...",
+			WantOutputs: []string{
+				"Deleting keys...",
+				"SUCCESS: Deleted all keys from KV Store 'store-id-123'",
+				"TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      3          0       0\n",
+			},
 		},
 		{
 			Args: fmt.Sprintf("--store-id %s --all --auto-yes", storeID),
@@ -182,7 +279,154 @@ func TestDeleteCommand(t *testing.T) {
 					return errors.New("whoops")
 				},
 			},
-			WantError: "failed to delete 3 keys",
+			WantError: "failed to delete 3 of 3 keys: bar, baz, foo",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --concurrency 1 --max-errors 1", storeID),
+			API: mock.API{
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoresEntriesPaginator{
+						next: true,
+						keys: []string{"foo", "bar", "baz"},
+					}
+				},
+				DeleteKVStoreKeyFn: func(_ *fastly.DeleteKVStoreKeyInput) error {
+					return errors.New("whoops")
+				},
+			},
+			WantError: "failed to delete 1 of 3 keys: foo",
+			WantOutputs: []string{
+				"TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      0          1       2\n",
+				"The remaining keys were skipped once 1 keys failed to be deleted (see --max-errors).",
+			},
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --concurrency 1 --max-errors 0", storeID),
+			API: mock.API{
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoresEntriesPaginator{
+						next: true,
+						keys: []string{"foo", "bar", "baz"},
+					}
+				},
+				DeleteKVStoreKeyFn: func(i *fastly.DeleteKVStoreKeyInput) error {
+					if i.Key == "bar" {
+						return errors.New("whoops")
+					}
+					return nil
+				},
+			},
+			WantError: "failed to delete 1 of 3 keys: bar",
+			WantOutputs: []string{
+				"TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      1          1       1\n",
+				"The remaining keys were skipped once 1 keys failed to be deleted (see --max-errors).",
+			},
+		},
+		{
+			Name: "validate an interrupt stops the deletion",
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --concurrency 1", storeID),
+			API: mock.API{
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoresEntriesPaginator{
+						next: true,
+						keys: []string{"foo", "bar", "baz"},
+					}
+				},
+				DeleteKVStoreKeyFn: func(_ *fastly.DeleteKVStoreKeyInput) error {
+					p, err := os.FindProcess(os.Getpid())
+					if err != nil {
+						return err
+					}
+					if err := p.Signal(os.Interrupt); err != nil {
+						return err
+					}
+					time.Sleep(100 * time.Millisecond) // wait for the signal to be handled
+					return nil
+				},
+			},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				if runtime.GOOS == "windows" {
+					t.Skip("sending an interrupt isn't supported on Windows")
+				}
+			},
+			WantError:  "error deleting keys: context canceled",
+			WantOutput: "TOTAL  SUCCEEDED  FAILED  SKIPPED\n1      1          0       0\n",
+		},
+		{
+			Args:      fmt.Sprintf("--store-id %s --all --auto-yes --max-errors=-1", storeID),
+			WantError: "invalid --max-errors value: -1",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --concurrency 1 --on-error abort", storeID),
+			API: mock.API{
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoresEntriesPaginator{
+						next: true,
+						keys: []string{"foo", "bar", "baz"},
+					}
+				},
+				DeleteKVStoreKeyFn: func(i *fastly.DeleteKVStoreKeyInput) error {
+					if i.Key == "bar" {
+						return errors.New("whoops")
+					}
+					return nil
+				},
+			},
+			WantError:  "failed to delete 1 of 3 keys: bar",
+			WantOutput: "TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      1          1       1\n",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --timeout-per-item 20ms", storeID),
+			API: mock.API{
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoresEntriesPaginator{
+						next: true,
+						keys: []string{"foo", "bar", "baz"},
+					}
+				},
+				DeleteKVStoreKeyFn: func(i *fastly.DeleteKVStoreKeyInput) error {
+					if i.Key == "bar" {
+						time.Sleep(500 * time.Millisecond)
+					}
+					return nil
+				},
+			},
+			WantError:  "failed to delete 1 of 3 keys: bar",
+			WantOutput: "bar     operation timed out after 20ms",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --ignore-errors", storeID),
+			API: mock.API{
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoresEntriesPaginator{
+						next: true,
+						keys: []string{"foo", "bar", "baz"},
+					}
+				},
+				DeleteKVStoreKeyFn: func(i *fastly.DeleteKVStoreKeyInput) error {
+					if i.Key == "bar" {
+						return errors.New("whoops")
+					}
+					return nil
+				},
+			},
+			WantOutput: "TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      2          1       0\n\nFAILED  ERROR\nbar     whoops\n",
+		},
+		{
+			Args: fmt.Sprintf("--store-id %s --all --auto-yes --json", storeID),
+			API: mock.API{
+				NewListKVStoreKeysPaginatorFn: func(_ *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+					return &mockKVStoresEntriesPaginator{
+						next: true,
+						keys: []string{"foo", "bar"},
+					}
+				},
+				DeleteKVStoreKeyFn: func(_ *fastly.DeleteKVStoreKeyInput) error {
+					return nil
+				},
+			},
+			WantOutput:     fstfmt.JSON(`{"summary": {"total": 2, "succeeded": 2, "failed": 0, "skipped": 0}, "results": [{"id": "bar", "status": "succeeded"}, {"id": "foo", "status": "succeeded"}]}`),
+			DontWantOutput: "Deleting keys",
 		},
 	}
 
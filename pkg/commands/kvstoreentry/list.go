@@ -43,6 +43,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	// Optional.
 	c.CmdClause.Flag("consistency", "Determines accuracy of results. i.e. 'eventual' uses caching to improve performance").Default("strong").HintOptions(ConsistencyOptions...).EnumVar(&c.consistency, ConsistencyOptions...)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	return &c
 }
 
@@ -113,7 +114,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 	}
 
 	if keys == nil {
-		if ok, err := c.WriteJSON(out, []string{}); ok {
+		if ok, err := c.WriteOutput(out, []string{}); ok {
 			return err
 		}
 		text.Break(out)
@@ -121,7 +122,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return nil
 	}
 
-	if ok, err := c.WriteJSON(out, keys); ok {
+	if ok, err := c.WriteOutput(out, keys); ok {
 		return err
 	}
 
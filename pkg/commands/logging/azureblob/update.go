@@ -17,6 +17,8 @@ import (
 // UpdateCommand calls the Fastly API to update an Azure Blob Storage logging endpoint.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -97,6 +99,9 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 		Dst:         &c.ServiceName.Value,
 	})
 	common.TimestampFormat(c.CmdClause, &c.TimestampFormat)
+	c.RegisterFlagBool(c.JSONFlag())    // --json
+	c.RegisterFieldFlag(c.CmdClause)    // --field
+	c.RegisterChangesFlags(c.CmdClause) // --show-changes, --diff-context
 	return &c
 }
 
@@ -163,6 +168,10 @@ func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -191,6 +200,19 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetBlobStorage(&fastly.GetBlobStorageInput{
+			Name:           c.EndpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	azureblob, err := c.Globals.APIClient.UpdateBlobStorage(input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -200,11 +222,17 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	changes := argparser.Changes(before, azureblob)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
 	text.Success(out,
 		"Updated Azure Blob Storage logging endpoint %s (service %s version %d)",
 		fastly.ToValue(azureblob.Name),
 		fastly.ToValue(azureblob.ServiceID),
 		fastly.ToValue(azureblob.ServiceVersion),
 	)
+	c.DisplayChanges(out, changes)
 	return nil
 }
@@ -1,6 +1,7 @@
 package bigquery
 
 import (
+	"context"
 	"io"
 
 	"github.com/fastly/go-fastly/v9/fastly"
@@ -15,10 +16,12 @@ import (
 type DescribeCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.WatchOutput
 
 	Input          fastly.GetBigQueryInput
 	serviceName    argparser.OptionalServiceNameID
 	serviceVersion argparser.OptionalServiceVersion
+	names          []string
 }
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -31,7 +34,7 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 	c.CmdClause = parent.Command("describe", "Show detailed information about a BigQuery logging endpoint on a Fastly service version").Alias("get")
 
 	// Required.
-	c.CmdClause.Flag("name", "The name of the BigQuery logging object").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("name", "The name of the BigQuery logging object (repeat to describe multiple)").Short('n').Required().StringsVar(&c.names)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagVersionName,
 		Description: argparser.FlagVersionDesc,
@@ -41,6 +44,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -53,6 +58,7 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 		Description: argparser.FlagServiceNameDesc,
 		Dst:         &c.serviceName.Value,
 	})
+	c.RegisterWatchFlags(c.CmdClause) // --watch, --interval
 	return &c
 }
 
@@ -81,19 +87,43 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 	c.Input.ServiceID = serviceID
 	c.Input.ServiceVersion = fastly.ToValue(serviceVersion.Number)
 
-	o, err := c.Globals.APIClient.GetBigQuery(&c.Input)
-	if err != nil {
-		c.Globals.ErrLog.AddWithContext(err, map[string]any{
-			"Service ID":      serviceID,
-			"Service Version": fastly.ToValue(serviceVersion.Number),
-		})
-		return err
-	}
+	return c.Watch(context.Background(), out, &c.JSONOutput, func(out io.Writer) error {
+		if len(c.names) > 1 {
+			return argparser.DescribeMany(out, c.names, c.JSONOutput, func(name string) (*fastly.BigQuery, error) {
+				input := c.Input
+				input.Name = name
+				o, err := c.Globals.APIClient.GetBigQuery(&input)
+				if err != nil {
+					c.Globals.ErrLog.AddWithContext(err, map[string]any{
+						"Name":            name,
+						"Service ID":      serviceID,
+						"Service Version": fastly.ToValue(serviceVersion.Number),
+					})
+				}
+				return o, err
+			}, c.print)
+		}
+		c.Input.Name = c.names[0]
 
-	if ok, err := c.WriteJSON(out, o); ok {
-		return err
-	}
+		o, err := c.Globals.APIClient.GetBigQuery(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": fastly.ToValue(serviceVersion.Number),
+			})
+			return err
+		}
+
+		if ok, err := c.WriteOutput(out, o); ok {
+			return err
+		}
+
+		return c.print(out, o)
+	})
+}
 
+// print displays the information returned from the API.
+func (c *DescribeCommand) print(out io.Writer, o *fastly.BigQuery) error {
 	lines := text.Lines{
 		"Account name":       fastly.ToValue(o.AccountName),
 		"Dataset":            fastly.ToValue(o.Dataset),
@@ -17,6 +17,8 @@ import (
 // UpdateCommand calls the Fastly API to update a BigQuery logging endpoint.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -87,6 +89,9 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	c.CmdClause.Flag("table", "Your BigQuery table").Action(c.Table.Set).StringVar(&c.Table.Value)
 	c.CmdClause.Flag("template-suffix", "BigQuery table name suffix template").Action(c.Template.Set).StringVar(&c.Template.Value)
 	c.CmdClause.Flag("user", "Your Google Cloud Platform service account email address. The client_email field in your service account authentication JSON.").Action(c.User.Set).StringVar(&c.User.Value)
+	c.RegisterFlagBool(c.JSONFlag())    // --json
+	c.RegisterFieldFlag(c.CmdClause)    // --field
+	c.RegisterChangesFlags(c.CmdClause) // --show-changes, --diff-context
 	return &c
 }
 
@@ -140,6 +145,10 @@ func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -168,6 +177,19 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetBigQuery(&fastly.GetBigQueryInput{
+			Name:           c.EndpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	bq, err := c.Globals.APIClient.UpdateBigQuery(input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -177,11 +199,17 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	changes := argparser.Changes(before, bq)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
 	text.Success(out,
 		"Updated BigQuery logging endpoint %s (service %s version %d)",
 		fastly.ToValue(bq.Name),
 		fastly.ToValue(bq.ServiceID),
 		fastly.ToValue(bq.ServiceVersion),
 	)
+	c.DisplayChanges(out, changes)
 	return nil
 }
@@ -0,0 +1,307 @@
+package cloudfiles
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"4d63.com/optional"
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/logging/common"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CloneCommand creates a copy of a Cloudfiles logging endpoint under a new
+// name, optionally overriding some of its configuration.
+type CloneCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	autoClone      argparser.OptionalAutoClone
+	endpointName   string
+	newName        string
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+	targetVersion  argparser.OptionalServiceVersion
+
+	// Overrides.
+	AccessKey         argparser.OptionalString
+	BucketName        argparser.OptionalString
+	CompressionCodec  argparser.OptionalString
+	Format            argparser.OptionalString
+	FormatVersion     argparser.OptionalInt
+	GzipLevel         argparser.OptionalInt
+	MessageType       argparser.OptionalString
+	Path              argparser.OptionalString
+	Period            argparser.OptionalInt
+	Placement         argparser.OptionalString
+	PublicKey         argparser.OptionalString
+	Region            argparser.OptionalString
+	ResponseCondition argparser.OptionalString
+	TimestampFormat   argparser.OptionalString
+	User              argparser.OptionalString
+}
+
+// NewCloneCommand returns a usable command registered under the parent.
+func NewCloneCommand(parent argparser.Registerer, g *global.Data) *CloneCommand {
+	c := CloneCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("clone", "Copy a Cloudfiles logging endpoint to a new name, on the same or another Fastly service version (flags set directly override the copied configuration)")
+
+	// Required.
+	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object to clone").Short('n').Required().StringVar(&c.endpointName)
+	c.CmdClause.Flag("new-name", "The name of the new Cloudfiles logging object").Required().StringVar(&c.newName)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: "The service version of the Cloudfiles logging object to clone ('latest', 'active', or the number of a specific version)",
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.CmdClause.Flag("access-key", "Your Cloudfile account access key").Action(c.AccessKey.Set).StringVar(&c.AccessKey.Value)
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("bucket", "The name of your Cloudfiles container").Action(c.BucketName.Set).StringVar(&c.BucketName.Value)
+	common.CompressionCodec(c.CmdClause, &c.CompressionCodec)
+	common.Format(c.CmdClause, &c.Format)
+	common.FormatVersion(c.CmdClause, &c.FormatVersion)
+	common.GzipLevel(c.CmdClause, &c.GzipLevel)
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	common.MessageType(c.CmdClause, &c.MessageType)
+	common.Path(c.CmdClause, &c.Path)
+	common.Period(c.CmdClause, &c.Period)
+	common.Placement(c.CmdClause, &c.Placement)
+	common.PublicKey(c.CmdClause, &c.PublicKey)
+	common.RegionFlag(c.CmdClause, c.Globals, "cloudfiles", "The region to stream logs to", &c.Region)
+	common.ResponseCondition(c.CmdClause, &c.ResponseCondition)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("target-version", "The service version to create the new Cloudfiles logging object on (defaults to --version)").Action(c.targetVersion.Set).StringVar(&c.targetVersion.Value)
+	common.TimestampFormat(c.CmdClause, &c.TimestampFormat)
+	c.CmdClause.Flag("user", "The username for your Cloudfile account").Action(c.User.Set).StringVar(&c.User.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CloneCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+	if c.newName == c.endpointName && !c.targetVersion.WasSet {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the new name is the same as the name of the endpoint being cloned (%s)", c.endpointName),
+			Remediation: "Pass a different value for --new-name, or clone the endpoint to another service version with --target-version.",
+		}
+	}
+	if c.CompressionCodec.WasSet && c.GzipLevel.WasSet {
+		return fmt.Errorf("error parsing arguments: the --compression-codec flag is mutually exclusive with the --gzip-level flag")
+	}
+
+	// NOTE: Only the target version must be editable (or is cloned with
+	// --autoclone), as the source version is only read. The target version is
+	// resolved without being cloned, so that nothing is created if the source
+	// endpoint doesn't exist or the new name is already taken.
+	targetVersionFlag := c.serviceVersion
+	if c.targetVersion.WasSet {
+		targetVersionFlag = c.targetVersion
+	}
+	opts := argparser.ServiceDetailsOpts{
+		Active:             optional.Of(false),
+		Locked:             optional.Of(false),
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ResolveOnly:        true,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: targetVersionFlag,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	}
+	serviceID, serviceVersion, err := argparser.ServiceDetails(opts)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+	version := fastly.ToValue(serviceVersion.Number)
+
+	sourceVersion := version
+	if c.targetVersion.WasSet {
+		v, err := c.serviceVersion.Parse(serviceID, c.Globals.APIClient)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID": serviceID,
+			})
+			return err
+		}
+		sourceVersion = fastly.ToValue(v.Number)
+	}
+
+	source, err := c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+		Name:           c.endpointName,
+		ServiceID:      serviceID,
+		ServiceVersion: sourceVersion,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": sourceVersion,
+		})
+		return err
+	}
+
+	// The new name is looked up first so a collision is reported clearly,
+	// rather than as the API's generic error.
+	_, err = c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+		Name:           c.newName,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	switch {
+	case err == nil:
+		return common.RenameCollision("Cloudfiles", c.newName, version)
+	case !common.IsNotFound(err):
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return err
+	}
+
+	serviceVersion, err = argparser.EditableVersion(opts, serviceID, serviceVersion)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+	version = fastly.ToValue(serviceVersion.Number)
+
+	cloudfiles, err := c.Globals.APIClient.CreateCloudfiles(c.constructInput(source, serviceID, version))
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		if common.IsConflict(err) {
+			return common.RenameCollision("Cloudfiles", c.newName, version)
+		}
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, argparser.CreatedResource{
+		Type:           "Cloudfiles logging endpoint",
+		Name:           fastly.ToValue(cloudfiles.Name),
+		ServiceID:      fastly.ToValue(cloudfiles.ServiceID),
+		ServiceVersion: fastly.ToValue(cloudfiles.ServiceVersion),
+	}); ok {
+		return err
+	}
+
+	text.Success(out,
+		"Cloned Cloudfiles logging endpoint %s (version %d) to %s (service %s version %d)",
+		c.endpointName,
+		sourceVersion,
+		fastly.ToValue(cloudfiles.Name),
+		fastly.ToValue(cloudfiles.ServiceID),
+		fastly.ToValue(cloudfiles.ServiceVersion),
+	)
+	return nil
+}
+
+// constructInput returns the input that creates a copy of the source endpoint
+// with the new name, and the flags set directly applied over its
+// configuration.
+//
+// NOTE: The configuration is copied via the exported representation, so the
+// read-only fields (e.g. timestamps) are dropped.
+func (c *CloneCommand) constructInput(source *fastly.Cloudfiles, serviceID string, serviceVersion int) *fastly.CreateCloudfilesInput {
+	cfg := newConfig(source, true)
+
+	// NOTE: Setting one of the mutually exclusive compression flags replaces
+	// the copied value of the other.
+	if c.CompressionCodec.WasSet {
+		cfg.GzipLevel = nil
+	}
+	if c.GzipLevel.WasSet {
+		cfg.CompressionCodec = nil
+	}
+
+	for _, o := range []struct {
+		flag  argparser.OptionalString
+		value **string
+	}{
+		{c.AccessKey, &cfg.AccessKey},
+		{c.BucketName, &cfg.BucketName},
+		{c.CompressionCodec, &cfg.CompressionCodec},
+		{c.Format, &cfg.Format},
+		{c.MessageType, &cfg.MessageType},
+		{c.Path, &cfg.Path},
+		{c.Placement, &cfg.Placement},
+		{c.PublicKey, &cfg.PublicKey},
+		{c.Region, &cfg.Region},
+		{c.ResponseCondition, &cfg.ResponseCondition},
+		{c.TimestampFormat, &cfg.TimestampFormat},
+		{c.User, &cfg.User},
+	} {
+		if o.flag.WasSet {
+			*o.value = fastly.ToPointer(o.flag.Value)
+		}
+	}
+	for _, o := range []struct {
+		flag  argparser.OptionalInt
+		value **int
+	}{
+		{c.FormatVersion, &cfg.FormatVersion},
+		{c.GzipLevel, &cfg.GzipLevel},
+		{c.Period, &cfg.Period},
+	} {
+		if o.flag.WasSet {
+			*o.value = fastly.ToPointer(o.flag.Value)
+		}
+	}
+
+	return &fastly.CreateCloudfilesInput{
+		AccessKey:         cfg.AccessKey,
+		BucketName:        cfg.BucketName,
+		CompressionCodec:  cfg.CompressionCodec,
+		Format:            cfg.Format,
+		FormatVersion:     cfg.FormatVersion,
+		GzipLevel:         cfg.GzipLevel,
+		MessageType:       cfg.MessageType,
+		Name:              fastly.ToPointer(c.newName),
+		Path:              cfg.Path,
+		Period:            cfg.Period,
+		Placement:         cfg.Placement,
+		PublicKey:         cfg.PublicKey,
+		Region:            cfg.Region,
+		ResponseCondition: cfg.ResponseCondition,
+		ServiceID:         serviceID,
+		ServiceVersion:    serviceVersion,
+		TimestampFormat:   cfg.TimestampFormat,
+		User:              cfg.User,
+	}
+}
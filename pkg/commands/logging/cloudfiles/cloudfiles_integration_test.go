@@ -4,15 +4,25 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/fatih/color"
 
 	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/logging/cloudfiles"
+	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/threadsafe"
 )
 
 func TestCloudfilesCreate(t *testing.T) {
@@ -30,7 +40,16 @@ func TestCloudfilesCreate(t *testing.T) {
 				CloneVersionFn:     testutil.CloneVersionResult(4),
 				CreateCloudfilesFn: createCloudfilesOK,
 			},
-			wantOutput: "Created Cloudfiles logging endpoint log (service 123 version 4)",
+			wantOutput: "SUCCESS: Created Cloudfiles logging endpoint 'log' on version 4\n",
+		},
+		{
+			args: args("logging cloudfiles create --service-id 123 --version 1 --name log --user username --bucket log --access-key foo --autoclone --json --json-compact"),
+			api: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				CreateCloudfilesFn: createCloudfilesOK,
+			},
+			wantOutput: `{"type":"Cloudfiles logging endpoint","name":"log","service_id":"123","service_version":4}` + "\n",
 		},
 		{
 			args: args("logging cloudfiles create --service-id 123 --version 1 --name log --user username --bucket log --access-key foo --autoclone"),
@@ -80,66 +99,862 @@ func TestCloudfilesList(t *testing.T) {
 				ListVersionsFn:   testutil.ListVersions,
 				ListCloudfilesFn: listCloudfilesOK,
 			},
-			wantOutput: listCloudfilesShortOutput,
+			wantOutput: listCloudfilesShortOutput,
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1 --verbose"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantOutput: listCloudfilesVerboseOutput,
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1 -v"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantOutput: listCloudfilesVerboseOutput,
+		},
+		{
+			args: args("logging cloudfiles --verbose list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantOutput: listCloudfilesVerboseOutput,
+		},
+		{
+			args: args("logging -v cloudfiles list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantOutput: listCloudfilesVerboseOutput,
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1 --filter region=ORD,name~ana"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantOutput: "SERVICE  VERSION  NAME\n123      1        analytics\n",
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1 --filter gzip_level<9 --count-only"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantOutput: "0\n",
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1 --filter zone=DFW"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantError: "invalid --filter field 'zone'",
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1 --csv --field Name --field Period --field Format"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantOutput: "Format,Name,Period\n\"%h %l %u %t \"\"%r\"\" %>s %b\",logs,3600\n\"%h %l %u %t \"\"%r\"\" %>s %b\",analytics,86400\n",
+		},
+		{
+			args: args("logging cloudfiles list --service-id 123 --version 1 --csv --json"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListCloudfilesFn: listCloudfilesOK,
+			},
+			wantError: "--csv can't be combined with --json",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.api)
+				return opts, nil
+			}
+			err := app.Run(testcase.args, nil)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCloudfilesDescribe(t *testing.T) {
+	args := testutil.SplitArgs
+	scenarios := []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("logging cloudfiles describe --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("logging cloudfiles describe --service-id 123 --version 1 --name logs"),
+			api: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("logging cloudfiles describe --service-id 123 --version 1 --name logs"),
+			api: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			wantOutput: describeCloudfilesOutput,
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+				opts := testutil.MockGlobalData(testcase.args, &stdout)
+				opts.APIClientFactory = mock.APIClient(testcase.api)
+				return opts, nil
+			}
+			err := app.Run(testcase.args, nil)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCloudfilesOutputFile(t *testing.T) {
+	api := mock.API{
+		ListVersionsFn:  testutil.ListVersions,
+		GetCloudfilesFn: getCloudfilesOK,
+	}
+	path := filepath.Join(t.TempDir(), "logs.json")
+	scenarios := []testutil.CLIScenario{
+		{
+			Name:           "validate the JSON output is written to the file",
+			Args:           "describe --service-id 123 --version 1 --name logs --output " + path,
+			API:            api,
+			DontWantOutput: "AccessKey",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				fi, err := os.Stat(path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertEqual(t, os.FileMode(0o600), fi.Mode().Perm())
+				data, err := os.ReadFile(path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertStringContains(t, string(data), `"AccessKey":"1234"`)
+			},
+		},
+		{
+			Name: "validate an existing file is replaced and its permissions restricted",
+			Args: "describe --service-id 123 --version 1 --name logs --yaml --output " + path,
+			API:  api,
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				testutil.AssertNoError(t, os.WriteFile(path, []byte("previous content that is longer than the output\n"), 0o644))
+				testutil.AssertNoError(t, os.Chmod(path, 0o644))
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				fi, err := os.Stat(path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertEqual(t, os.FileMode(0o600), fi.Mode().Perm())
+				data, err := os.ReadFile(path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertStringContains(t, string(data), "BucketName: my-logs\n")
+				testutil.AssertStringDoesntContain(t, string(data), "previous content")
+			},
+		},
+		{
+			Name:      "validate --no-clobber refuses to replace the file",
+			Args:      "describe --service-id 123 --version 1 --name logs --no-clobber --output " + path,
+			WantError: "refusing to overwrite existing file",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles"}, scenarios)
+}
+
+func TestCloudfilesRedactOutput(t *testing.T) {
+	api := mock.API{
+		ListVersionsFn:   testutil.ListVersions,
+		GetCloudfilesFn:  getCloudfilesOK,
+		ListCloudfilesFn: listCloudfilesOK,
+	}
+	scenarios := []testutil.CLIScenario{
+		{
+			Name:       "validate sensitive fields are displayed by default",
+			Args:       "describe --service-id 123 --version 1 --name logs --json",
+			API:        api,
+			WantOutput: `"AccessKey": "1234"`,
+		},
+		{
+			Name:           "validate sensitive fields are redacted from the JSON output",
+			Args:           "describe --service-id 123 --version 1 --name logs --json --redact-output",
+			API:            api,
+			WantOutputs:    []string{`"AccessKey": "REDACTED"`, `"PublicKey": "-----BEGIN PGP PUBLIC KEY BLOCK-----`, `"User": "username"`},
+			DontWantOutput: `"1234"`,
+		},
+		{
+			Name:           "validate sensitive fields are redacted from the text output",
+			Args:           "describe --service-id 123 --version 1 --name logs --redact-output",
+			API:            api,
+			WantOutputs:    []string{"Access key: REDACTED", "Public key: -----BEGIN PGP PUBLIC KEY BLOCK-----"},
+			DontWantOutput: "1234",
+		},
+		{
+			Name:           "validate sensitive fields are redacted from the verbose list output",
+			Args:           "list --service-id 123 --version 1 --verbose --redact-output",
+			API:            api,
+			WantOutputs:    []string{"Access key: REDACTED", "Public key: -----BEGIN PGP PUBLIC KEY BLOCK-----"},
+			DontWantOutput: "1234",
+		},
+		{
+			Name:           "validate sensitive fields are redacted from the structured output of any command",
+			Args:           "export --service-id 123 --version 1 --name logs --include-secrets --redact-output",
+			API:            api,
+			WantOutputs:    []string{"access-key: REDACTED", "-----BEGIN PGP PUBLIC KEY BLOCK-----", "user: username"},
+			DontWantOutput: "1234",
+		},
+		{
+			Name:      "validate a command whose text output isn't redacted requires structured output",
+			Args:      "rename --service-id 123 --version 1 --name logs --new-name archive --redact-output",
+			WantError: "--redact-output is only supported by this command with structured output",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles"}, scenarios)
+}
+
+func TestCloudfilesDescribeFormat(t *testing.T) {
+	api := mock.API{
+		ListVersionsFn:  testutil.ListVersions,
+		GetCloudfilesFn: getCloudfilesOK,
+	}
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:       "--service-id 123 --version 1 --name logs --format json",
+			API:        api,
+			WantOutput: `"BucketName": "my-logs"`,
+		},
+		{
+			Args:       "--service-id 123 --version 1 --name logs --json",
+			API:        api,
+			WantOutput: `"BucketName": "my-logs"`,
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --format yaml",
+			API:  api,
+			WantOutputs: []string{
+				"BucketName: my-logs\n",
+				"GzipLevel: 9\n",
+				"Region: ORD\n",
+			},
+			DontWantOutput: "{",
+		},
+		{
+			Args:       "--service-id 123 --version 1 --name logs --format yaml --pointer /Region",
+			API:        api,
+			WantOutput: "ORD\n",
+		},
+		{
+			Args:       "--service-id 123 --version 1 --name logs --name other --format yaml",
+			API:        api,
+			WantOutput: "- AccessKey: \"1234\"\n",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --format logfmt",
+			API:  api,
+			WantOutputs: []string{
+				"AccessKey=1234 BucketName=my-logs ",
+				` Format="%h %l %u %t \"%r\" %>s %b" FormatVersion=2 GzipLevel=9 `,
+				` Region=ORD ResponseCondition="Prevent default logging" `,
+			},
+			DontWantOutput: "\nBucketName",
+		},
+		{
+			Args:       "--service-id 123 --version 1 --name logs --name other --format logfmt",
+			API:        api,
+			WantOutput: "Name=logs ",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, stdout *threadsafe.Buffer) {
+				testutil.AssertEqual(t, 2, strings.Count(stdout.String(), "AccessKey=1234 "))
+			},
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --format logfmt --mask-ids",
+			API:  api,
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				text.ResetIDs()
+			},
+			WantOutput:     " ServiceID=SERVICE_1 ServiceVersion=1 ",
+			DontWantOutput: "ServiceID=123",
+		},
+		{
+			Args:           "--service-id 123 --version 1 --name logs --yaml",
+			API:            api,
+			WantOutput:     "BucketName: my-logs\n",
+			DontWantOutput: "{",
+		},
+		{
+			Args:       "--service-id 123 --version 1 --name logs --field BucketName --field Region --json-compact",
+			API:        api,
+			WantOutput: `{"BucketName":"my-logs","Region":"ORD"}` + "\n",
+		},
+		{
+			Args:           "--service-id 123 --version 1 --name logs --field Region --yaml",
+			API:            api,
+			WantOutput:     "Region: ORD\n",
+			DontWantOutput: "BucketName",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --field Bucket",
+			API:       api,
+			WantError: `unknown field "Bucket"`,
+		},
+		{
+			Args:           "--service-id 123 --version 1 --name logs --json --no-yaml",
+			API:            api,
+			WantOutput:     `"BucketName": "my-logs"`,
+			DontWantOutput: "BucketName: my-logs",
+		},
+		{
+			Args:       "--service-id 123 --version 1 --name logs --no-yaml",
+			API:        api,
+			WantOutput: "Bucket: my-logs",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --yaml --json",
+			WantError: "--yaml can't be combined with --json",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --json --yaml",
+			WantError: "--yaml can't be combined with --json",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --format logfmt --yaml",
+			WantError: "--yaml is an alias for --format yaml and can't be combined with --format logfmt",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --yaml --verbose",
+			WantError: "invalid flag combination, --verbose and --json",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --format yaml --json",
+			WantError: "--json is an alias for --format json and can't be combined with --format yaml",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --format csv",
+			WantError: "enum value must be one of json,yaml,logfmt, got 'csv'",
+		},
+		{
+			Args:       "--service-id 123 --version 1 --name logs --template-file testdata/describe.tmpl",
+			API:        api,
+			WantOutput: "logs streams to my-logs (ORD)\n",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --template-file testdata/missingkey.tmpl",
+			API:       api,
+			WantError: `error executing template file: template: missingkey.tmpl:1:3: executing "missingkey.tmpl" at <.Bucket>: map has no entry for key "Bucket"`,
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --template-file testdata/invalid.tmpl",
+			WantError: "error parsing template file: template: invalid.tmpl:1: unexpected \"}\" in operand",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --template-file testdata/missing.tmpl",
+			WantError: "error reading template file: open testdata/missing.tmpl: no such file or directory",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --template-file testdata/describe.tmpl --format yaml",
+			WantError: "--template-file can't be combined with --format",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --template-file testdata/describe.tmpl --json",
+			WantError: "--template-file can't be combined with --json",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "describe"}, scenarios)
+}
+
+func TestCloudfilesValidateResponses(t *testing.T) {
+	api := mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		GetCloudfilesFn: func(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+			o, err := getCloudfilesOK(i)
+			o.BucketName = nil
+			o.FormatVersion = nil
+			return o, err
+		},
+	}
+	warning := "The API response for the cloudfiles logging endpoint 'logs' is missing expected fields (displayed as empty values): BucketName, FormatVersion."
+	scenarios := []testutil.CLIScenario{
+		{
+			Name:           "not validated by default",
+			Args:           "describe --service-id 123 --version 1 --name logs",
+			API:            api,
+			WantOutput:     "Bucket: \n",
+			DontWantOutput: "WARNING",
+		},
+		{
+			Args:       "describe --service-id 123 --version 1 --name logs --validate-responses",
+			API:        api,
+			WantOutput: warning,
+		},
+		{
+			Name:           "a complete response isn't warned about",
+			Args:           "describe --service-id 123 --version 1 --name logs --validate-responses",
+			API:            mock.API{ListVersionsFn: testutil.ListVersions, GetCloudfilesFn: getCloudfilesOK},
+			DontWantOutput: "WARNING",
+		},
+		{
+			Name:           "the warning is recorded but not displayed with --json",
+			Args:           "describe --service-id 123 --version 1 --name logs --validate-responses --json",
+			API:            api,
+			DontWantOutput: "WARNING",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, []string{warning}, text.Warnings.Messages())
+			},
+		},
+		{
+			Args: "list --service-id 123 --version 1 --validate-responses",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListCloudfilesFn: func(i *fastly.ListCloudfilesInput) ([]*fastly.Cloudfiles, error) {
+					cs, err := listCloudfilesOK(i)
+					cs[1].User = nil
+					return cs, err
+				},
+			},
+			WantOutput:     "The API response for the cloudfiles logging endpoint 'analytics' is missing expected fields (displayed as empty values): User.",
+			DontWantOutput: "'logs'",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles"}, scenarios)
+}
+
+func TestCloudfilesMigrateFormat(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:      "--service-id 123 --version 1",
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			WantError: "the Cloudfiles logging endpoint 'logs' uses format version 2, not 1",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesFormatV1(`%h %t "%r" %>s %b %{Referer}i`),
+			},
+			WantOutputs: []string{
+				`Suggested format (version 2): %{req.http.Fastly-Client-IP}V %t "%{req.method}V %{req.url}V %{req.proto}V" %{resp.status}V %{resp.body_bytes_written}V %{req.http.Referer}V`,
+			},
+			DontWantOutputs: []string{"WARNING", "SUCCESS"},
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesFormatV1(`%h %l %u %Z %{beresp.status}V`),
+			},
+			WantOutputs: []string{
+				"Suggested format (version 2): %{req.http.Fastly-Client-IP}V - %u %Z %{beresp.status}V",
+				"Review manually: %u (remote user) has no VCL equivalent",
+				"Review manually: %Z has no known version 2 equivalent",
+				"Review manually: %{beresp.status}V references beresp variables",
+			},
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --apply --autoclone",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: getCloudfilesFormatV1(`%u`),
+			},
+			WantError: "the format can't be migrated automatically",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --apply --autoclone",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: getCloudfilesFormatV1(`%h %>s`),
+				UpdateCloudfilesFn: func(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					if fastly.ToValue(i.Format) != "%{req.http.Fastly-Client-IP}V %{resp.status}V" || fastly.ToValue(i.FormatVersion) != 2 || i.ServiceVersion != 4 {
+						return nil, errors.New("unexpected update input")
+					}
+					return updateCloudfilesOK(i)
+				},
+			},
+			WantOutput: "Migrated Cloudfiles logging endpoint log to format version 2 (service 123 version 4)",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --json",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesFormatV1(`%s %u`),
+			},
+			WantOutputs: []string{
+				`"suggested": "%{resp.status}V %u"`,
+				`"applied": false`,
+			},
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "migrate-format"}, scenarios)
+}
+
+func TestCloudfilesRotateCredentials(t *testing.T) {
+	var stderr bytes.Buffer
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:      "--service-id 123 --version 1 --name logs",
+			WantError: "error parsing arguments: required flag --access-key not provided",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --access-key new-secret-key --autoclone",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: getCloudfilesError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --access-key new-secret-key --autoclone",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			Stdin: []string{"n"},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				originalStderr := color.Error
+				t.Cleanup(func() {
+					color.Error = originalStderr
+				})
+				color.Error = &stderr
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, stdout *threadsafe.Buffer) {
+				testutil.AssertStringContains(t, stderr.String(), "This will replace the credentials of the Cloudfiles logging endpoint 'logs' (service 123 version 4).")
+				testutil.AssertStringContains(t, stderr.String(), "Are you sure you want to continue?")
+				testutil.AssertStringDoesntContain(t, stdout.String(), "This will replace the credentials")
+			},
+			DontWantOutputs: []string{"SUCCESS", "new-secret-key"},
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --access-key new-secret-key --user new-user --autoclone --auto-yes",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: getCloudfilesOK,
+				UpdateCloudfilesFn: func(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					if fastly.ToValue(i.AccessKey) != "new-secret-key" || fastly.ToValue(i.User) != "new-user" || i.ServiceVersion != 4 {
+						return nil, errors.New("unexpected credentials")
+					}
+					if i.BucketName != nil || i.Format != nil || i.NewName != nil || i.Path != nil || i.Region != nil {
+						return nil, errors.New("unexpected non-credential field in update")
+					}
+					return updateCloudfilesOK(i)
+				},
+				ValidateVersionFn: func(i *fastly.ValidateVersionInput) (bool, string, error) {
+					if i.ServiceVersion != 4 {
+						return false, "", errors.New("unexpected service version validated")
+					}
+					return true, "", nil
+				},
+			},
+			WantOutputs: []string{
+				"Rotated the credentials of Cloudfiles logging endpoint log (service 123 version 4)",
+				"Service version 4 validated successfully (the new credentials aren't checked with Cloudfiles).",
+			},
+			DontWantOutputs: []string{"new-secret-key"},
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --access-key new-secret-key --autoclone --auto-yes",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    getCloudfilesOK,
+				UpdateCloudfilesFn: updateCloudfilesOK,
+				ValidateVersionFn: func(_ *fastly.ValidateVersionInput) (bool, string, error) {
+					return false, "authentication failed for logging endpoint", nil
+				},
+			},
+			WantError: "the credentials were updated but service version 4 is no longer valid: authentication failed for logging endpoint",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --access-key new-secret-key --autoclone --auto-yes --json",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    getCloudfilesOK,
+				UpdateCloudfilesFn: updateCloudfilesOK,
+				ValidateVersionFn: func(_ *fastly.ValidateVersionInput) (bool, string, error) {
+					return true, "", nil
+				},
+			},
+			WantOutputs: []string{
+				`"service_version": 4`,
+				`"user_updated": false`,
+				`"version_validated": true`,
+			},
+			DontWantOutputs: []string{"new-secret-key"},
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "rotate-credentials"}, scenarios)
+}
+
+func TestCloudfilesRename(t *testing.T) {
+	notFound := func(_ *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+		return nil, &fastly.HTTPError{StatusCode: http.StatusNotFound}
+	}
+	renameOK := func(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+		if i.Name != "logs" || fastly.ToValue(i.NewName) != "archive" || i.ServiceVersion != 4 {
+			return nil, errors.New("unexpected rename")
+		}
+		if i.AccessKey != nil || i.BucketName != nil || i.Format != nil || i.Path != nil || i.User != nil {
+			return nil, errors.New("unexpected non-name field in update")
+		}
+		o, err := updateCloudfilesOK(i)
+		o.Name = i.NewName
+		return o, err
+	}
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:      "--service-id 123 --version 1 --name logs",
+			WantError: "error parsing arguments: required flag --new-name not provided",
+		},
+		{
+			Args:      "--service-id 123 --version 1 --name logs --new-name logs",
+			WantError: "the new name is the same as the current name (logs)",
+		},
+		{
+			Name: "validate an existing endpoint with the new name is reported as a collision",
+			Args: "--service-id 123 --version 1 --name logs --new-name archive --autoclone",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			WantError: "a Cloudfiles logging endpoint named 'archive' already exists on service version 4",
+		},
+		{
+			Name: "validate a conflict returned by the API is reported as a collision",
+			Args: "--service-id 123 --version 1 --name logs --new-name archive --autoclone",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: notFound,
+				UpdateCloudfilesFn: func(_ *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					return nil, &fastly.HTTPError{StatusCode: http.StatusConflict}
+				},
+			},
+			WantError: "a Cloudfiles logging endpoint named 'archive' already exists on service version 4",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --new-name archive --autoclone",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    getCloudfilesError,
+				UpdateCloudfilesFn: renameOK,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --new-name archive --autoclone",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    notFound,
+				UpdateCloudfilesFn: renameOK,
+			},
+			WantOutput: "Renamed Cloudfiles logging endpoint logs to archive (service 123 version 4)",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs --new-name archive --autoclone --json",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    notFound,
+				UpdateCloudfilesFn: renameOK,
+			},
+			WantOutputs: []string{
+				`"new_name": "archive"`,
+				`"old_name": "logs"`,
+				`"service_id": "123"`,
+				`"service_version": 4`,
+			},
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "rename"}, scenarios)
+}
+
+func TestCloudfilesValidateBucket(t *testing.T) {
+	identityOK := func() *http.Response {
+		return mock.NewHTTPResponse(http.StatusOK, nil, io.NopCloser(strings.NewReader(rackspaceIdentityResponse)))
+	}
+	status := func(code int) *http.Response {
+		return mock.NewHTTPResponse(code, nil, nil)
+	}
+	client := func(res ...*http.Response) func(*testing.T, *testutil.CLIScenario, *global.Data) {
+		return func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+			opts.HTTPClient = mock.HTMLClient(res, make([]error, len(res)))
+		}
+	}
+
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:      "--service-id 123 --version 1",
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Name: "authentication failure",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			Setup:     client(status(http.StatusUnauthorized)),
+			WantError: "authentication failed for Cloudfiles user 'username': unexpected response: 401 Unauthorized",
 		},
 		{
-			args: args("logging cloudfiles list --service-id 123 --version 1 --verbose"),
-			api: mock.API{
-				ListVersionsFn:   testutil.ListVersions,
-				ListCloudfilesFn: listCloudfilesOK,
+			Name: "bucket not found",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
 			},
-			wantOutput: listCloudfilesVerboseOutput,
+			Setup:     client(identityOK(), status(http.StatusNotFound)),
+			WantError: "bucket 'my-logs' was not found in region 'ORD'",
 		},
 		{
-			args: args("logging cloudfiles list --service-id 123 --version 1 -v"),
-			api: mock.API{
-				ListVersionsFn:   testutil.ListVersions,
-				ListCloudfilesFn: listCloudfilesOK,
+			Name: "write permission denied",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
 			},
-			wantOutput: listCloudfilesVerboseOutput,
+			Setup:     client(identityOK(), status(http.StatusNoContent), status(http.StatusForbidden)),
+			WantError: "permission denied trying to write to bucket 'my-logs'",
 		},
 		{
-			args: args("logging cloudfiles --verbose list --service-id 123 --version 1"),
-			api: mock.API{
-				ListVersionsFn:   testutil.ListVersions,
-				ListCloudfilesFn: listCloudfilesOK,
+			Name: "region not in the service catalog",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetCloudfilesFn: func(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+					o, err := getCloudfilesOK(i)
+					o.Region = fastly.ToPointer("SYD")
+					return o, err
+				},
 			},
-			wantOutput: listCloudfilesVerboseOutput,
+			Setup:     client(identityOK()),
+			WantError: "no Cloud Files endpoint was found for region 'SYD'",
 		},
 		{
-			args: args("logging -v cloudfiles list --service-id 123 --version 1"),
-			api: mock.API{
-				ListVersionsFn:   testutil.ListVersions,
-				ListCloudfilesFn: listCloudfilesOK,
+			Name: "success",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
 			},
-			wantOutput: listCloudfilesVerboseOutput,
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.HTTPClient = &mock.HTTPClient{
+					Index:        -1,
+					Responses:    []*http.Response{identityOK(), status(http.StatusOK), status(http.StatusCreated), status(http.StatusNoContent)},
+					Errors:       make([]error, 4),
+					SaveRequests: true,
+				}
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, opts *global.Data, _ *threadsafe.Buffer) {
+				reqs := opts.HTTPClient.(*mock.HTTPClient).Requests
+				want := []string{
+					"POST https://identity.api.rackspacecloud.com/v2.0/tokens",
+					"GET https://storage101.ord1.clouddrive.com/v1/MossoCloudFS_1/my-logs?limit=1&format=json",
+					"PUT https://storage101.ord1.clouddrive.com/v1/MossoCloudFS_1/my-logs/logs/fastly-cli-validate-",
+					"DELETE https://storage101.ord1.clouddrive.com/v1/MossoCloudFS_1/my-logs/logs/fastly-cli-validate-",
+				}
+				if len(reqs) != len(want) {
+					t.Fatalf("want %d requests, got %d", len(want), len(reqs))
+				}
+				for i, r := range reqs {
+					testutil.AssertStringContains(t, r.Method+" "+r.URL.String(), want[i])
+				}
+				testutil.AssertEqual(t, "token-1", reqs[1].Header.Get("X-Auth-Token"))
+			},
+			WantOutput:     "The credentials of Cloudfiles logging endpoint logs can list and write to bucket 'my-logs' (service 123 version 1)",
+			DontWantOutput: "1234",
 		},
 		{
-			args: args("logging cloudfiles list --service-id 123 --version 1"),
-			api: mock.API{
-				ListVersionsFn:   testutil.ListVersions,
-				ListCloudfilesFn: listCloudfilesError,
+			Name: "path without a trailing slash",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetCloudfilesFn: func(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+					o, err := getCloudfilesOK(i)
+					o.Path = fastly.ToPointer("/logs")
+					return o, err
+				},
+			},
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.HTTPClient = &mock.HTTPClient{
+					Index:        -1,
+					Responses:    []*http.Response{identityOK(), status(http.StatusOK), status(http.StatusCreated), status(http.StatusNoContent)},
+					Errors:       make([]error, 4),
+					SaveRequests: true,
+				}
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, opts *global.Data, _ *threadsafe.Buffer) {
+				reqs := opts.HTTPClient.(*mock.HTTPClient).Requests
+				if len(reqs) != 4 {
+					t.Fatalf("want 4 requests, got %d", len(reqs))
+				}
+				testutil.AssertStringContains(t, reqs[2].URL.String(), "/my-logs/logs/fastly-cli-validate-")
 			},
-			wantError: errTest.Error(),
 		},
 	}
-	for testcaseIdx := range scenarios {
-		testcase := &scenarios[testcaseIdx]
-		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
-			var stdout bytes.Buffer
-			app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
-				opts := testutil.MockGlobalData(testcase.args, &stdout)
-				opts.APIClientFactory = mock.APIClient(testcase.api)
-				return opts, nil
-			}
-			err := app.Run(testcase.args, nil)
-			testutil.AssertErrorContains(t, err, testcase.wantError)
-			testutil.AssertString(t, testcase.wantOutput, stdout.String())
-		})
-	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "validate-bucket"}, scenarios)
 }
 
-func TestCloudfilesDescribe(t *testing.T) {
+func TestCloudfilesTest(t *testing.T) {
 	args := testutil.SplitArgs
 	scenarios := []struct {
 		args       []string
@@ -148,11 +963,11 @@ func TestCloudfilesDescribe(t *testing.T) {
 		wantOutput string
 	}{
 		{
-			args:      args("logging cloudfiles describe --service-id 123 --version 1"),
+			args:      args("logging cloudfiles test --service-id 123 --version 1"),
 			wantError: "error parsing arguments: required flag --name not provided",
 		},
 		{
-			args: args("logging cloudfiles describe --service-id 123 --version 1 --name logs"),
+			args: args("logging cloudfiles test --service-id 123 --version 1 --name logs"),
 			api: mock.API{
 				ListVersionsFn:  testutil.ListVersions,
 				GetCloudfilesFn: getCloudfilesError,
@@ -160,12 +975,12 @@ func TestCloudfilesDescribe(t *testing.T) {
 			wantError: errTest.Error(),
 		},
 		{
-			args: args("logging cloudfiles describe --service-id 123 --version 1 --name logs"),
+			args: args("logging cloudfiles test --service-id 123 --version 1 --name logs"),
 			api: mock.API{
 				ListVersionsFn:  testutil.ListVersions,
 				GetCloudfilesFn: getCloudfilesOK,
 			},
-			wantOutput: describeCloudfilesOutput,
+			wantError: "testing log delivery is not supported for this provider (Cloudfiles): the Fastly API has no test operation for the 'logs' endpoint",
 		},
 	}
 	for testcaseIdx := range scenarios {
@@ -231,6 +1046,535 @@ func TestCloudfilesUpdate(t *testing.T) {
 	}
 }
 
+func TestCloudfilesPartialUpdate(t *testing.T) {
+	var errLog *fsterr.LogEntries
+	scenarios := []testutil.CLIScenario{
+		{
+			Name: "validate fields the API didn't apply are reported",
+			Args: "--service-id 123 --version 1 --name logs --period 60 --path logs/ --autoclone",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				UpdateCloudfilesFn: updateCloudfilesOK,
+			},
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				errLog = new(fsterr.LogEntries)
+				opts.ErrLog = errLog
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, 1, len(*errLog))
+				testutil.AssertEqual(t, []string{"period"}, (*errLog)[0].Context["Rejected Fields"])
+			},
+			WantOutputs: []string{
+				"Partially updated Cloudfiles logging endpoint log",
+				"the API didn't apply the requested value of period.",
+			},
+			DontWantOutput: "SUCCESS",
+		},
+		{
+			Name: "validate the rejected fields are included in the JSON output",
+			Args: "--service-id 123 --version 1 --name logs --period 60 --autoclone --json",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    getCloudfilesOK,
+				UpdateCloudfilesFn: updateCloudfilesOK,
+			},
+			WantOutput:     "\"rejected\": [\n    \"period\"\n  ]",
+			DontWantOutput: "Partially updated",
+		},
+		{
+			Name: "validate a fully applied update isn't reported",
+			Args: "--service-id 123 --version 1 --name logs --period 3600 --autoclone --json",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    getCloudfilesOK,
+				UpdateCloudfilesFn: updateCloudfilesOK,
+			},
+			DontWantOutput: "rejected",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "update"}, scenarios)
+}
+
+func TestCloudfilesOnlyIfChanged(t *testing.T) {
+	var listed int
+	scenarios := []testutil.CLIScenario{
+		{
+			Name: "validate an update matching the existing values is skipped without cloning",
+			Args: "--service-id 123 --version 1 --name logs --period 3600 --path logs/ --autoclone --only-if-changed",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			WantOutput:     "No changes: Cloudfiles logging endpoint logs (service 123 version 1) already has the values provided",
+			DontWantOutput: "Updated",
+		},
+		{
+			Name: "validate a skipped update is reported as unchanged in the JSON output",
+			Args: "--service-id 123 --version 1 --name logs --period 3600 --autoclone --only-if-changed --json",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			WantOutput: "{\n  \"changed\": {}\n}",
+		},
+		{
+			Name: "validate an update that changes a value is applied",
+			Args: "--service-id 123 --version 1 --name logs --period 60 --autoclone --only-if-changed",
+			API: mock.API{
+				ListVersionsFn: func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+					if listed++; listed > 1 {
+						return nil, errors.New("the service versions were listed more than once")
+					}
+					return testutil.ListVersions(i)
+				},
+				CloneVersionFn:  testutil.CloneVersionResult(4),
+				GetCloudfilesFn: getCloudfilesOK,
+				UpdateCloudfilesFn: func(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					o, err := updateCloudfilesOK(i)
+					o.Period = i.Period
+					return o, err
+				},
+			},
+			WantOutput:     "Updated Cloudfiles logging endpoint log (service 123 version 4)",
+			DontWantOutput: "No changes",
+		},
+		{
+			Name: "validate an endpoint that can't be fetched isn't updated",
+			Args: "--service-id 123 --version 1 --name logs --period 60 --autoclone --only-if-changed",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "update"}, scenarios)
+}
+
+func TestCloudfilesExport(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      "--service-id 123 --version 1",
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetCloudfiles API error",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Name: "validate the access key is omitted by default",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			WantOutputs:     []string{"bucket: my-logs\n", "gzip-level: 9\n", "name: logs\n", "period: 3600\n", "user: username\n"},
+			DontWantOutputs: []string{"access-key", "ServiceID", "service"},
+		},
+		{
+			Name: "validate the access key is included with --include-secrets",
+			Args: "--service-id 123 --version 1 --name logs --include-secrets --output-format json",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			WantOutputs: []string{`"access-key": "1234"`, `"period": 3600`},
+		},
+		{
+			Name:      "validate --format isn't the document format",
+			Args:      "--service-id 123 --version 1 --name logs --format json",
+			WantError: "unknown long flag '--format'",
+		},
+		{
+			Name: "validate the gzip level is omitted when a compression codec is used",
+			Args: "--service-id 123 --version 1 --name logs",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetCloudfilesFn: func(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+					o, err := getCloudfilesOK(i)
+					o.CompressionCodec = fastly.ToPointer("zstd")
+					o.GzipLevel = fastly.ToPointer(0)
+					return o, err
+				},
+			},
+			WantOutput:     "compression-codec: zstd\n",
+			DontWantOutput: "gzip-level",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "export"}, scenarios)
+}
+
+// TestCloudfilesExportRoundTrip validates that an exported endpoint is
+// recreated faithfully by `create --from-file` on a fresh version.
+func TestCloudfilesExportRoundTrip(t *testing.T) {
+	for _, format := range []string{"json", "yaml"} {
+		t.Run(format, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "cloudfiles."+format)
+			original, _ := getCloudfilesOK(&fastly.GetCloudfilesInput{ServiceID: "123", ServiceVersion: 1})
+
+			export := []testutil.CLIScenario{
+				{
+					Name: "export",
+					Args: "--service-id 123 --version 1 --name logs --include-secrets --output-format " + format,
+					API: mock.API{
+						ListVersionsFn:  testutil.ListVersions,
+						GetCloudfilesFn: getCloudfilesOK,
+					},
+					Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, stdout *threadsafe.Buffer) {
+						if err := os.WriteFile(path, []byte(stdout.String()), 0o600); err != nil {
+							t.Fatal(err)
+						}
+					},
+				},
+			}
+			testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "export"}, export)
+
+			var input *fastly.CreateCloudfilesInput
+			create := []testutil.CLIScenario{
+				{
+					Name: "create",
+					Args: "--service-id 123 --version 3 --from-file " + path,
+					API: mock.API{
+						ListVersionsFn: testutil.ListVersions,
+						CreateCloudfilesFn: func(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+							input = i
+							return createCloudfilesOK(i)
+						},
+					},
+					WantOutput: "Created Cloudfiles logging endpoint 'logs' on version 3",
+				},
+			}
+			testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "create"}, create)
+
+			if input == nil {
+				t.Fatal("expected the endpoint to be created")
+			}
+			testutil.AssertEqual(t, 0, len(argparser.PendingChanges(input, original)))
+			testutil.AssertEqual(t, fastly.ToValue(original.AccessKey), fastly.ToValue(input.AccessKey))
+			testutil.AssertEqual(t, fastly.ToValue(original.GzipLevel), fastly.ToValue(input.GzipLevel))
+			testutil.AssertEqual(t, fastly.ToValue(original.PublicKey), fastly.ToValue(input.PublicKey))
+		})
+	}
+}
+
+func TestCloudfilesClone(t *testing.T) {
+	var created *fastly.CreateCloudfilesInput
+	getSource := func(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+		if i.Name != "logs" {
+			return nil, &fastly.HTTPError{StatusCode: http.StatusNotFound}
+		}
+		return getCloudfilesOK(i)
+	}
+	createOK := func(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+		created = i
+		return createCloudfilesOK(i)
+	}
+	reset := func(_ *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+		created = nil
+	}
+
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:      "--service-id 123 --version 1 --name logs",
+			WantError: "error parsing arguments: required flag --new-name not provided",
+		},
+		{
+			Args:      "--service-id 123 --version 3 --name logs --new-name logs",
+			WantError: "the new name is the same as the name of the endpoint being cloned (logs)",
+		},
+		{
+			Args:      "--service-id 123 --version 3 --name logs --new-name copy --compression-codec zstd --gzip-level 9",
+			WantError: "error parsing arguments: the --compression-codec flag is mutually exclusive with the --gzip-level flag",
+		},
+		{
+			Name: "validate the configuration is copied to the new name",
+			Args: "--service-id 123 --version 3 --name logs --new-name copy",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				GetCloudfilesFn:    getSource,
+				CreateCloudfilesFn: createOK,
+			},
+			Setup:      reset,
+			WantOutput: "Cloned Cloudfiles logging endpoint logs (version 3) to copy (service 123 version 3)",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, &fastly.CreateCloudfilesInput{
+					AccessKey:         fastly.ToPointer("1234"),
+					BucketName:        fastly.ToPointer("my-logs"),
+					Format:            fastly.ToPointer(`%h %l %u %t "%r" %>s %b`),
+					FormatVersion:     fastly.ToPointer(2),
+					GzipLevel:         fastly.ToPointer(9),
+					MessageType:       fastly.ToPointer("classic"),
+					Name:              fastly.ToPointer("copy"),
+					Path:              fastly.ToPointer("logs/"),
+					Period:            fastly.ToPointer(3600),
+					Placement:         fastly.ToPointer("none"),
+					PublicKey:         fastly.ToPointer(pgpPublicKey()),
+					Region:            fastly.ToPointer("ORD"),
+					ResponseCondition: fastly.ToPointer("Prevent default logging"),
+					ServiceID:         "123",
+					ServiceVersion:    3,
+					TimestampFormat:   fastly.ToPointer("%Y-%m-%dT%H:%M:%S.000"),
+					User:              fastly.ToPointer("username"),
+				}, created)
+			},
+		},
+		{
+			Name: "validate flags set directly override the copied configuration",
+			Args: "--service-id 123 --version 3 --name logs --new-name copy --bucket other --period 60 --compression-codec zstd",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				GetCloudfilesFn:    getSource,
+				CreateCloudfilesFn: createOK,
+			},
+			Setup: reset,
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertString(t, "other", fastly.ToValue(created.BucketName))
+				testutil.AssertEqual(t, 60, fastly.ToValue(created.Period))
+				testutil.AssertString(t, "zstd", fastly.ToValue(created.CompressionCodec))
+				testutil.AssertEqual(t, (*int)(nil), created.GzipLevel)
+				testutil.AssertString(t, "username", fastly.ToValue(created.User))
+			},
+		},
+		{
+			Name: "validate the endpoint is cloned to --target-version",
+			Args: "--service-id 123 --version 1 --name logs --new-name logs --target-version 3 --json",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetCloudfilesFn: func(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+					if i.ServiceVersion != 1 {
+						return nil, &fastly.HTTPError{StatusCode: http.StatusNotFound}
+					}
+					return getCloudfilesOK(i)
+				},
+				CreateCloudfilesFn: createOK,
+			},
+			Setup: reset,
+			WantOutputs: []string{
+				`"name": "logs"`,
+				`"service_id": "123"`,
+				`"service_version": 3`,
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, 3, created.ServiceVersion)
+			},
+		},
+		{
+			Name: "validate the target version must be editable",
+			Args: "--service-id 123 --version 3 --name logs --new-name copy --target-version 1",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getSource,
+				CreateCloudfilesFn: func(_ *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					t.Fatal("unexpected API call")
+					return nil, nil
+				},
+			},
+			WantError: "service version 1 is active",
+		},
+		{
+			Name: "validate --autoclone clones the target version",
+			Args: "--service-id 123 --version 1 --name logs --new-name copy --autoclone",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CloneVersionFn:     testutil.CloneVersionResult(4),
+				GetCloudfilesFn:    getSource,
+				CreateCloudfilesFn: createOK,
+			},
+			Setup:      reset,
+			WantOutput: "Cloned Cloudfiles logging endpoint logs (version 1) to copy (service 123 version 4)",
+		},
+		{
+			Name: "validate --autoclone doesn't clone the target version if the source endpoint can't be read",
+			Args: "--service-id 123 --version 1 --name logs --new-name copy --autoclone",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: func(_ *fastly.CloneVersionInput) (*fastly.Version, error) {
+					t.Fatal("unexpected API call")
+					return nil, nil
+				},
+				GetCloudfilesFn: getCloudfilesError,
+			},
+			WantError: errTest.Error(),
+		},
+		{
+			Name: "validate --autoclone doesn't clone the target version if the new name is taken",
+			Args: "--service-id 123 --version 1 --name logs --new-name copy --autoclone",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: func(_ *fastly.CloneVersionInput) (*fastly.Version, error) {
+					t.Fatal("unexpected API call")
+					return nil, nil
+				},
+				GetCloudfilesFn: getCloudfilesOK,
+			},
+			WantError: "a Cloudfiles logging endpoint named 'copy' already exists on service version 1",
+		},
+		{
+			Name: "validate an existing endpoint with the new name is reported as a collision",
+			Args: "--service-id 123 --version 3 --name logs --new-name copy",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesOK,
+				CreateCloudfilesFn: func(_ *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					t.Fatal("unexpected API call")
+					return nil, nil
+				},
+			},
+			WantError: "a Cloudfiles logging endpoint named 'copy' already exists on service version 3",
+		},
+		{
+			Name: "validate a conflict returned by the API is reported as a collision",
+			Args: "--service-id 123 --version 3 --name logs --new-name copy",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getSource,
+				CreateCloudfilesFn: func(_ *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					return nil, &fastly.HTTPError{StatusCode: http.StatusConflict}
+				},
+			},
+			WantError: "a Cloudfiles logging endpoint named 'copy' already exists on service version 3",
+		},
+		{
+			Args: "--service-id 123 --version 3 --name logs --new-name copy",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				GetCloudfilesFn: getCloudfilesError,
+			},
+			WantError: errTest.Error(),
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "clone"}, scenarios)
+}
+
+func TestCloudfilesCreateFromList(t *testing.T) {
+	var created []*fastly.CreateCloudfilesInput
+	createExceptAnalytics := func(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+		if fastly.ToValue(i.Name) == "analytics" {
+			return nil, errTest
+		}
+		created = append(created, i)
+		return createCloudfilesOK(i)
+	}
+	reset := func(_ *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+		created = nil
+	}
+	createdNames := func() []string {
+		names := make([]string, 0, len(created))
+		for _, i := range created {
+			names = append(names, fastly.ToValue(i.Name))
+		}
+		return names
+	}
+
+	scenarios := []testutil.CLIScenario{
+		{
+			Name: "validate an endpoint is created for each item",
+			Args: "--service-id 123 --version 3 --region ORD --from-file testdata/create-list.yaml",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreateCloudfilesFn: func(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					created = append(created, i)
+					return createCloudfilesOK(i)
+				},
+			},
+			Setup:       reset,
+			WantOutputs: []string{"Created 3 Cloudfiles logging endpoints (service 123 version 3)", "TOTAL  SUCCEEDED  FAILED  SKIPPED\n3      3          0       0"},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, []string{"logs", "analytics", "audit"}, createdNames())
+				for _, i := range created {
+					testutil.AssertString(t, "123", i.ServiceID)
+					testutil.AssertEqual(t, 3, i.ServiceVersion)
+					testutil.AssertString(t, "ORD", fastly.ToValue(i.Region))
+				}
+				testutil.AssertEqual(t, 60, fastly.ToValue(created[1].Period))
+			},
+		},
+		{
+			Name: "validate the remaining items are skipped once an item fails",
+			Args: "--service-id 123 --version 3 --from-file testdata/create-list.yaml",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CreateCloudfilesFn: createExceptAnalytics,
+			},
+			Setup:      reset,
+			WantError:  "failed to create 1 of 3 Cloudfiles logging endpoints: analytics",
+			WantOutput: "3      1          1       1",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, []string{"logs"}, createdNames())
+			},
+		},
+		{
+			Name: "validate --on-error continue creates the remaining items",
+			Args: "--service-id 123 --version 3 --from-file testdata/create-list.yaml --on-error continue",
+			API: mock.API{
+				ListVersionsFn:     testutil.ListVersions,
+				CreateCloudfilesFn: createExceptAnalytics,
+			},
+			Setup:      reset,
+			WantError:  "failed to create 1 of 3 Cloudfiles logging endpoints: analytics",
+			WantOutput: "3      2          1       0",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, []string{"logs", "audit"}, createdNames())
+			},
+		},
+		{
+			Name: "validate every item is validated before any endpoint is created",
+			Args: "--service-id 123 --version 3 --from-file testdata/create-list-invalid.json",
+			API: mock.API{
+				CreateCloudfilesFn: func(_ *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					t.Fatal("unexpected API call")
+					return nil, nil
+				},
+			},
+			WantError: "item 2 of the --from-file file doesn't have a name",
+		},
+		{
+			Name: "validate items can use the API's field names",
+			Args: "--service-id 123 --version 3 --from-file testdata/create-list-api.json",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreateCloudfilesFn: func(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+					created = append(created, i)
+					return createCloudfilesOK(i)
+				},
+			},
+			Setup:      reset,
+			WantOutput: "Created 2 Cloudfiles logging endpoints (service 123 version 3)",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				testutil.AssertEqual(t, []string{"logs", "audit"}, createdNames())
+				testutil.AssertString(t, "my-logs", fastly.ToValue(created[0].BucketName))
+				testutil.AssertString(t, "key", fastly.ToValue(created[0].AccessKey))
+				testutil.AssertEqual(t, 1, fastly.ToValue(created[1].GzipLevel))
+			},
+		},
+		{
+			Name:      "validate an item can't set a field by both its names",
+			Args:      "--service-id 123 --version 3 --from-file testdata/create-list-alias-conflict.json",
+			WantError: "error in item 1 of the --from-file file: fields 'bucket' and 'bucket_name' in --from-file file 'testdata/create-list-alias-conflict.json' both set --bucket",
+		},
+		{
+			Name:      "validate a flag can't be set both directly and by an item",
+			Args:      "--service-id 123 --version 3 --bucket other --from-file testdata/create-list.yaml",
+			WantError: "error in item 1 of the --from-file file: flag --bucket was provided both directly and via --from-file",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", "create"}, scenarios)
+}
+
 func TestCloudfilesDelete(t *testing.T) {
 	args := testutil.SplitArgs
 	scenarios := []struct {
@@ -280,6 +1624,19 @@ func TestCloudfilesDelete(t *testing.T) {
 
 var errTest = errors.New("fixture error")
 
+var rackspaceIdentityResponse = `{
+  "access": {
+    "token": {"id": "token-1"},
+    "serviceCatalog": [
+      {"name": "cloudServersOpenStack", "endpoints": [{"region": "ORD", "publicURL": "https://ord.servers.api.rackspacecloud.com/v2/1"}]},
+      {"name": "cloudFiles", "endpoints": [
+        {"region": "DFW", "publicURL": "https://storage101.dfw1.clouddrive.com/v1/MossoCloudFS_1"},
+        {"region": "ORD", "publicURL": "https://storage101.ord1.clouddrive.com/v1/MossoCloudFS_1"}
+      ]}
+    ]
+  }
+}`
+
 func createCloudfilesOK(i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
 	s := fastly.Cloudfiles{
 		ServiceID:      fastly.ToPointer(i.ServiceID),
@@ -417,12 +1774,20 @@ func getCloudfilesOK(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
 	}, nil
 }
 
+func getCloudfilesFormatV1(format string) func(*fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+	return func(i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+		o, err := getCloudfilesOK(i)
+		o.Format = fastly.ToPointer(format)
+		o.FormatVersion = fastly.ToPointer(1)
+		return o, err
+	}
+}
+
 func getCloudfilesError(_ *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
 	return nil, errTest
 }
 
 var describeCloudfilesOutput = "\n" + strings.TrimSpace(`
-Access key: 1234
 Bucket: my-logs
 Format: %h %l %u %t "%r" %>s %b
 Format version: 2
@@ -432,13 +1797,16 @@ Name: logs
 Path: logs/
 Period: 3600
 Placement: none
-Public key: `+pgpPublicKey()+`
 Region: ORD
 Response condition: Prevent default logging
 Service ID: 123
 Timestamp format: %Y-%m-%dT%H:%M:%S.000
-User: username
 Version: 1
+
+Authentication:
+  Access key: 1234
+  User: username
+  Public key: `+pgpPublicKey()+`
 `) + "\n"
 
 func updateCloudfilesOK(i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
@@ -508,3 +1876,47 @@ wMfrTEOvx0NxUM3rpaCgEmuWbB1G1Hu371oyr4srrr+N
 -----END PGP PUBLIC KEY BLOCK-----
 `)
 }
+
+// TestCloudfilesJSONShape snapshots the --json output of the describe and list
+// commands, so renaming or removing a field (which would break scripts
+// consuming the output) fails the test. See cloudfiles.Output.
+func TestCloudfilesJSONShape(t *testing.T) {
+	api := mock.API{
+		ListVersionsFn:   testutil.ListVersions,
+		GetCloudfilesFn:  getCloudfilesOK,
+		ListCloudfilesFn: listCloudfilesOK,
+	}
+	for _, testcase := range []struct {
+		command  string
+		args     string
+		snapshot string
+	}{
+		{command: "describe", args: "--service-id 123 --version 1 --name logs --json", snapshot: "describe.json"},
+		{command: "list", args: "--service-id 123 --version 1 --json", snapshot: "list.json"},
+	} {
+		want, err := os.ReadFile(filepath.Join("testdata", testcase.snapshot))
+		testutil.AssertNoError(t, err)
+		testutil.RunCLIScenarios(t, []string{"logging", "cloudfiles", testcase.command}, []testutil.CLIScenario{
+			{
+				Args: testcase.args,
+				API:  api,
+				Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, stdout *threadsafe.Buffer) {
+					testutil.AssertString(t, string(want), stdout.String())
+				},
+			},
+		})
+	}
+}
+
+// TestCloudfilesOutputFields validates the Output type has a field for every
+// field of the API client's type, so a new API field isn't silently dropped.
+func TestCloudfilesOutputFields(t *testing.T) {
+	output := reflect.TypeOf(cloudfiles.Output{})
+	api := reflect.TypeOf(fastly.Cloudfiles{})
+	for i := 0; i < api.NumField(); i++ {
+		name := api.Field(i).Name
+		if _, ok := output.FieldByName(name); !ok {
+			t.Errorf("cloudfiles.Output has no %s field (add it with a stable JSON tag)", name)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package cloudfiles_test
 
 import (
 	"bytes"
+	"path/filepath"
 	"testing"
 
 	"github.com/fastly/go-fastly/v9/fastly"
@@ -149,6 +150,44 @@ func TestUpdateCloudfilesInput(t *testing.T) {
 				CompressionCodec:  fastly.ToPointer("new13"),
 			},
 		},
+		{
+			name: "partial patch from file",
+			cmd:  updateCommandFromFile("patch.yaml"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+			},
+			want: &fastly.UpdateCloudfilesInput{
+				ServiceID:      "123",
+				ServiceVersion: 4,
+				Name:           "log",
+				Period:         fastly.ToPointer(60),
+				GzipLevel:      fastly.ToPointer(0),
+				User:           fastly.ToPointer("alice"),
+			},
+		},
+		{
+			name: "error patch from file with invalid type",
+			cmd:  updateCommandFromFile("patch-invalid.json"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+			},
+			wantError: "field 'period' in --from-file file 'testdata/patch-invalid.json' must be an integer",
+		},
+		{
+			name: "error patch from file with flag also set",
+			cmd: func() *cloudfiles.UpdateCommand {
+				c := updateCommandFromFile("patch.yaml")
+				c.User = argparser.OptionalString{Optional: argparser.Optional{WasSet: true}, Value: "bob"}
+				return c
+			}(),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+			},
+			wantError: "flag --user was provided both directly and via --from-file",
+		},
 		{
 			name:      "error missing serviceID",
 			cmd:       updateCommandMissingServiceID(),
@@ -181,9 +220,7 @@ func TestUpdateCloudfilesInput(t *testing.T) {
 			case err != nil && testcase.wantError != "":
 				testutil.AssertErrorContains(t, err, testcase.wantError)
 				return
-			case err == nil && testcase.wantError != "":
-				t.Fatalf("expected error, have nil (service details: %s, %d)", serviceID, serviceVersion.Number)
-			case err == nil && testcase.wantError == "":
+			default:
 				have, err := testcase.cmd.ConstructInput(serviceID, fastly.ToValue(serviceVersion.Number))
 				testutil.AssertErrorContains(t, err, testcase.wantError)
 				testutil.AssertEqual(t, testcase.want, have)
@@ -322,6 +359,12 @@ func updateCommandNoUpdate() *cloudfiles.UpdateCommand {
 	}
 }
 
+func updateCommandFromFile(filename string) *cloudfiles.UpdateCommand {
+	c := updateCommandNoUpdate()
+	c.FromFile = argparser.OptionalString{Optional: argparser.Optional{WasSet: true}, Value: filepath.Join("testdata", filename)}
+	return c
+}
+
 func updateCommandAll() *cloudfiles.UpdateCommand {
 	var b bytes.Buffer
 
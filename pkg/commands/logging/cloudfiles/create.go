@@ -1,6 +1,7 @@
 package cloudfiles
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -18,6 +19,7 @@ import (
 // CreateCommand calls the Fastly API to create a Cloudfiles logging endpoint.
 type CreateCommand struct {
 	argparser.Base
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -30,7 +32,10 @@ type CreateCommand struct {
 	BucketName        argparser.OptionalString
 	CompressionCodec  argparser.OptionalString
 	EndpointName      argparser.OptionalString // Can't shadow argparser.Base method Name().
+	FieldFromFile     argparser.OptionalString
 	Format            argparser.OptionalString
+	FromFile          argparser.OptionalString
+	InputFormat       argparser.OptionalString
 	FormatVersion     argparser.OptionalInt
 	GzipLevel         argparser.OptionalInt
 	MessageType       argparser.OptionalString
@@ -43,6 +48,8 @@ type CreateCommand struct {
 	TimestampFormat   argparser.OptionalString
 	Token             argparser.OptionalString
 	User              argparser.OptionalString
+
+	onError string
 }
 
 // NewCreateCommand returns a usable command registered under the parent.
@@ -70,16 +77,21 @@ func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateComman
 	})
 	c.CmdClause.Flag("bucket", "The name of your Cloudfiles container").Action(c.BucketName.Set).StringVar(&c.BucketName.Value)
 	common.CompressionCodec(c.CmdClause, &c.CompressionCodec)
+	common.FieldFromFile(c.CmdClause, &c.FieldFromFile)
 	common.Format(c.CmdClause, &c.Format)
+	c.CmdClause.Flag(argparser.FlagFromFileName, "Path to a JSON or YAML file of flag names and values, e.g. written by `fastly logging cloudfiles export`. A list of them creates an endpoint per item, and accepts the API's field names (e.g. bucket_name). Flags set directly apply to every item, so an item can't also set them").Action(c.FromFile.Set).StringVar(&c.FromFile.Value)
+	common.InputFormat(c.CmdClause, &c.InputFormat)
 	common.FormatVersion(c.CmdClause, &c.FormatVersion)
 	common.GzipLevel(c.CmdClause, &c.GzipLevel)
+	c.RegisterFlagBool(c.JSONFlag()) // --json
 	common.MessageType(c.CmdClause, &c.MessageType)
 	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object. Used as a primary key for API access").Short('n').Action(c.EndpointName.Set).StringVar(&c.EndpointName.Value)
+	c.CmdClause.Flag(argparser.FlagOnErrorName, argparser.FlagOnErrorDesc+" (only used when the --from-file file is a list)").Default(argparser.OnErrorAbort).HintOptions(argparser.OnErrorBehaviours...).EnumVar(&c.onError, argparser.OnErrorBehaviours...)
 	common.Path(c.CmdClause, &c.Path)
 	common.Period(c.CmdClause, &c.Period)
 	common.Placement(c.CmdClause, &c.Placement)
 	common.PublicKey(c.CmdClause, &c.PublicKey)
-	c.CmdClause.Flag("region", "The region to stream logs to. One of: DFW-Dallas, ORD-Chicago, IAD-Northern Virginia, LON-London, SYD-Sydney, HKG-Hong Kong").Action(c.Region.Set).StringVar(&c.Region.Value)
+	common.RegionFlag(c.CmdClause, c.Globals, "cloudfiles", "The region to stream logs to", &c.Region)
 	common.ResponseCondition(c.CmdClause, &c.ResponseCondition)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
@@ -98,8 +110,51 @@ func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateComman
 	return &c
 }
 
+// patchFields returns the fields of a --from-file file, i.e. the flag names
+// and the values they populate, and the API's names for the fields whose flag
+// is named differently.
+func (c *CreateCommand) patchFields() map[string]any {
+	return map[string]any{
+		"access-key":         &c.AccessKey,
+		"bucket":             &c.BucketName,
+		"bucket-name":        argparser.PatchAlias("bucket"),
+		"compression-codec":  &c.CompressionCodec,
+		"format":             &c.Format,
+		"format-version":     &c.FormatVersion,
+		"gzip-level":         &c.GzipLevel,
+		"message-type":       &c.MessageType,
+		"name":               &c.EndpointName,
+		"path":               &c.Path,
+		"period":             &c.Period,
+		"placement":          &c.Placement,
+		"public-key":         &c.PublicKey,
+		"region":             &c.Region,
+		"response-condition": &c.ResponseCondition,
+		"timestamp-format":   &c.TimestampFormat,
+		"user":               &c.User,
+	}
+}
+
 // ConstructInput transforms values parsed from CLI flags into an object to be used by the API client library.
 func (c *CreateCommand) ConstructInput(serviceID string, serviceVersion int) (*fastly.CreateCloudfilesInput, error) {
+	if c.FromFile.WasSet {
+		err := argparser.PatchFromFile(c.FromFile.Value, c.InputFormat.Value, c.patchFields())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.FieldFromFile.WasSet {
+		err := argparser.FieldsFromFile(c.FieldFromFile.Value, c.InputFormat.Value, map[string]*argparser.OptionalString{
+			"access-key": &c.AccessKey,
+			"public-key": &c.PublicKey,
+			"user":       &c.User,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var input fastly.CreateCloudfilesInput
 
 	input.ServiceID = serviceID
@@ -165,6 +220,27 @@ func (c *CreateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	// NOTE: A --from-file file containing a list creates an endpoint per item.
+	// Every item is validated before any API call is made.
+	var inputs []*fastly.CreateCloudfilesInput
+	if c.FromFile.WasSet {
+		patches, list, err := argparser.PatchesFromFile(c.FromFile.Value, c.InputFormat.Value)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		if list {
+			if inputs, err = c.constructInputs(patches); err != nil {
+				c.Globals.ErrLog.Add(err)
+				return err
+			}
+		}
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -184,6 +260,10 @@ func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if inputs != nil {
+		return c.createAll(out, inputs, serviceID, fastly.ToValue(serviceVersion.Number))
+	}
+
 	input, err := c.ConstructInput(serviceID, fastly.ToValue(serviceVersion.Number))
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -202,11 +282,78 @@ func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	text.Success(out,
-		"Created Cloudfiles logging endpoint %s (service %s version %d)",
-		fastly.ToValue(d.Name),
-		fastly.ToValue(d.ServiceID),
-		fastly.ToValue(d.ServiceVersion),
-	)
-	return nil
+	return argparser.WriteCreated(out, c.JSONOutput, argparser.CreatedResource{
+		Type:           "Cloudfiles logging endpoint",
+		Name:           fastly.ToValue(d.Name),
+		ServiceID:      fastly.ToValue(d.ServiceID),
+		ServiceVersion: fastly.ToValue(d.ServiceVersion),
+	})
+}
+
+// constructInputs returns the input of each item of a --from-file list. The
+// flags set directly apply to every item (so setting one of them in an item is
+// an error), and each item must have a distinct name.
+//
+// NOTE: The service ID and version are set once they're resolved.
+func (c *CreateCommand) constructInputs(patches []map[string]json.RawMessage) ([]*fastly.CreateCloudfilesInput, error) {
+	if len(patches) == 0 {
+		return nil, fmt.Errorf("the --%s file doesn't contain any endpoints", argparser.FlagFromFileName)
+	}
+	inputs := make([]*fastly.CreateCloudfilesInput, 0, len(patches))
+	names := make(map[string]bool, len(patches))
+	for i, patch := range patches {
+		item := *c
+		item.FromFile = argparser.OptionalString{}
+		if err := argparser.ApplyPatch(c.FromFile.Value, patch, item.patchFields()); err != nil {
+			return nil, fmt.Errorf("error in item %d of the --%s file: %w", i+1, argparser.FlagFromFileName, err)
+		}
+		input, err := item.ConstructInput("", 0)
+		if err != nil {
+			return nil, fmt.Errorf("error in item %d of the --%s file: %w", i+1, argparser.FlagFromFileName, err)
+		}
+		name := fastly.ToValue(input.Name)
+		switch {
+		case name == "":
+			return nil, fmt.Errorf("item %d of the --%s file doesn't have a name", i+1, argparser.FlagFromFileName)
+		case names[name]:
+			return nil, fmt.Errorf("item %d of the --%s file has the same name as an earlier item: %s", i+1, argparser.FlagFromFileName, name)
+		}
+		names[name] = true
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// createAll creates an endpoint for each input, reporting the outcome of each.
+// Once an endpoint fails to be created the remaining endpoints are skipped,
+// unless --on-error is continue.
+func (c *CreateCommand) createAll(out io.Writer, inputs []*fastly.CreateCloudfilesInput, serviceID string, serviceVersion int) error {
+	result := argparser.BulkResult{Action: "create", Noun: "Cloudfiles logging endpoints", OnError: c.onError}
+	for _, input := range inputs {
+		name := fastly.ToValue(input.Name)
+		if result.Aborted() {
+			result.Skipped(name)
+			continue
+		}
+		input.ServiceID = serviceID
+		input.ServiceVersion = serviceVersion
+		if _, err := c.Globals.APIClient.CreateCloudfiles(input); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Name":            name,
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion,
+			})
+			result.Failed(name, err)
+			continue
+		}
+		result.Succeeded(name)
+	}
+
+	if !c.JSONOutput.Enabled && result.Report().Summary.Failed == 0 {
+		text.Success(out, "Created %d Cloudfiles logging endpoints (service %s version %d)", len(inputs), serviceID, serviceVersion)
+	}
+	if err := result.Render(out, c.JSONOutput); err != nil {
+		return err
+	}
+	return result.Err(false)
 }
@@ -1,11 +1,13 @@
 package cloudfiles
 
 import (
+	"context"
 	"io"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
 	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/logging/common"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
@@ -15,10 +17,12 @@ import (
 type DescribeCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.WatchOutput
 
 	Input          fastly.GetCloudfilesInput
 	serviceName    argparser.OptionalServiceNameID
 	serviceVersion argparser.OptionalServiceVersion
+	names          []string
 }
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -31,7 +35,7 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 	c.CmdClause = parent.Command("describe", "Show detailed information about a Cloudfiles logging endpoint on a Fastly service version").Alias("get")
 
 	// Required.
-	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object (repeat to describe multiple)").Short('n').Required().StringsVar(&c.names)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagVersionName,
 		Description: argparser.FlagVersionDesc,
@@ -40,7 +44,7 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 	})
 
 	// Optional.
-	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFormatFlags(c.CmdClause, argparser.FormatJSON, argparser.FormatYAML, argparser.FormatLogfmt) // --format, --json
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -53,6 +57,7 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 		Description: argparser.FlagServiceNameDesc,
 		Dst:         &c.serviceName.Value,
 	})
+	c.RegisterWatchFlags(c.CmdClause) // --watch, --interval
 	return &c
 }
 
@@ -81,41 +86,83 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 	c.Input.ServiceID = serviceID
 	c.Input.ServiceVersion = fastly.ToValue(serviceVersion.Number)
 
-	o, err := c.Globals.APIClient.GetCloudfiles(&c.Input)
-	if err != nil {
-		c.Globals.ErrLog.AddWithContext(err, map[string]any{
-			"Service ID":      serviceID,
-			"Service Version": fastly.ToValue(serviceVersion.Number),
-		})
-		return err
-	}
+	return c.Watch(context.Background(), out, &c.JSONOutput, func(out io.Writer) error {
+		if len(c.names) > 1 {
+			return argparser.DescribeMany(out, c.names, c.JSONOutput, func(name string) (*Output, error) {
+				input := c.Input
+				input.Name = name
+				o, err := c.Globals.APIClient.GetCloudfiles(&input)
+				if err != nil {
+					c.Globals.ErrLog.AddWithContext(err, map[string]any{
+						"Name":            name,
+						"Service ID":      serviceID,
+						"Service Version": fastly.ToValue(serviceVersion.Number),
+					})
+					return nil, err
+				}
+				common.WarnMissingFields(c.Globals, out, "cloudfiles", name, o)
+				common.RedactFields(c.Globals, o)
+				return newOutput(o), nil
+			}, c.print)
+		}
+		c.Input.Name = c.names[0]
 
-	if ok, err := c.WriteJSON(out, o); ok {
-		return err
-	}
+		o, err := c.Globals.APIClient.GetCloudfiles(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": fastly.ToValue(serviceVersion.Number),
+			})
+			return err
+		}
+		common.WarnMissingFields(c.Globals, out, "cloudfiles", c.Input.Name, o)
+		common.RedactFields(c.Globals, o)
 
-	lines := text.Lines{
-		"Access key":         fastly.ToValue(o.AccessKey),
-		"Bucket":             fastly.ToValue(o.BucketName),
-		"Format version":     fastly.ToValue(o.FormatVersion),
-		"Format":             fastly.ToValue(o.Format),
-		"GZip level":         fastly.ToValue(o.GzipLevel),
-		"Message type":       fastly.ToValue(o.MessageType),
-		"Name":               fastly.ToValue(o.Name),
-		"Path":               fastly.ToValue(o.Path),
-		"Period":             fastly.ToValue(o.Period),
-		"Placement":          fastly.ToValue(o.Placement),
-		"Public key":         fastly.ToValue(o.PublicKey),
-		"Region":             fastly.ToValue(o.Region),
-		"Response condition": fastly.ToValue(o.ResponseCondition),
-		"Timestamp format":   fastly.ToValue(o.TimestampFormat),
-		"User":               fastly.ToValue(o.User),
-		"Version":            fastly.ToValue(o.ServiceVersion),
+		if ok, err := c.WriteOutput(out, newOutput(o)); ok {
+			return err
+		}
+
+		return c.print(out, newOutput(o))
+	})
+}
+
+// print displays the information returned from the API.
+func (c *DescribeCommand) print(out io.Writer, o *Output) error {
+	lines := []text.Line{
+		{Key: "Bucket", Value: fastly.ToValue(o.BucketName)},
+		{Key: "Format", Value: fastly.ToValue(o.Format)},
+		{Key: "Format version", Value: fastly.ToValue(o.FormatVersion)},
+		{Key: "GZip level", Value: fastly.ToValue(o.GzipLevel)},
+		{Key: "Message type", Value: fastly.ToValue(o.MessageType)},
+		{Key: "Name", Value: fastly.ToValue(o.Name)},
+		{Key: "Path", Value: fastly.ToValue(o.Path)},
+		{Key: "Period", Value: fastly.ToValue(o.Period)},
+		{Key: "Placement", Value: fastly.ToValue(o.Placement)},
+		{Key: "Region", Value: fastly.ToValue(o.Region)},
+		{Key: "Response condition", Value: fastly.ToValue(o.ResponseCondition)},
 	}
 	if !c.Globals.Verbose() {
-		lines["Service ID"] = fastly.ToValue(o.ServiceID)
+		lines = append(lines, text.Line{Key: "Service ID", Value: fastly.ToValue(o.ServiceID)})
 	}
-	text.PrintLines(out, lines)
+	lines = append(lines,
+		text.Line{Key: "Timestamp format", Value: fastly.ToValue(o.TimestampFormat)},
+		text.Line{Key: "Version", Value: fastly.ToValue(o.ServiceVersion)},
+	)
+	text.PrintSections(out, []text.Section{
+		{Lines: lines},
+		{
+			Title: "Authentication",
+			Lines: []text.Line{
+				{Key: "Access key", Value: fastly.ToValue(o.AccessKey)},
+				{Key: "User", Value: fastly.ToValue(o.User)},
+				{Key: "Public key", Value: fastly.ToValue(o.PublicKey)},
+			},
+		},
+	})
 
 	return nil
 }
+
+// RedactsTextOutput indicates the text output honours --redact-output (see
+// common.RedactFields).
+func (c *DescribeCommand) RedactsTextOutput() {}
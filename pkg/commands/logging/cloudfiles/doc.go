@@ -1,3 +1,8 @@
 // Package cloudfiles contains commands to inspect and manipulate Fastly service Cloudfiles
 // logging endpoints.
+//
+// NOTE: There are no enable/disable subcommands, as the Fastly API (and so
+// fastly.Cloudfiles) has no enabled state for a logging endpoint. To stop an
+// endpoint receiving logs, either delete it from a new service version or
+// attach a --response-condition that never matches (see update).
 package cloudfiles
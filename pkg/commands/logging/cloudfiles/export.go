@@ -0,0 +1,156 @@
+package cloudfiles
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+)
+
+// Config is the exported (round-trippable) configuration of a Cloudfiles
+// logging endpoint. The field names are the flags of the create command, so
+// the document can be passed to `create --from-file`.
+//
+// NOTE: A nil field is omitted, while a zero value (e.g. gzip-level: 0) is
+// exported so it's recreated faithfully.
+type Config struct {
+	AccessKey         *string `json:"access-key,omitempty"`
+	BucketName        *string `json:"bucket,omitempty"`
+	CompressionCodec  *string `json:"compression-codec,omitempty"`
+	Format            *string `json:"format,omitempty"`
+	FormatVersion     *int    `json:"format-version,omitempty"`
+	GzipLevel         *int    `json:"gzip-level,omitempty"`
+	MessageType       *string `json:"message-type,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	Path              *string `json:"path,omitempty"`
+	Period            *int    `json:"period,omitempty"`
+	Placement         *string `json:"placement,omitempty"`
+	PublicKey         *string `json:"public-key,omitempty"`
+	Region            *string `json:"region,omitempty"`
+	ResponseCondition *string `json:"response-condition,omitempty"`
+	TimestampFormat   *string `json:"timestamp-format,omitempty"`
+	User              *string `json:"user,omitempty"`
+}
+
+// newConfig converts the API representation of a Cloudfiles logging endpoint.
+// The access key is only included if includeSecrets is set.
+func newConfig(o *fastly.Cloudfiles, includeSecrets bool) *Config {
+	cfg := &Config{
+		BucketName:        o.BucketName,
+		CompressionCodec:  o.CompressionCodec,
+		Format:            o.Format,
+		FormatVersion:     o.FormatVersion,
+		GzipLevel:         o.GzipLevel,
+		MessageType:       o.MessageType,
+		Name:              o.Name,
+		Path:              o.Path,
+		Period:            o.Period,
+		Placement:         o.Placement,
+		PublicKey:         o.PublicKey,
+		Region:            o.Region,
+		ResponseCondition: o.ResponseCondition,
+		TimestampFormat:   o.TimestampFormat,
+		User:              o.User,
+	}
+	if includeSecrets {
+		cfg.AccessKey = o.AccessKey
+	}
+	// NOTE: The create command rejects --gzip-level with --compression-codec,
+	// and the API reports a gzip level of zero when a codec is used.
+	if fastly.ToValue(o.CompressionCodec) != "" {
+		cfg.GzipLevel = nil
+	}
+	return cfg
+}
+
+// ExportCommand calls the Fastly API to export the configuration of a
+// Cloudfiles logging endpoint.
+type ExportCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	Input          fastly.GetCloudfilesInput
+	includeSecrets bool
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// NewExportCommand returns a usable command registered under the parent.
+func NewExportCommand(parent argparser.Registerer, g *global.Data) *ExportCommand {
+	c := ExportCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+		// NOTE: The document is always structured output, in the
+		// --output-format chosen.
+		JSONOutput: argparser.JSONOutput{
+			Enabled: true,
+		},
+	}
+	c.CmdClause = parent.Command("export", "Export the configuration of a Cloudfiles logging endpoint as a document that `fastly logging cloudfiles create --from-file` accepts")
+
+	// Required.
+	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object").Short('n').Required().StringVar(&c.Input.Name)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	// NOTE: The flag isn't named --format as that's the log line format (which
+	// is exported as the document's format field).
+	c.CmdClause.Flag("output-format", "The format of the exported document (json, yaml)").Default(argparser.InputFormatYAML).HintOptions(argparser.InputFormats...).EnumVar(&c.Format, argparser.InputFormats...)
+	c.CmdClause.Flag("include-secrets", "Include the access key (otherwise it's omitted, and must be provided when the document is used)").BoolVar(&c.includeSecrets)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ExportCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = fastly.ToValue(serviceVersion.Number)
+
+	o, err := c.Globals.APIClient.GetCloudfiles(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fastly.ToValue(serviceVersion.Number),
+		})
+		return err
+	}
+
+	_, err = c.WriteOutput(out, newConfig(o, c.includeSecrets))
+	return err
+}
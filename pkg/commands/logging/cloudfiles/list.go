@@ -3,10 +3,12 @@ package cloudfiles
 import (
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
 	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/logging/common"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
@@ -15,7 +17,9 @@ import (
 // ListCommand calls the Fastly API to list Cloudfiles logging endpoints.
 type ListCommand struct {
 	argparser.Base
+	argparser.CountOutput
 	argparser.JSONOutput
+	argparser.ListFilter
 
 	Input          fastly.ListCloudfilesInput
 	serviceName    argparser.OptionalServiceNameID
@@ -40,7 +44,8 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	})
 
 	// Optional.
-	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlagBool(c.CountFlag()) // --count-only
+	c.RegisterFlag(c.FilterFlag())    // --filter
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -53,6 +58,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 		Description: argparser.FlagServiceNameDesc,
 		Dst:         &c.serviceName.Value,
 	})
+	c.RegisterFormatFlags(c.CmdClause, argparser.FormatJSON, argparser.FormatYAML, argparser.FormatCSV) // --format, --json, --yaml, --csv
 	return &c
 }
 
@@ -90,21 +96,34 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	o, err = argparser.ApplyFilter(c.ListFilter, o)
+	if err != nil {
+		return err
+	}
+
+	for _, cloudfile := range o {
+		common.WarnMissingFields(c.Globals, out, "cloudfiles", fastly.ToValue(cloudfile.Name), cloudfile)
+		common.RedactFields(c.Globals, cloudfile)
+	}
+
+	if ok, err := c.WriteCount(out, len(o), c.JSONOutput); ok {
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, newOutputs(o)); ok {
 		return err
 	}
 
 	if !c.Globals.Verbose() {
-		tw := text.NewTable(out)
-		tw.AddHeader("SERVICE", "VERSION", "NAME")
+		rows := make([][]string, 0, len(o))
 		for _, cloudfile := range o {
-			tw.AddLine(
+			rows = append(rows, []string{
 				fastly.ToValue(cloudfile.ServiceID),
-				fastly.ToValue(cloudfile.ServiceVersion),
+				strconv.Itoa(fastly.ToValue(cloudfile.ServiceVersion)),
 				fastly.ToValue(cloudfile.Name),
-			)
+			})
 		}
-		tw.Print()
+		text.PrintTable(out, []string{"SERVICE", "VERSION", "NAME"}, rows, text.TableOptions{})
 		return nil
 	}
 
@@ -133,3 +152,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 
 	return nil
 }
+
+// RedactsTextOutput indicates the text output honours --redact-output (see
+// common.RedactFields).
+func (c *ListCommand) RedactsTextOutput() {}
@@ -0,0 +1,167 @@
+package cloudfiles
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"4d63.com/optional"
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/logging/common"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// MigrateFormatCommand translates the version 1 log format of a Cloudfiles
+// logging endpoint into its version 2 equivalent.
+type MigrateFormatCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	apply          bool
+	autoClone      argparser.OptionalAutoClone
+	endpointName   string
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// NewMigrateFormatCommand returns a usable command registered under the parent.
+func NewMigrateFormatCommand(parent argparser.Registerer, g *global.Data) *MigrateFormatCommand {
+	c := MigrateFormatCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("migrate-format", "Suggest (or apply) the format version 2 equivalent of a Cloudfiles logging endpoint's version 1 format")
+
+	// Required.
+	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object").Short('n').Required().StringVar(&c.endpointName)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.CmdClause.Flag("apply", "Update the endpoint with the suggested format (and format version 2)").BoolVar(&c.apply)
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *MigrateFormatCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	opts := argparser.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	}
+	// NOTE: The service version only needs to be editable if it's updated.
+	if c.apply {
+		opts.Active = optional.Of(false)
+		opts.Locked = optional.Of(false)
+	}
+	serviceID, serviceVersion, err := argparser.ServiceDetails(opts)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	o, err := c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+		Name:           c.endpointName,
+		ServiceID:      serviceID,
+		ServiceVersion: fastly.ToValue(serviceVersion.Number),
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fastly.ToValue(serviceVersion.Number),
+		})
+		return err
+	}
+
+	if v := fastly.ToValue(o.FormatVersion); v != 1 {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the Cloudfiles logging endpoint '%s' uses format version %d, not 1", c.endpointName, v),
+			Remediation: "Only endpoints on format version 1 need migrating.",
+		}
+	}
+
+	m := common.MigrateFormatV1(fastly.ToValue(o.Format))
+
+	if c.apply {
+		if len(m.Review) > 0 {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("the format can't be migrated automatically: %s", strings.Join(m.Review, "; ")),
+				Remediation: fmt.Sprintf("Resolve the ambiguities manually, then run `fastly logging cloudfiles update --name %s --format <format> --format-version 2`.", c.endpointName),
+			}
+		}
+		o, err = c.Globals.APIClient.UpdateCloudfiles(&fastly.UpdateCloudfilesInput{
+			Format:         &m.Suggested,
+			FormatVersion:  fastly.ToPointer(2),
+			Name:           c.endpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": fastly.ToValue(serviceVersion.Number),
+			})
+			return err
+		}
+		m.Applied = true
+	}
+
+	if ok, err := c.WriteOutput(out, m); ok {
+		return err
+	}
+
+	text.PrintLines(out, text.Lines{
+		"Format (version 1)":           m.Original,
+		"Suggested format (version 2)": m.Suggested,
+	})
+	for _, r := range m.Review {
+		text.Warning(out, "Review manually: %s", r)
+	}
+	if m.Applied {
+		text.Success(out,
+			"Migrated Cloudfiles logging endpoint %s to format version 2 (service %s version %d)",
+			fastly.ToValue(o.Name),
+			fastly.ToValue(o.ServiceID),
+			fastly.ToValue(o.ServiceVersion),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package cloudfiles
+
+import (
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+)
+
+// Output is the structured (--json, --format) representation of a Cloudfiles
+// logging endpoint, as displayed by the describe and list commands.
+//
+// NOTE: The field names are a stable contract for scripts consuming the
+// output. They match the fields of the API client's type (which were
+// previously marshalled as-is) so that changes to the API client can't rename
+// them. Fields may be added but must not be renamed or removed, which the
+// snapshot tests under testdata/ enforce.
+type Output struct {
+	AccessKey         *string    `json:"AccessKey"`
+	BucketName        *string    `json:"BucketName"`
+	CompressionCodec  *string    `json:"CompressionCodec"`
+	CreatedAt         *time.Time `json:"CreatedAt"`
+	DeletedAt         *time.Time `json:"DeletedAt"`
+	Format            *string    `json:"Format"`
+	FormatVersion     *int       `json:"FormatVersion"`
+	GzipLevel         *int       `json:"GzipLevel"`
+	MessageType       *string    `json:"MessageType"`
+	Name              *string    `json:"Name"`
+	Path              *string    `json:"Path"`
+	Period            *int       `json:"Period"`
+	Placement         *string    `json:"Placement"`
+	PublicKey         *string    `json:"PublicKey"`
+	Region            *string    `json:"Region"`
+	ResponseCondition *string    `json:"ResponseCondition"`
+	ServiceID         *string    `json:"ServiceID"`
+	ServiceVersion    *int       `json:"ServiceVersion"`
+	TimestampFormat   *string    `json:"TimestampFormat"`
+	UpdatedAt         *time.Time `json:"UpdatedAt"`
+	User              *string    `json:"User"`
+}
+
+// newOutput converts the API representation of a Cloudfiles logging endpoint.
+func newOutput(o *fastly.Cloudfiles) *Output {
+	return &Output{
+		AccessKey:         o.AccessKey,
+		BucketName:        o.BucketName,
+		CompressionCodec:  o.CompressionCodec,
+		CreatedAt:         o.CreatedAt,
+		DeletedAt:         o.DeletedAt,
+		Format:            o.Format,
+		FormatVersion:     o.FormatVersion,
+		GzipLevel:         o.GzipLevel,
+		MessageType:       o.MessageType,
+		Name:              o.Name,
+		Path:              o.Path,
+		Period:            o.Period,
+		Placement:         o.Placement,
+		PublicKey:         o.PublicKey,
+		Region:            o.Region,
+		ResponseCondition: o.ResponseCondition,
+		ServiceID:         o.ServiceID,
+		ServiceVersion:    o.ServiceVersion,
+		TimestampFormat:   o.TimestampFormat,
+		UpdatedAt:         o.UpdatedAt,
+		User:              o.User,
+	}
+}
+
+// newOutputs converts the API representation of Cloudfiles logging endpoints.
+func newOutputs(cs []*fastly.Cloudfiles) []*Output {
+	out := make([]*Output, 0, len(cs))
+	for _, o := range cs {
+		out = append(out, newOutput(o))
+	}
+	return out
+}
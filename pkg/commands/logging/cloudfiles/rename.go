@@ -0,0 +1,158 @@
+package cloudfiles
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"4d63.com/optional"
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/logging/common"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RenameCommand renames a Cloudfiles logging endpoint, leaving the rest of its
+// configuration untouched.
+type RenameCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	autoClone      argparser.OptionalAutoClone
+	endpointName   string
+	newName        string
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// NewRenameCommand returns a usable command registered under the parent.
+func NewRenameCommand(parent argparser.Registerer, g *global.Data) *RenameCommand {
+	c := RenameCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("rename", "Rename a Cloudfiles logging endpoint on a Fastly service version")
+
+	// Required.
+	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object").Short('n').Required().StringVar(&c.endpointName)
+	c.CmdClause.Flag("new-name", "The new name of the Cloudfiles logging object").Required().StringVar(&c.newName)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+//
+// NOTE: Only the name is sent in the update, so the rest of the endpoint's
+// configuration can't be changed (or reset) by mistake.
+func (c *RenameCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+	if c.newName == c.endpointName {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("the new name is the same as the current name (%s)", c.endpointName),
+			Remediation: "Pass a different value for --new-name.",
+		}
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		Active:             optional.Of(false),
+		Locked:             optional.Of(false),
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+	version := fastly.ToValue(serviceVersion.Number)
+
+	// The new name is looked up first so a collision is reported clearly,
+	// rather than as the API's generic error.
+	_, err = c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+		Name:           c.newName,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	switch {
+	case err == nil:
+		return common.RenameCollision("Cloudfiles", c.newName, version)
+	case !common.IsNotFound(err):
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return err
+	}
+
+	cloudfiles, err := c.Globals.APIClient.UpdateCloudfiles(&fastly.UpdateCloudfilesInput{
+		Name:           c.endpointName,
+		NewName:        &c.newName,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		if common.IsConflict(err) {
+			return common.RenameCollision("Cloudfiles", c.newName, version)
+		}
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, common.RenameOutput{
+		NewName:        fastly.ToValue(cloudfiles.Name),
+		OldName:        c.endpointName,
+		ServiceID:      fastly.ToValue(cloudfiles.ServiceID),
+		ServiceVersion: fastly.ToValue(cloudfiles.ServiceVersion),
+	}); ok {
+		return err
+	}
+
+	text.Success(out,
+		"Renamed Cloudfiles logging endpoint %s to %s (service %s version %d)",
+		c.endpointName,
+		fastly.ToValue(cloudfiles.Name),
+		fastly.ToValue(cloudfiles.ServiceID),
+		fastly.ToValue(cloudfiles.ServiceVersion),
+	)
+	return nil
+}
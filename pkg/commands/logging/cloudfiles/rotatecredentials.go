@@ -0,0 +1,208 @@
+package cloudfiles
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/fatih/color"
+
+	"4d63.com/optional"
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/prompt"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RotateCredentialsCommand replaces the credentials of a Cloudfiles logging
+// endpoint, leaving the rest of its configuration untouched.
+type RotateCredentialsCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	accessKey      string
+	autoClone      argparser.OptionalAutoClone
+	endpointName   string
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+	user           argparser.OptionalString
+}
+
+// RotateCredentialsOutput is the structured (--json) result of rotating the
+// credentials of a Cloudfiles logging endpoint. The credentials themselves are
+// never included.
+type RotateCredentialsOutput struct {
+	Name           string `json:"name"`
+	ServiceID      string `json:"service_id"`
+	ServiceVersion int    `json:"service_version"`
+	UserUpdated    bool   `json:"user_updated"`
+	// VersionValidated is whether the service version's configuration passed
+	// validation. The new credentials aren't checked with Cloudfiles.
+	VersionValidated bool `json:"version_validated"`
+}
+
+// NewRotateCredentialsCommand returns a usable command registered under the parent.
+func NewRotateCredentialsCommand(parent argparser.Registerer, g *global.Data) *RotateCredentialsCommand {
+	c := RotateCredentialsCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("rotate-credentials", "Replace the credentials of a Cloudfiles logging endpoint on a Fastly service version, then validate the version")
+
+	// Required.
+	c.CmdClause.Flag("access-key", "The new access key for your Cloudfile account").Required().StringVar(&c.accessKey)
+	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object").Short('n').Required().StringVar(&c.endpointName)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterAutoCloneFlag(argparser.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("user", "The new username for your Cloudfile account (if it's also changing)").Action(c.user.Set).StringVar(&c.user.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+//
+// NOTE: Only the credentials are sent in the update, so the rest of the
+// endpoint's configuration can't be changed (or reset) by mistake.
+func (c *RotateCredentialsCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	// The new access key is registered so that it's redacted from the API
+	// request/response output (--debug-mode) and the error log.
+	text.RegisterSecret(c.accessKey)
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		Active:             optional.Of(false),
+		Locked:             optional.Of(false),
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+	version := fastly.ToValue(serviceVersion.Number)
+
+	// The endpoint is fetched first so a typo in --name is reported before
+	// the user is asked to confirm.
+	if _, err := c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+		Name:           c.endpointName,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	}); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return err
+	}
+
+	// NOTE: The confirmation is written to stderr so it isn't mixed with the
+	// command output (e.g. --json).
+	if !c.Globals.Flags.AutoYes && !c.Globals.Flags.NonInteractive {
+		text.Warning(color.Error, "This will replace the credentials of the Cloudfiles logging endpoint '%s' (service %s version %d).\n\n", c.endpointName, serviceID, version)
+		cont, err := prompt.Confirm(color.Error, in, "Are you sure you want to continue?", false)
+		if err != nil {
+			return err
+		}
+		if !cont {
+			return nil
+		}
+		text.Break(color.Error)
+	}
+
+	input := fastly.UpdateCloudfilesInput{
+		AccessKey:      &c.accessKey,
+		Name:           c.endpointName,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	}
+	if c.user.WasSet {
+		input.User = &c.user.Value
+	}
+
+	cloudfiles, err := c.Globals.APIClient.UpdateCloudfiles(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return err
+	}
+
+	valid, msg, err := c.Globals.APIClient.ValidateVersion(&fastly.ValidateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return fmt.Errorf("the credentials were updated but service version %d couldn't be validated: %w", version, err)
+	}
+	if !valid {
+		err := fmt.Errorf("the credentials were updated but service version %d is no longer valid: %s", version, msg)
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return fsterr.RemediationError{
+			Inner:       err,
+			Remediation: "Check the new credentials are correct, then run this command again.",
+		}
+	}
+
+	if ok, err := c.WriteOutput(out, RotateCredentialsOutput{
+		Name:             fastly.ToValue(cloudfiles.Name),
+		ServiceID:        fastly.ToValue(cloudfiles.ServiceID),
+		ServiceVersion:   fastly.ToValue(cloudfiles.ServiceVersion),
+		UserUpdated:      c.user.WasSet,
+		VersionValidated: true,
+	}); ok {
+		return err
+	}
+
+	text.Success(out,
+		"Rotated the credentials of Cloudfiles logging endpoint %s (service %s version %d)",
+		fastly.ToValue(cloudfiles.Name),
+		fastly.ToValue(cloudfiles.ServiceID),
+		fastly.ToValue(cloudfiles.ServiceVersion),
+	)
+	text.Info(out, "Service version %d validated successfully (the new credentials aren't checked with Cloudfiles).", version)
+	return nil
+}
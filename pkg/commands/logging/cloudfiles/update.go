@@ -1,7 +1,9 @@
 package cloudfiles
 
 import (
+	"fmt"
 	"io"
+	"strings"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
@@ -17,6 +19,8 @@ import (
 // UpdateCommand calls the Fastly API to update a Cloudfiles logging endpoint.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -36,12 +40,15 @@ type UpdateCommand struct {
 	Period            argparser.OptionalInt
 	GzipLevel         argparser.OptionalInt
 	Format            argparser.OptionalString
+	InputFormat       argparser.OptionalString
 	FormatVersion     argparser.OptionalInt
 	ResponseCondition argparser.OptionalString
 	MessageType       argparser.OptionalString
 	TimestampFormat   argparser.OptionalString
 	PublicKey         argparser.OptionalString
 	CompressionCodec  argparser.OptionalString
+	FieldFromFile     argparser.OptionalString
+	FromFile          argparser.OptionalString
 }
 
 // NewUpdateCommand returns a usable command registered under the parent.
@@ -70,7 +77,10 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	c.CmdClause.Flag("access-key", "Your Cloudfile account access key").Action(c.AccessKey.Set).StringVar(&c.AccessKey.Value)
 	c.CmdClause.Flag("bucket", "The name of your Cloudfiles container").Action(c.BucketName.Set).StringVar(&c.BucketName.Value)
 	common.CompressionCodec(c.CmdClause, &c.CompressionCodec)
+	common.FieldFromFile(c.CmdClause, &c.FieldFromFile)
 	common.Format(c.CmdClause, &c.Format)
+	common.FromFile(c.CmdClause, &c.FromFile)
+	common.InputFormat(c.CmdClause, &c.InputFormat)
 	common.FormatVersion(c.CmdClause, &c.FormatVersion)
 	common.GzipLevel(c.CmdClause, &c.GzipLevel)
 	common.MessageType(c.CmdClause, &c.MessageType)
@@ -79,7 +89,7 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	common.Period(c.CmdClause, &c.Period)
 	common.Placement(c.CmdClause, &c.Placement)
 	common.PublicKey(c.CmdClause, &c.PublicKey)
-	c.CmdClause.Flag("region", "The region to stream logs to. One of: DFW-Dallas, ORD-Chicago, IAD-Northern Virginia, LON-London, SYD-Sydney, HKG-Hong Kong").Action(c.Region.Set).StringVar(&c.Region.Value)
+	common.RegionFlag(c.CmdClause, c.Globals, "cloudfiles", "The region to stream logs to", &c.Region)
 	c.CmdClause.Flag("user", "The username for your Cloudfile account").Action(c.User.Set).StringVar(&c.User.Value)
 	common.ResponseCondition(c.CmdClause, &c.ResponseCondition)
 	c.RegisterFlag(argparser.StringFlagOpts{
@@ -95,11 +105,51 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 		Dst:         &c.ServiceName.Value,
 	})
 	common.TimestampFormat(c.CmdClause, &c.TimestampFormat)
+	c.RegisterFlagBool(c.JSONFlag())         // --json
+	c.RegisterFieldFlag(c.CmdClause)         // --field
+	c.RegisterChangesFlags(c.CmdClause)      // --show-changes, --diff-context
+	c.RegisterOnlyIfChangedFlag(c.CmdClause) // --only-if-changed
 	return &c
 }
 
 // ConstructInput transforms values parsed from CLI flags into an object to be used by the API client library.
 func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*fastly.UpdateCloudfilesInput, error) {
+	if c.FromFile.WasSet {
+		err := argparser.PatchFromFile(c.FromFile.Value, c.InputFormat.Value, map[string]any{
+			"access-key":         &c.AccessKey,
+			"bucket":             &c.BucketName,
+			"bucket-name":        argparser.PatchAlias("bucket"),
+			"compression-codec":  &c.CompressionCodec,
+			"format":             &c.Format,
+			"format-version":     &c.FormatVersion,
+			"gzip-level":         &c.GzipLevel,
+			"message-type":       &c.MessageType,
+			"new-name":           &c.NewName,
+			"path":               &c.Path,
+			"period":             &c.Period,
+			"placement":          &c.Placement,
+			"public-key":         &c.PublicKey,
+			"region":             &c.Region,
+			"response-condition": &c.ResponseCondition,
+			"timestamp-format":   &c.TimestampFormat,
+			"user":               &c.User,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.FieldFromFile.WasSet {
+		err := argparser.FieldsFromFile(c.FieldFromFile.Value, c.InputFormat.Value, map[string]*argparser.OptionalString{
+			"access-key": &c.AccessKey,
+			"public-key": &c.PublicKey,
+			"user":       &c.User,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	input := fastly.UpdateCloudfilesInput{
 		ServiceID:      serviceID,
 		ServiceVersion: serviceVersion,
@@ -176,17 +226,48 @@ func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
-	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	// NOTE: The version is resolved without being cloned (with --autoclone),
+	// so that with --only-if-changed a no-op update doesn't create a version.
+	opts := argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
 		AutoCloneFlag:      c.AutoClone,
 		APIClient:          c.Globals.APIClient,
 		Manifest:           *c.Globals.Manifest,
 		Out:                out,
+		ResolveOnly:        true,
 		ServiceNameFlag:    c.ServiceName,
 		ServiceVersionFlag: c.ServiceVersion,
 		VerboseMode:        c.Globals.Flags.Verbose,
-	})
+	}
+	serviceID, serviceVersion, err := argparser.ServiceDetails(opts)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	if c.OnlyIfChanged {
+		skip, err := c.skipUnchanged(out, serviceID, fastly.ToValue(serviceVersion.Number))
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": fastly.ToValue(serviceVersion.Number),
+			})
+			return err
+		}
+		if skip {
+			return nil
+		}
+	}
+
+	serviceVersion, err = argparser.EditableVersion(opts, serviceID, serviceVersion)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
 			"Service ID":      serviceID,
@@ -204,6 +285,19 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+			Name:           c.EndpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	cloudfiles, err := c.Globals.APIClient.UpdateCloudfiles(input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -213,11 +307,79 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	text.Success(out,
-		"Updated Cloudfiles logging endpoint %s (service %s version %d)",
+	changes := argparser.Changes(before, cloudfiles)
+	changes.Rejected = argparser.RejectedFields(input, cloudfiles)
+	if len(changes.Rejected) > 0 {
+		c.Globals.ErrLog.AddWithContext(fmt.Errorf("partial update: the API didn't apply the requested value of: %s", strings.Join(changes.Rejected, ", ")), map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fastly.ToValue(serviceVersion.Number),
+			"Name":            c.EndpointName,
+			"Rejected Fields": changes.Rejected,
+		})
+	}
+	c.warnPartialUpdate(out, cloudfiles, changes.Rejected)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
+	if len(changes.Rejected) == 0 {
+		text.Success(out,
+			"Updated Cloudfiles logging endpoint %s (service %s version %d)",
+			fastly.ToValue(cloudfiles.Name),
+			fastly.ToValue(cloudfiles.ServiceID),
+			fastly.ToValue(cloudfiles.ServiceVersion),
+		)
+	}
+	c.DisplayChanges(out, changes)
+	return nil
+}
+
+// skipUnchanged reports the update as skipped (returning true) if the values
+// provided match the existing values of the endpoint on the resolved version
+// (see --only-if-changed).
+func (c *UpdateCommand) skipUnchanged(out io.Writer, serviceID string, version int) (bool, error) {
+	input, err := c.ConstructInput(serviceID, version)
+	if err != nil {
+		return false, err
+	}
+	current, err := c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+		Name:           c.EndpointName,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(argparser.PendingChanges(input, current)) > 0 {
+		return false, nil
+	}
+
+	if ok, err := c.WriteOutput(out, argparser.ChangeSet{Changed: map[string]argparser.FieldChange{}}); ok {
+		return true, err
+	}
+	text.Info(out, "No changes: Cloudfiles logging endpoint %s (service %s version %d) already has the values provided, so it wasn't updated.", c.EndpointName, serviceID, version)
+	return true, nil
+}
+
+// warnPartialUpdate warns that the update was only partially applied, as the
+// API didn't apply the requested value of the rejected fields.
+//
+// NOTE: With --json (which implies --quiet) the warning is only recorded (e.g.
+// for --fail-on-warning), as the rejected fields are included in the output.
+func (c *UpdateCommand) warnPartialUpdate(out io.Writer, cloudfiles *fastly.Cloudfiles, rejected []string) {
+	if len(rejected) == 0 {
+		return
+	}
+	msg := fmt.Sprintf(
+		"Partially updated Cloudfiles logging endpoint %s (service %s version %d): the API didn't apply the requested value of %s.",
 		fastly.ToValue(cloudfiles.Name),
 		fastly.ToValue(cloudfiles.ServiceID),
 		fastly.ToValue(cloudfiles.ServiceVersion),
+		strings.Join(rejected, ", "),
 	)
-	return nil
+	if c.Globals.Flags.Quiet {
+		text.Warnings.Add(msg)
+		return
+	}
+	text.Warning(out, "%s", msg)
 }
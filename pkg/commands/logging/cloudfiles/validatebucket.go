@@ -0,0 +1,344 @@
+package cloudfiles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// IdentityEndpoint is the Rackspace identity API used to exchange the
+// endpoint's username and access key for a token.
+//
+// NOTE: It's a variable so the tests can point it at a mock.
+var IdentityEndpoint = "https://identity.api.rackspacecloud.com/v2.0/tokens"
+
+// ValidateBucketTimeout is the timeout for each request to Rackspace.
+const ValidateBucketTimeout = 30 * time.Second
+
+// validateObjectPrefix is the name prefix of the object written (and then
+// deleted) to check the credentials can write to the bucket.
+const validateObjectPrefix = "fastly-cli-validate-"
+
+// ValidateBucketCommand checks the credentials of a Cloudfiles logging
+// endpoint can access its bucket, by calling Rackspace directly.
+type ValidateBucketCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	endpointName   string
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// ValidateBucketOutput is the structured (--json) result of validating the
+// bucket of a Cloudfiles logging endpoint. The credentials are never included.
+type ValidateBucketOutput struct {
+	Bucket         string `json:"bucket"`
+	Name           string `json:"name"`
+	Region         string `json:"region"`
+	ServiceID      string `json:"service_id"`
+	ServiceVersion int    `json:"service_version"`
+	Valid          bool   `json:"valid"`
+}
+
+// NewValidateBucketCommand returns a usable command registered under the parent.
+func NewValidateBucketCommand(parent argparser.Registerer, g *global.Data) *ValidateBucketCommand {
+	c := ValidateBucketCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("validate-bucket", "Check the credentials of a Cloudfiles logging endpoint can list and write to its bucket")
+
+	// Required.
+	c.CmdClause.Flag("name", "The name of the Cloudfiles logging object").Short('n').Required().StringVar(&c.endpointName)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional.
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+//
+// NOTE: The check is made from the machine running the CLI, not from Fastly,
+// so a failure caused by a firewall between Fastly and Rackspace won't be
+// detected.
+func (c *ValidateBucketCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+	version := fastly.ToValue(serviceVersion.Number)
+
+	cloudfiles, err := c.Globals.APIClient.GetCloudfiles(&fastly.GetCloudfilesInput{
+		Name:           c.endpointName,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return err
+	}
+
+	// The access key is registered so that it's redacted from any error
+	// message and the error log.
+	accessKey := fastly.ToValue(cloudfiles.AccessKey)
+	text.RegisterSecret(accessKey)
+
+	bucket := fastly.ToValue(cloudfiles.BucketName)
+	region := fastly.ToValue(cloudfiles.Region)
+
+	v := bucketValidator{
+		accessKey: accessKey,
+		bucket:    bucket,
+		client:    c.Globals.HTTPClient,
+		path:      fastly.ToValue(cloudfiles.Path),
+		region:    region,
+		user:      fastly.ToValue(cloudfiles.User),
+	}
+	if err := v.validate(); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Bucket":          bucket,
+			"Region":          region,
+			"Service ID":      serviceID,
+			"Service Version": version,
+		})
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, ValidateBucketOutput{
+		Bucket:         bucket,
+		Name:           c.endpointName,
+		Region:         region,
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+		Valid:          true,
+	}); ok {
+		return err
+	}
+
+	text.Success(out, "The credentials of Cloudfiles logging endpoint %s can list and write to bucket '%s' (service %s version %d)", c.endpointName, bucket, serviceID, version)
+	return nil
+}
+
+// bucketValidator performs the minimal set of Rackspace requests needed to
+// prove the credentials can deliver logs to the bucket.
+type bucketValidator struct {
+	accessKey string
+	bucket    string
+	client    api.HTTPClient
+	path      string
+	region    string
+	user      string
+}
+
+// identityResponse is the subset of the Rackspace identity API response that
+// we need.
+type identityResponse struct {
+	Access struct {
+		Token struct {
+			ID string `json:"id"`
+		} `json:"token"`
+		ServiceCatalog []struct {
+			Name      string `json:"name"`
+			Endpoints []struct {
+				PublicURL string `json:"publicURL"`
+				Region    string `json:"region"`
+			} `json:"endpoints"`
+		} `json:"serviceCatalog"`
+	} `json:"access"`
+}
+
+// validate authenticates, lists the bucket, then writes and deletes a small
+// object under the endpoint's path.
+func (v bucketValidator) validate() error {
+	token, storageURL, err := v.authenticate()
+	if err != nil {
+		return err
+	}
+
+	bucketURL := storageURL + "/" + url.PathEscape(v.bucket)
+	if _, err := v.do(http.MethodGet, bucketURL+"?limit=1&format=json", token, nil); err != nil {
+		return v.classify("list", err)
+	}
+
+	object := path.Join(strings.TrimPrefix(v.path, "/"), fmt.Sprintf("%s%d", validateObjectPrefix, time.Now().UnixNano()))
+	objectURL := bucketURL + "/" + (&url.URL{Path: object}).EscapedPath()
+	if _, err := v.do(http.MethodPut, objectURL, token, []byte("fastly-cli bucket validation\n")); err != nil {
+		return v.classify("write to", err)
+	}
+	if _, err := v.do(http.MethodDelete, objectURL, token, nil); err != nil {
+		return v.classify(fmt.Sprintf("delete the validation object '%s' from", object), err)
+	}
+	return nil
+}
+
+// authenticate exchanges the username and access key for a token, and returns
+// it with the Cloud Files URL for the endpoint's region.
+func (v bucketValidator) authenticate() (token, storageURL string, err error) {
+	body, err := json.Marshal(map[string]any{
+		"auth": map[string]any{
+			"RAX-KSKEY:apiKeyCredentials": map[string]string{
+				"username": v.user,
+				"apiKey":   v.accessKey,
+			},
+		},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	data, err := v.do(http.MethodPost, IdentityEndpoint, "", body)
+	if err != nil {
+		var se statusError
+		if errors.As(err, &se) && (se.status == http.StatusUnauthorized || se.status == http.StatusForbidden) {
+			return "", "", fsterr.RemediationError{
+				Inner:       fmt.Errorf("authentication failed for Cloudfiles user '%s': %w", v.user, err),
+				Remediation: "Check the --user and --access-key of the endpoint (see `fastly logging cloudfiles rotate-credentials`).",
+			}
+		}
+		return "", "", fmt.Errorf("error authenticating with Rackspace: %w", err)
+	}
+
+	var r identityResponse
+	if err := json.Unmarshal(data, &r); err != nil {
+		return "", "", fmt.Errorf("error parsing the Rackspace identity response: %w", err)
+	}
+
+	for _, s := range r.Access.ServiceCatalog {
+		if s.Name != "cloudFiles" {
+			continue
+		}
+		for _, e := range s.Endpoints {
+			if v.region == "" || strings.EqualFold(e.Region, v.region) {
+				return r.Access.Token.ID, strings.TrimSuffix(e.PublicURL, "/"), nil
+			}
+		}
+	}
+	return "", "", fsterr.RemediationError{
+		Inner:       fmt.Errorf("no Cloud Files endpoint was found for region '%s'", v.region),
+		Remediation: "Check the --region of the endpoint is one your Rackspace account has access to.",
+	}
+}
+
+// classify describes a failed bucket operation as an authentication,
+// not-found or permission error.
+func (v bucketValidator) classify(action string, err error) error {
+	var se statusError
+	if !errors.As(err, &se) {
+		return fmt.Errorf("error trying to %s bucket '%s': %w", action, v.bucket, err)
+	}
+	switch se.status {
+	case http.StatusUnauthorized:
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("authentication failed trying to %s bucket '%s': %w", action, v.bucket, err),
+			Remediation: "Check the --user and --access-key of the endpoint (see `fastly logging cloudfiles rotate-credentials`).",
+		}
+	case http.StatusForbidden:
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("permission denied trying to %s bucket '%s': %w", action, v.bucket, err),
+			Remediation: fmt.Sprintf("Grant the Cloudfiles user '%s' read and write access to the bucket.", v.user),
+		}
+	case http.StatusNotFound:
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("bucket '%s' was not found in region '%s': %w", v.bucket, v.region, err),
+			Remediation: "Check the --bucket and --region of the endpoint, or create the bucket.",
+		}
+	}
+	return fmt.Errorf("error trying to %s bucket '%s': %w", action, v.bucket, err)
+}
+
+// do sends a request to Rackspace, returning the response body or a
+// statusError for a non-2xx response.
+func (v bucketValidator) do(method, u, token string, body []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ValidateBucketTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req.Header.Set("X-Auth-Token", token)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // #nosec G307
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, statusError{status: resp.StatusCode}
+	}
+	return data, nil
+}
+
+// statusError is a non-2xx response from Rackspace.
+type statusError struct {
+	status int
+}
+
+func (e statusError) Error() string {
+	return fmt.Sprintf("unexpected response: %d %s", e.status, http.StatusText(e.status))
+}
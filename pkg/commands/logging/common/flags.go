@@ -85,3 +85,18 @@ func TLSClientCert(command *kingpin.CmdClause, c *argparser.OptionalString) {
 func TLSClientKey(command *kingpin.CmdClause, c *argparser.OptionalString) {
 	command.Flag("tls-client-key", "The client private key used to make authenticated requests. Must be in PEM format").Action(c.Set).StringVar(&c.Value)
 }
+
+// FieldFromFile defines the field-from-file flag.
+func FieldFromFile(command *kingpin.CmdClause, c *argparser.OptionalString) {
+	command.Flag(argparser.FlagFieldFromFileName, argparser.FlagFieldFromFileDesc).Action(c.Set).StringVar(&c.Value)
+}
+
+// FromFile defines the from-file flag.
+func FromFile(command *kingpin.CmdClause, c *argparser.OptionalString) {
+	command.Flag(argparser.FlagFromFileName, argparser.FlagFromFileDesc).Action(c.Set).StringVar(&c.Value)
+}
+
+// InputFormat defines the input-format flag.
+func InputFormat(command *kingpin.CmdClause, c *argparser.OptionalString) {
+	command.Flag(argparser.FlagInputFormatName, argparser.FlagInputFormatDesc).Action(c.Set).EnumVar(&c.Value, argparser.InputFormats...)
+}
@@ -0,0 +1,122 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatMigration is the result of translating a version 1 log format into
+// its version 2 equivalent.
+type FormatMigration struct {
+	// Original is the version 1 format.
+	Original string `json:"original"`
+	// Suggested is the version 2 format.
+	Suggested string `json:"suggested"`
+	// Review lists the parts of the format that couldn't be translated
+	// unambiguously and so need to be checked manually.
+	Review []string `json:"review,omitempty"`
+	// Applied indicates the endpoint was updated with the suggested format.
+	Applied bool `json:"applied"`
+}
+
+// formatV1Directives maps the version 1 directives to their explicit version 2
+// (VCL) equivalents, as version 2 formats are evaluated in vcl_log.
+var formatV1Directives = map[string]string{
+	"%%":  "%%",
+	"%>s": "%{resp.status}V",
+	"%A":  "%{server.ip}V",
+	"%B":  "%{resp.body_bytes_written}V",
+	"%D":  "%{time.elapsed.usec}V",
+	"%H":  "%{req.proto}V",
+	"%T":  "%{time.elapsed.sec}V",
+	"%U":  "%{req.url.path}V",
+	"%a":  "%{req.http.Fastly-Client-IP}V",
+	"%b":  "%{resp.body_bytes_written}V",
+	"%h":  "%{req.http.Fastly-Client-IP}V",
+	"%l":  "-",
+	"%m":  "%{req.method}V",
+	"%q":  `%{if(req.url.qs, "?" req.url.qs, "")}V`,
+	"%r":  "%{req.method}V %{req.url}V %{req.proto}V",
+	"%s":  "%{resp.status}V",
+	"%t":  "%t",
+	"%v":  "%{req.http.host}V",
+}
+
+// formatV2UnavailableVariables are VCL variable prefixes a version 1 format
+// (evaluated in vcl_deliver) may reference that aren't available in vcl_log.
+var formatV2UnavailableVariables = []string{"bereq.", "beresp.", "obj."}
+
+// MigrateFormatV1 translates a version 1 log format into its version 2
+// equivalent. Known directives are replaced by the VCL variables they
+// represent, while anything ambiguous (e.g. an unknown directive) is kept
+// as-is and listed for manual review.
+func MigrateFormatV1(format string) FormatMigration {
+	m := FormatMigration{Original: format}
+	var b strings.Builder
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			b.WriteByte(format[i])
+			i++
+			continue
+		}
+
+		directive, arg, verb := scanFormatDirective(format[i:])
+		i += len(directive)
+
+		if arg == "" {
+			if v2, ok := formatV1Directives[directive]; ok {
+				b.WriteString(v2)
+				continue
+			}
+		}
+
+		switch {
+		case verb == 'i' && arg != "":
+			fmt.Fprintf(&b, "%%{req.http.%s}V", arg)
+		case verb == 'o' && arg != "":
+			fmt.Fprintf(&b, "%%{resp.http.%s}V", arg)
+		case verb == 't' && arg != "":
+			b.WriteString(directive)
+		case verb == 'V' && arg != "":
+			b.WriteString(directive)
+			for _, prefix := range formatV2UnavailableVariables {
+				if strings.Contains(arg, prefix) {
+					m.Review = append(m.Review, fmt.Sprintf("%s references %s variables, which aren't available in vcl_log", directive, strings.TrimSuffix(prefix, ".")))
+					break
+				}
+			}
+		case directive == "%u":
+			b.WriteString(directive)
+			m.Review = append(m.Review, "%u (remote user) has no VCL equivalent: replace it with the header that identifies the user, e.g. %{req.http.Authorization}V")
+		default:
+			b.WriteString(directive)
+			m.Review = append(m.Review, fmt.Sprintf("%s has no known version 2 equivalent", directive))
+		}
+	}
+
+	m.Suggested = b.String()
+	return m
+}
+
+// scanFormatDirective returns the directive at the start of s (which begins
+// with '%'), along with its {argument} and verb (if any).
+func scanFormatDirective(s string) (directive, arg string, verb byte) {
+	i := 1
+	if i < len(s) && (s[i] == '>' || s[i] == '<') {
+		i++
+	}
+	if i < len(s) && s[i] == '{' {
+		end := strings.IndexByte(s[i:], '}')
+		if end == -1 {
+			return s, "", 0 // unterminated argument
+		}
+		arg = s[i+1 : i+end]
+		i += end + 1
+	}
+	if i < len(s) {
+		verb = s[i]
+		i++
+	}
+	return s[:i], arg, verb
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"reflect"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RedactFields replaces the sensitive fields (see text.SensitiveFields) that
+// are set in v, which is the API (or output) representation of an endpoint (a
+// pointer to a struct of pointer fields), with api.Redacted when
+// --redact-output is set.
+//
+// NOTE: An unset (nil or empty) field is left as it is, so the output still
+// shows which fields have a value. Structured output is also redacted by
+// argparser.JSONOutput, so RedactFields is only needed for the text output of
+// a command (which then has a RedactsTextOutput method, otherwise
+// --redact-output requires --json).
+func RedactFields(g *global.Data, v any) {
+	if !g.Flags.RedactOutput {
+		return
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+	rv = rv.Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		if !text.IsSensitiveField(rv.Type().Field(i).Name) {
+			continue
+		}
+		f := rv.Field(i)
+		if !f.CanSet() || f.Kind() != reflect.Pointer || f.IsNil() || f.Elem().Kind() != reflect.String || f.Elem().String() == "" {
+			continue
+		}
+		redacted := api.Redacted
+		f.Set(reflect.ValueOf(&redacted))
+	}
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fastly/kingpin"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/global"
+)
+
+// Region is a valid value for a logging endpoint's region.
+type Region struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// Regions are the valid region values for each logging provider that accepts
+// a fixed set, keyed by the provider's command name (e.g. datadog).
+//
+// NOTE: The Fastly API doesn't expose these values, so this is the source of
+// truth used both to check the --region flag and by `fastly logging
+// regions`. Providers that accept any of their own regions (e.g. kinesis,
+// which accepts any AWS region) aren't listed.
+var Regions = map[string][]Region{
+	"cloudfiles": {
+		{Code: "DFW", Description: "Dallas"},
+		{Code: "ORD", Description: "Chicago"},
+		{Code: "IAD", Description: "Northern Virginia"},
+		{Code: "LON", Description: "London"},
+		{Code: "SYD", Description: "Sydney"},
+		{Code: "HKG", Description: "Hong Kong"},
+	},
+	"datadog": {
+		{Code: "US", Description: "United States (the default)"},
+		{Code: "US3", Description: "United States (US3)"},
+		{Code: "US5", Description: "United States (US5)"},
+		{Code: "EU", Description: "Europe"},
+	},
+	"newrelic": {
+		{Code: "US", Description: "United States (the default)"},
+		{Code: "EU", Description: "Europe"},
+	},
+	"newrelicotlp": {
+		{Code: "US", Description: "United States (the default)"},
+		{Code: "EU", Description: "Europe"},
+	},
+	"scalyr": {
+		{Code: "US", Description: "United States (the default)"},
+		{Code: "EU", Description: "Europe"},
+	},
+}
+
+// RegionProviders returns the providers with a fixed set of regions, sorted.
+func RegionProviders() []string {
+	providers := make([]string, 0, len(Regions))
+	for p := range Regions {
+		providers = append(providers, p)
+	}
+	sort.Strings(providers)
+	return providers
+}
+
+// RegionCodes returns the region codes for the provider.
+func RegionCodes(provider string) []string {
+	codes := make([]string, 0, len(Regions[provider]))
+	for _, r := range Regions[provider] {
+		codes = append(codes, r.Code)
+	}
+	return codes
+}
+
+// LookupRegion returns the region code for the provider matching value
+// (case-insensitively), and whether the provider is known to support it.
+func LookupRegion(provider, value string) (string, bool) {
+	for _, r := range Regions[provider] {
+		if strings.EqualFold(r.Code, value) {
+			return r.Code, true
+		}
+	}
+	return value, false
+}
+
+// RegionFlag defines the region flag for a provider listed in Regions. The
+// value is normalised to the region's code when it's parsed.
+//
+// NOTE: An unknown region is sent to the API as given (with a warning) rather
+// than rejected, as the provider may support regions added since Regions was
+// last updated.
+func RegionFlag(command *kingpin.CmdClause, g *global.Data, provider, description string, c *argparser.OptionalString) {
+	codes := RegionCodes(provider)
+	desc := fmt.Sprintf("%s. One of: %s", description, strings.Join(codes, ", "))
+	command.Flag("region", desc).HintOptions(codes...).Action(func(e *kingpin.ParseElement, ctx *kingpin.ParseContext) error {
+		code, ok := LookupRegion(provider, c.Value)
+		if !ok {
+			g.Warnings = append(g.Warnings, fmt.Sprintf("Unknown region '%s' for %s (known regions: %s), so it's sent to the API as given. Run `fastly logging regions --provider %s` to list the known regions.", c.Value, provider, strings.Join(codes, ", "), provider))
+		}
+		c.Value = code
+		return c.Set(e, ctx)
+	}).StringVar(&c.Value)
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// RenameOutput is the structured (--json) result of a logging `rename`
+// command.
+type RenameOutput struct {
+	NewName        string `json:"new_name"`
+	OldName        string `json:"old_name"`
+	ServiceID      string `json:"service_id"`
+	ServiceVersion int    `json:"service_version"`
+}
+
+// RenameCollision returns the error reported by a logging `rename` (or
+// `clone`) command when the service version already has an endpoint of the
+// provider called newName.
+func RenameCollision(provider, newName string, version int) error {
+	return fsterr.RemediationError{
+		Inner:       fmt.Errorf("a %s logging endpoint named '%s' already exists on service version %d", provider, newName, version),
+		Remediation: "Choose a different value for --new-name, or delete the existing endpoint first.",
+	}
+}
+
+// IsNotFound reports whether err is an API error for a resource that doesn't
+// exist.
+func IsNotFound(err error) bool {
+	var httpErr *fastly.HTTPError
+	return errors.As(err, &httpErr) && httpErr.IsNotFound()
+}
+
+// IsConflict reports whether err is an API error for a request that conflicts
+// with an existing resource (e.g. a duplicate name).
+func IsConflict(err error) bool {
+	var httpErr *fastly.HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusConflict
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"fmt"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// TestNotSupported returns the error reported by a logging `test` command when
+// the Fastly API has no operation to validate delivery to the provider.
+func TestNotSupported(provider, name string) error {
+	return fsterr.RemediationError{
+		Inner:       fmt.Errorf("testing log delivery is not supported for this provider (%s): the Fastly API has no test operation for the '%s' endpoint", provider, name),
+		Remediation: "Check the endpoint is on the active service version (see `fastly service-version list`), send a request to the service and confirm the logs arrive at the provider.",
+	}
+}
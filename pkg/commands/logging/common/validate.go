@@ -0,0 +1,78 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RequiredFields are the fields of each logging provider's API type (e.g.
+// fastly.Cloudfiles) that the API always populates, keyed by the provider's
+// command name (e.g. cloudfiles).
+//
+// NOTE: The commands display a nil field as its zero value, so when the API
+// unexpectedly omits one of these the output is silently wrong. They're
+// checked when --validate-responses is set (see WarnMissingFields).
+var RequiredFields = map[string][]string{
+	"cloudfiles": {
+		"BucketName",
+		"Format",
+		"FormatVersion",
+		"MessageType",
+		"Name",
+		"Period",
+		"ServiceID",
+		"ServiceVersion",
+		"User",
+	},
+}
+
+// MissingFields returns the RequiredFields of the provider that are nil in v,
+// which is the API representation of one of its endpoints (a pointer to a
+// struct of pointer fields).
+//
+// NOTE: A required field the type doesn't have is reported as missing, so a
+// renamed field isn't silently ignored.
+func MissingFields(provider string, v any) []string {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	var missing []string
+	for _, name := range RequiredFields[provider] {
+		f := rv.FieldByName(name)
+		if !f.IsValid() || (f.Kind() == reflect.Pointer && f.IsNil()) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// WarnMissingFields warns about the RequiredFields of the provider that are
+// nil in the API response v for the named endpoint (see MissingFields), when
+// --validate-responses is set.
+func WarnMissingFields(g *global.Data, out io.Writer, provider, name string, v any) {
+	if !g.Flags.ValidateResponses {
+		return
+	}
+	missing := MissingFields(provider, v)
+	g.Tracer.Record("response validation", time.Now(), 0, map[string]any{
+		"provider": provider,
+		"name":     name,
+		"missing":  missing,
+	}, nil)
+	if len(missing) == 0 {
+		return
+	}
+	msg := fmt.Sprintf("The API response for the %s logging endpoint '%s' is missing expected fields (displayed as empty values): %s.", provider, name, strings.Join(missing, ", "))
+	if g.Flags.Quiet {
+		text.Warnings.Add(msg)
+		return
+	}
+	text.Warning(out, "%s", msg)
+}
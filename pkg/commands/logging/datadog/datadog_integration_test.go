@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/fastly/go-fastly/v9/fastly"
+	"github.com/fatih/color"
 
 	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/global"
@@ -41,6 +42,20 @@ func TestDatadogCreate(t *testing.T) {
 			},
 			wantError: errTest.Error(),
 		},
+		{
+			args: args("logging datadog create --service-id 123 --version 1 --name log --auth-token abc --autoclone --region eu"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateDatadogFn: func(i *fastly.CreateDatadogInput) (*fastly.Datadog, error) {
+					if i.Region == nil || *i.Region != "EU" {
+						return nil, errTest
+					}
+					return createDatadogOK(i)
+				},
+			},
+			wantOutput: "Created Datadog logging endpoint log (service 123 version 4)",
+		},
 	}
 	for testcaseIdx := range scenarios {
 		testcase := &scenarios[testcaseIdx]
@@ -58,6 +73,37 @@ func TestDatadogCreate(t *testing.T) {
 	}
 }
 
+// TestDatadogCreateUnknownRegion validates an unknown region is sent to the API
+// as given, with a warning on stderr.
+func TestDatadogCreateUnknownRegion(t *testing.T) {
+	originalStderr := color.Error
+	defer func() {
+		color.Error = originalStderr
+	}()
+	var stdout, stderr bytes.Buffer
+	color.Error = &stderr
+
+	args := testutil.SplitArgs("logging datadog create --service-id 123 --version 1 --name log --auth-token abc --autoclone --region AP1")
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		opts := testutil.MockGlobalData(args, &stdout)
+		opts.APIClientFactory = mock.APIClient(mock.API{
+			ListVersionsFn: testutil.ListVersions,
+			CloneVersionFn: testutil.CloneVersionResult(4),
+			CreateDatadogFn: func(i *fastly.CreateDatadogInput) (*fastly.Datadog, error) {
+				if i.Region == nil || *i.Region != "AP1" {
+					return nil, errTest
+				}
+				return createDatadogOK(i)
+			},
+		})
+		return opts, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout.String(), "Created Datadog logging endpoint log (service 123 version 4)")
+	testutil.AssertStringContains(t, stderr.String(), "Unknown region 'AP1' for datadog (known regions: US, US3, US5, EU)")
+}
+
 func TestDatadogList(t *testing.T) {
 	args := testutil.SplitArgs
 	scenarios := []struct {
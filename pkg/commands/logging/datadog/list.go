@@ -15,7 +15,9 @@ import (
 // ListCommand calls the Fastly API to list Datadog logging endpoints.
 type ListCommand struct {
 	argparser.Base
+	argparser.CountOutput
 	argparser.JSONOutput
+	argparser.ListFilter
 
 	Input          fastly.ListDatadogInput
 	serviceName    argparser.OptionalServiceNameID
@@ -40,7 +42,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	})
 
 	// Optional.
-	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlagBool(c.CountFlag()) // --count-only
+	c.RegisterFlag(c.FilterFlag())    // --filter
+	c.RegisterFlagBool(c.JSONFlag())  // --json
+	c.RegisterFieldFlag(c.CmdClause)  // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -87,7 +92,16 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	o, err = argparser.ApplyFilter(c.ListFilter, o)
+	if err != nil {
+		return err
+	}
+
+	if ok, err := c.WriteCount(out, len(o), c.JSONOutput); ok {
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -17,6 +17,8 @@ import (
 // UpdateCommand calls the Fastly API to update a Datadog logging endpoint.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -63,7 +65,7 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	common.FormatVersion(c.CmdClause, &c.FormatVersion)
 	c.CmdClause.Flag("new-name", "New name of the Datadog logging object").Action(c.NewName.Set).StringVar(&c.NewName.Value)
 	common.Placement(c.CmdClause, &c.Placement)
-	c.CmdClause.Flag("region", "The region that log data will be sent to. One of US, US3, US5, or EU. Defaults to US if undefined").Action(c.Region.Set).StringVar(&c.Region.Value)
+	common.RegionFlag(c.CmdClause, c.Globals, "datadog", "The region that log data will be sent to. Defaults to US if undefined", &c.Region)
 	common.ResponseCondition(c.CmdClause, &c.ResponseCondition)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
@@ -77,6 +79,9 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 		Description: argparser.FlagServiceNameDesc,
 		Dst:         &c.ServiceName.Value,
 	})
+	c.RegisterFlagBool(c.JSONFlag())    // --json
+	c.RegisterFieldFlag(c.CmdClause)    // --field
+	c.RegisterChangesFlags(c.CmdClause) // --show-changes, --diff-context
 	return &c
 }
 
@@ -121,6 +126,10 @@ func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -146,17 +155,36 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetDatadog(&fastly.GetDatadogInput{
+			Name:           c.EndpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	datadog, err := c.Globals.APIClient.UpdateDatadog(input)
 	if err != nil {
 		c.Globals.ErrLog.Add(err)
 		return err
 	}
 
+	changes := argparser.Changes(before, datadog)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
 	text.Success(out,
 		"Updated Datadog logging endpoint %s (service %s version %d)",
 		fastly.ToValue(datadog.Name),
 		fastly.ToValue(datadog.ServiceID),
 		fastly.ToValue(datadog.ServiceVersion),
 	)
+	c.DisplayChanges(out, changes)
 	return nil
 }
@@ -17,6 +17,8 @@ import (
 // UpdateCommand calls the Fastly API to update a DigitalOcean Spaces logging endpoint.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -95,6 +97,9 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 		Dst:         &c.ServiceName.Value,
 	})
 	common.TimestampFormat(c.CmdClause, &c.TimestampFormat)
+	c.RegisterFlagBool(c.JSONFlag())    // --json
+	c.RegisterFieldFlag(c.CmdClause)    // --field
+	c.RegisterChangesFlags(c.CmdClause) // --show-changes, --diff-context
 	return &c
 }
 
@@ -176,6 +181,10 @@ func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -201,17 +210,36 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetDigitalOcean(&fastly.GetDigitalOceanInput{
+			Name:           c.EndpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	digitalocean, err := c.Globals.APIClient.UpdateDigitalOcean(input)
 	if err != nil {
 		c.Globals.ErrLog.Add(err)
 		return err
 	}
 
+	changes := argparser.Changes(before, digitalocean)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
 	text.Success(out,
 		"Updated DigitalOcean Spaces logging endpoint %s (service %s version %d)",
 		fastly.ToValue(digitalocean.Name),
 		fastly.ToValue(digitalocean.ServiceID),
 		fastly.ToValue(digitalocean.ServiceVersion),
 	)
+	c.DisplayChanges(out, changes)
 	return nil
 }
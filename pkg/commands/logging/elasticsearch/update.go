@@ -17,6 +17,8 @@ import (
 // UpdateCommand calls the Fastly API to update an Elasticsearch logging endpoint.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -93,6 +95,9 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	common.TLSClientKey(c.CmdClause, &c.TLSClientKey)
 	common.TLSHostname(c.CmdClause, &c.TLSHostname)
 	c.CmdClause.Flag("url", "The URL to stream logs to. Must use HTTPS.").Action(c.URL.Set).StringVar(&c.URL.Value)
+	c.RegisterFlagBool(c.JSONFlag())    // --json
+	c.RegisterFieldFlag(c.CmdClause)    // --field
+	c.RegisterChangesFlags(c.CmdClause) // --show-changes, --diff-context
 	return &c
 }
 
@@ -173,6 +178,10 @@ func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -198,17 +207,36 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetElasticsearch(&fastly.GetElasticsearchInput{
+			Name:           c.EndpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	elasticsearch, err := c.Globals.APIClient.UpdateElasticsearch(input)
 	if err != nil {
 		c.Globals.ErrLog.Add(err)
 		return err
 	}
 
+	changes := argparser.Changes(before, elasticsearch)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
 	text.Success(out,
 		"Updated Elasticsearch logging endpoint %s (service %s version %d)",
 		fastly.ToValue(elasticsearch.Name),
 		fastly.ToValue(elasticsearch.ServiceID),
 		fastly.ToValue(elasticsearch.ServiceVersion),
 	)
+	c.DisplayChanges(out, changes)
 	return nil
 }
@@ -17,6 +17,8 @@ import (
 // UpdateCommand calls the Fastly API to update an FTP logging endpoint.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 	Manifest manifest.Data
 
 	// Required.
@@ -93,6 +95,9 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	})
 	common.TimestampFormat(c.CmdClause, &c.TimestampFormat)
 	c.CmdClause.Flag("username", "The username for the server (can be anonymous)").Action(c.Username.Set).StringVar(&c.Username.Value)
+	c.RegisterFlagBool(c.JSONFlag())    // --json
+	c.RegisterFieldFlag(c.CmdClause)    // --field
+	c.RegisterChangesFlags(c.CmdClause) // --show-changes, --diff-context
 	return &c
 }
 
@@ -170,6 +175,10 @@ func (c *UpdateCommand) ConstructInput(serviceID string, serviceVersion int) (*f
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -195,17 +204,36 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetFTP(&fastly.GetFTPInput{
+			Name:           c.EndpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	ftp, err := c.Globals.APIClient.UpdateFTP(input)
 	if err != nil {
 		c.Globals.ErrLog.Add(err)
 		return err
 	}
 
+	changes := argparser.Changes(before, ftp)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
 	text.Success(out,
 		"Updated FTP logging endpoint %s (service %s version %d)",
 		fastly.ToValue(ftp.Name),
 		fastly.ToValue(ftp.ServiceID),
 		fastly.ToValue(ftp.ServiceVersion),
 	)
+	c.DisplayChanges(out, changes)
 	return nil
 }
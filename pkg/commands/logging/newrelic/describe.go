@@ -1,6 +1,7 @@
 package newrelic
 
 import (
+	"context"
 	"io"
 
 	"github.com/fastly/go-fastly/v9/fastly"
@@ -21,7 +22,7 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 	c.CmdClause = parent.Command("describe", "Get the details of a New Relic Logs logging object for a particular service and version").Alias("get")
 
 	// Required.
-	c.CmdClause.Flag("name", "The name for the real-time logging configuration").Required().StringVar(&c.name)
+	c.CmdClause.Flag("name", "The name for the real-time logging configuration (repeat to describe multiple)").Required().StringsVar(&c.names)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagVersionName,
 		Description: argparser.FlagVersionDesc,
@@ -31,6 +32,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -44,6 +47,7 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 		Dst:         &c.serviceName.Value,
 	})
 
+	c.RegisterWatchFlags(c.CmdClause) // --watch, --interval
 	return &c
 }
 
@@ -51,8 +55,9 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 type DescribeCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.WatchOutput
 
-	name           string
+	names          []string
 	serviceName    argparser.OptionalServiceNameID
 	serviceVersion argparser.OptionalServiceVersion
 }
@@ -79,29 +84,45 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	input := c.constructInput(serviceID, fastly.ToValue(serviceVersion.Number))
-
-	o, err := c.Globals.APIClient.GetNewRelic(input)
-	if err != nil {
-		c.Globals.ErrLog.AddWithContext(err, map[string]any{
-			"Service ID":      serviceID,
-			"Service Version": fastly.ToValue(serviceVersion.Number),
-		})
-		return err
-	}
-
-	if ok, err := c.WriteJSON(out, o); ok {
-		return err
-	}
-
-	return c.print(out, o)
+	return c.Watch(context.Background(), out, &c.JSONOutput, func(out io.Writer) error {
+		if len(c.names) > 1 {
+			return argparser.DescribeMany(out, c.names, c.JSONOutput, func(name string) (*fastly.NewRelic, error) {
+				o, err := c.Globals.APIClient.GetNewRelic(c.constructInput(name, serviceID, fastly.ToValue(serviceVersion.Number)))
+				if err != nil {
+					c.Globals.ErrLog.AddWithContext(err, map[string]any{
+						"Name":            name,
+						"Service ID":      serviceID,
+						"Service Version": fastly.ToValue(serviceVersion.Number),
+					})
+				}
+				return o, err
+			}, c.print)
+		}
+
+		input := c.constructInput(c.names[0], serviceID, fastly.ToValue(serviceVersion.Number))
+
+		o, err := c.Globals.APIClient.GetNewRelic(input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": fastly.ToValue(serviceVersion.Number),
+			})
+			return err
+		}
+
+		if ok, err := c.WriteOutput(out, o); ok {
+			return err
+		}
+
+		return c.print(out, o)
+	})
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
-func (c *DescribeCommand) constructInput(serviceID string, serviceVersion int) *fastly.GetNewRelicInput {
+func (c *DescribeCommand) constructInput(name, serviceID string, serviceVersion int) *fastly.GetNewRelicInput {
 	var input fastly.GetNewRelicInput
 
-	input.Name = c.name
+	input.Name = name
 	input.ServiceID = serviceID
 	input.ServiceVersion = serviceVersion
 
@@ -30,7 +30,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	})
 
 	// Optional.
-	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlagBool(c.CountFlag()) // --count-only
+	c.RegisterFlag(c.FilterFlag())    // --filter
+	c.RegisterFlagBool(c.JSONFlag())  // --json
+	c.RegisterFieldFlag(c.CmdClause)  // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -50,7 +53,9 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 // ListCommand calls the Fastly API to list appropriate resources.
 type ListCommand struct {
 	argparser.Base
+	argparser.CountOutput
 	argparser.JSONOutput
+	argparser.ListFilter
 
 	serviceName    argparser.OptionalServiceNameID
 	serviceVersion argparser.OptionalServiceVersion
@@ -89,7 +94,16 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	o, err = argparser.ApplyFilter(c.ListFilter, o)
+	if err != nil {
+		return err
+	}
+
+	if ok, err := c.WriteCount(out, len(o), c.JSONOutput); ok {
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -129,13 +143,13 @@ func (c *ListCommand) printVerbose(out io.Writer, serviceVersion int, ls []*fast
 		fmt.Fprintf(out, "\nResponse Condition: %s\n\n", fastly.ToValue(l.ResponseCondition))
 
 		if l.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", l.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *l.CreatedAt))
 		}
 		if l.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", l.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *l.UpdatedAt))
 		}
 		if l.DeletedAt != nil {
-			fmt.Fprintf(out, "Deleted at: %s\n", l.DeletedAt)
+			fmt.Fprintf(out, "Deleted at: %s\n", text.FormatTime(out, *l.DeletedAt))
 		}
 	}
 }
@@ -17,6 +17,8 @@ import (
 // UpdateCommand calls the Fastly API to update an appropriate resource.
 type UpdateCommand struct {
 	argparser.Base
+	argparser.ChangesOutput
+	argparser.JSONOutput
 
 	endpointName   string
 	serviceName    argparser.OptionalServiceNameID
@@ -60,7 +62,7 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 	c.CmdClause.Flag("key", "The Insert API key from the Account page of your New Relic account").Action(c.key.Set).StringVar(&c.key.Value)
 	c.CmdClause.Flag("new-name", "The name for the real-time logging configuration").Action(c.newName.Set).StringVar(&c.newName.Value)
 	c.CmdClause.Flag("placement", "Where in the generated VCL the logging call should be placed").Action(c.placement.Set).StringVar(&c.placement.Value)
-	c.CmdClause.Flag("region", "The region to which to stream logs").Action(c.region.Set).StringVar(&c.region.Value)
+	common.RegionFlag(c.CmdClause, c.Globals, "newrelic", "The region to which to stream logs", &c.region)
 	c.CmdClause.Flag("response-condition", "The name of an existing condition in the configured endpoint").Action(c.responseCondition.Set).StringVar(&c.responseCondition.Value)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
@@ -75,11 +77,18 @@ func NewUpdateCommand(parent argparser.Registerer, g *global.Data) *UpdateComman
 		Dst:         &c.serviceName.Value,
 	})
 
+	c.RegisterFlagBool(c.JSONFlag())    // --json
+	c.RegisterFieldFlag(c.CmdClause)    // --field
+	c.RegisterChangesFlags(c.CmdClause) // --show-changes, --diff-context
 	return &c
 }
 
 // Exec invokes the application logic for the command.
 func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return errors.ErrInvalidVerboseJSONCombo
+	}
+
 	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
 		Active:             optional.Of(false),
 		Locked:             optional.Of(false),
@@ -101,6 +110,19 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 
 	input := c.constructInput(serviceID, fastly.ToValue(serviceVersion.Number))
 
+	var before any
+	if c.ChangesRequested(c.JSONOutput) {
+		before, err = c.Globals.APIClient.GetNewRelic(&fastly.GetNewRelicInput{
+			Name:           c.endpointName,
+			ServiceID:      serviceID,
+			ServiceVersion: fastly.ToValue(serviceVersion.Number),
+		})
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
 	l, err := c.Globals.APIClient.UpdateNewRelic(input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -115,6 +137,11 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		prev = fmt.Sprintf("previously: %s, ", c.endpointName)
 	}
 
+	changes := argparser.Changes(before, l)
+	if ok, err := c.WriteOutput(out, changes); ok {
+		return err
+	}
+
 	text.Success(out,
 		"Updated New Relic logging endpoint '%s' (%sservice: %s, version: %d)",
 		fastly.ToValue(l.Name),
@@ -122,6 +149,7 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
 		fastly.ToValue(l.ServiceID),
 		fastly.ToValue(l.ServiceVersion),
 	)
+	c.DisplayChanges(out, changes)
 	return nil
 }
 
@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/commands/logging/common"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RegionsCommand lists the valid --region values for the logging providers.
+type RegionsCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	provider string
+}
+
+// providerRegion is a region along with the provider it belongs to.
+type providerRegion struct {
+	Provider    string `json:"provider"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// NewRegionsCommand returns a usable command registered under the parent.
+func NewRegionsCommand(parent argparser.Registerer, g *global.Data) *RegionsCommand {
+	var c RegionsCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("regions", "List the valid --region values for logging endpoints")
+
+	// Optional.
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	providers := common.RegionProviders()
+	c.CmdClause.Flag("provider", "Only list the regions for this logging provider").HintOptions(providers...).EnumVar(&c.provider, providers...)
+
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *RegionsCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	providers := common.RegionProviders()
+	if c.provider != "" {
+		providers = []string{c.provider}
+	}
+
+	regions := []providerRegion{}
+	for _, p := range providers {
+		for _, r := range common.Regions[p] {
+			regions = append(regions, providerRegion{Provider: p, Code: r.Code, Description: r.Description})
+		}
+	}
+
+	if ok, err := c.WriteOutput(out, regions); ok {
+		return err
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("PROVIDER", "CODE", "DESCRIPTION")
+	for _, r := range regions {
+		tw.AddLine(r.Provider, r.Code, r.Description)
+	}
+	tw.Print()
+	return nil
+}
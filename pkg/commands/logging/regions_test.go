@@ -0,0 +1,37 @@
+package logging_test
+
+import (
+	"testing"
+
+	root "github.com/fastly/cli/pkg/commands/logging"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestRegions(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Args: "",
+			WantOutputs: []string{
+				"PROVIDER      CODE  DESCRIPTION",
+				"cloudfiles    ORD   Chicago",
+				"datadog       US5   United States (US5)",
+				"scalyr        EU    Europe",
+			},
+		},
+		{
+			Args:           "--provider scalyr",
+			WantOutput:     "scalyr    EU    Europe",
+			DontWantOutput: "datadog",
+		},
+		{
+			Args:       "--provider scalyr --json",
+			WantOutput: `"code": "US",`,
+		},
+		{
+			Args:      "--provider kinesis",
+			WantError: "enum value must be one of cloudfiles,datadog,newrelic,newrelicotlp,scalyr, got 'kinesis'",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "regions"}, scenarios)
+}
@@ -132,6 +132,22 @@ func TestS3List(t *testing.T) {
 			},
 			wantOutput: listS3sShortOutput,
 		},
+		{
+			args: args("logging s3 list --service-id 123 --version 1 --count-only"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListS3sFn:      listS3sOK,
+			},
+			wantOutput: "2\n",
+		},
+		{
+			args: args("logging s3 list --service-id 123 --version 1 --count-only --json --json-compact"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListS3sFn:      listS3sOK,
+			},
+			wantOutput: "{\"count\":2}\n",
+		},
 		{
 			args: args("logging s3 list --service-id 123 --version 1 --verbose"),
 			api: mock.API{
@@ -217,6 +233,28 @@ func TestS3Describe(t *testing.T) {
 			},
 			wantOutput: describeS3Output,
 		},
+		{
+			args: args("logging s3 describe --service-id 123 --version 1 --name logs --name logs"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetS3Fn:        getS3OK,
+			},
+			wantOutput: describeS3Output + describeS3Output,
+		},
+		{
+			args: args("logging s3 describe --service-id 123 --version 1 --name logs --name missing"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetS3Fn: func(i *fastly.GetS3Input) (*fastly.S3, error) {
+					if i.Name == "missing" {
+						return nil, errTest
+					}
+					return getS3OK(i)
+				},
+			},
+			wantError:  "failed to describe 1 of 2 resources: missing (" + errTest.Error() + ")",
+			wantOutput: describeS3Output,
+		},
 	}
 	for testcaseIdx := range scenarios {
 		testcase := &scenarios[testcaseIdx]
@@ -273,6 +311,55 @@ func TestS3Update(t *testing.T) {
 			},
 			wantOutput: "Updated S3 logging endpoint log (service 123 version 4)",
 		},
+		{
+			args: args("logging s3 update --service-id 123 --version 1 --name logs --new-name log --autoclone --show-changes"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				GetS3Fn:        getS3OK,
+				UpdateS3Fn:     updateS3OK,
+			},
+			wantOutput: "Updated S3 logging endpoint log (service 123 version 4)\n\nFIELD  OLD   NEW\nname   logs  log\n",
+		},
+		{
+			args: args("logging s3 update --service-id 123 --version 1 --name logs --new-name log --autoclone --json --json-compact"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				GetS3Fn:        getS3OK,
+				UpdateS3Fn:     updateS3OK,
+			},
+			wantOutput: `{"changed":{"name":{"old":"logs","new":"log"}}}`,
+		},
+		{
+			args: args("logging s3 update --service-id 123 --version 1 --name logs --new-name logs --autoclone --show-changes"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				GetS3Fn:        getS3OK,
+				UpdateS3Fn:     getS3OKAsUpdate,
+			},
+			wantOutput: "No fields were changed (the values provided match the existing values).",
+		},
+		{
+			args: args("logging s3 update --service-id 123 --version 1 --name logs --new-name logs --autoclone --show-changes --diff-context full"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				GetS3Fn:        getS3OK,
+				UpdateS3Fn:     getS3OKAsUpdate,
+			},
+			wantOutput: "secret_key                         REDACTED                                                      REDACTED\n",
+		},
+		{
+			args: args("logging s3 update --service-id 123 --version 1 --name logs --new-name log --autoclone --show-changes"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				GetS3Fn:        getS3Error,
+			},
+			wantError: errTest.Error(),
+		},
 	}
 	for testcaseIdx := range scenarios {
 		testcase := &scenarios[testcaseIdx]
@@ -545,6 +632,12 @@ func updateS3OK(i *fastly.UpdateS3Input) (*fastly.S3, error) {
 	}, nil
 }
 
+// getS3OKAsUpdate returns the resource unchanged, as if the update was given
+// its existing values.
+func getS3OKAsUpdate(i *fastly.UpdateS3Input) (*fastly.S3, error) {
+	return getS3OK(&fastly.GetS3Input{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+}
+
 func updateS3Error(_ *fastly.UpdateS3Input) (*fastly.S3, error) {
 	return nil, errTest
 }
@@ -21,7 +21,9 @@ import (
 	"github.com/tomnomnom/linkheader"
 
 	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/backoff"
 	"github.com/fastly/cli/pkg/debug"
+	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
 )
@@ -146,6 +148,15 @@ func (c *RootCommand) tail(out io.Writer) error {
 	// re-request on failure.
 	var lastBatchID string
 
+	// failures counts the consecutive retryable responses so the delay between
+	// re-requests grows while the server has trouble.
+	var failures int
+	retry := backoff.ExponentialBackoff{
+		Base:   1 * time.Second,
+		Max:    30 * time.Second,
+		Jitter: 0.5,
+	}
+
 	for {
 		// Check to see if we already passed the "to" requirement.
 		if toWindow != 0 && curWindow > toWindow {
@@ -167,6 +178,20 @@ func (c *RootCommand) tail(out io.Writer) error {
 		resp, err := c.doReq(req)
 		if err != nil {
 			c.Globals.ErrLog.Add(err)
+			// Try the request again after waiting if it failed transiently
+			// (e.g. the connection was refused or timed out).
+			if fsterr.IsRetryable(err) {
+				delay := retry.Delay(failures)
+				if !c.Globals.RetryBudget.Reserve(delay) {
+					return backoff.BudgetExhaustedError{
+						Err:   fmt.Errorf("unable to execute request: %w", err),
+						Limit: c.Globals.RetryBudget.Limit,
+					}
+				}
+				failures++
+				time.Sleep(delay)
+				continue
+			}
 			return fmt.Errorf("unable to execute request: %w", err)
 		}
 
@@ -194,16 +219,29 @@ func (c *RootCommand) tail(out io.Writer) error {
 				c.Globals.ErrLog.Add(err)
 			}
 
-			// Try the response again after a 1 second wait.
+			// Try the response again after waiting, respecting any Retry-After
+			// delay requested by the server.
 			if resp.StatusCode/100 == 5 && resp.StatusCode != 501 ||
 				resp.StatusCode == http.StatusTooManyRequests {
-				time.Sleep(1 * time.Second)
+				delay := retry.Delay(failures)
+				if after, ok := backoff.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok && after > delay {
+					delay = after
+				}
+				if !c.Globals.RetryBudget.Reserve(delay) {
+					return backoff.BudgetExhaustedError{
+						Err:   fmt.Errorf("response code: %d", resp.StatusCode),
+						Limit: c.Globals.RetryBudget.Limit,
+					}
+				}
+				failures++
+				time.Sleep(delay)
 				continue
 			}
 
 			// Failing at this point is unrecoverable.
 			return fmt.Errorf("unrecoverable error, response code: %d", resp.StatusCode)
 		}
+		failures = 0
 
 		// Read and parse response, send batches to the output loop.
 		scanner := bufio.NewScanner(resp.Body)
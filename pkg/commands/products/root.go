@@ -49,6 +49,7 @@ func NewRootCommand(parent argparser.Registerer, g *global.Data) *RootCommand {
 	c.CmdClause.Flag("disable", "Disable product").HintOptions(ProductEnablementOptions...).EnumVar(&c.disableProduct, ProductEnablementOptions...)
 	c.CmdClause.Flag("enable", "Enable product").HintOptions(ProductEnablementOptions...).EnumVar(&c.enableProduct, ProductEnablementOptions...)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -162,7 +163,7 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
 		ps.WebSockets = true
 	}
 
-	if ok, err := c.WriteJSON(out, ps); ok {
+	if ok, err := c.WriteOutput(out, ps); ok {
 		return err
 	}
 
@@ -0,0 +1,127 @@
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/filesystem"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/profile"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ExportFilePermissions is the file mode of a profile export.
+const ExportFilePermissions = 0o600
+
+// ExportCommand represents a Kingpin command.
+type ExportCommand struct {
+	argparser.Base
+
+	encrypt      bool
+	file         string
+	includeToken bool
+	profile      string
+}
+
+// NewExportCommand returns a new command registered in the parent.
+func NewExportCommand(parent argparser.Registerer, g *global.Data) *ExportCommand {
+	var c ExportCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("export", "Export a user profile to a file, to be imported on another machine (the token is excluded unless --encrypt or --include-token is set)")
+	c.CmdClause.Arg("profile", "Profile to export").Required().StringVar(&c.profile)
+	c.CmdClause.Flag("encrypt", "Include the token, encrypted with a passphrase (prompted for)").BoolVar(&c.encrypt)
+	c.CmdClause.Flag("file", "Path of the file to write the profile to").Short('f').Required().StringVar(&c.file)
+	c.CmdClause.Flag("include-token", "Include the token unencrypted (anyone with access to the file can use it). Can't be combined with --encrypt").BoolVar(&c.includeToken)
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *ExportCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.encrypt && c.includeToken {
+		return fsterr.RemediationError{
+			Inner:       errors.New("the --encrypt flag is mutually exclusive with the --include-token flag"),
+			Remediation: "Pass --encrypt to include the token encrypted with a passphrase, or --include-token to include it unencrypted.",
+		}
+	}
+	p := profile.Get(c.profile, c.Globals.Config.Profiles)
+	if p == nil {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf(profile.DoesNotExist, c.profile),
+			Remediation: fsterr.ProfileRemediation,
+		}
+	}
+	if err := filesystem.CheckClobber(c.file); err != nil {
+		return err
+	}
+
+	e := profile.NewExport(c.profile, p)
+	switch {
+	case c.encrypt:
+		passphrase, err := promptForNewPassphrase(in, out)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		if err := e.IncludeCredentials(p, passphrase); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	case c.includeToken:
+		text.Warning(out, "The token of profile '%s' is written unencrypted to %s. Anyone with access to the file can use it, so delete it once it has been imported (or use --encrypt instead).", c.profile, c.file)
+		text.Break(out)
+		if err := e.IncludeCredentials(p, ""); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding profile: %w", err)
+	}
+	if err := filesystem.WriteFile(c.file, append(data, '\n'), ExportFilePermissions); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"File": c.file,
+		})
+		return fmt.Errorf("error writing profile export: %w", err)
+	}
+
+	text.Success(out, "Profile '%s' exported to %s", c.profile, c.file)
+	if !c.encrypt && !c.includeToken {
+		text.Info(out, "The token wasn't exported. Run `fastly profile update %s` after importing the profile to set one.", c.profile)
+	}
+	return nil
+}
+
+// ErrPassphraseMismatch is returned when the confirmation of a new passphrase
+// doesn't match.
+var ErrPassphraseMismatch = errors.New("the passphrases don't match")
+
+// ErrEmptyPassphrase is returned when an empty passphrase is given.
+var ErrEmptyPassphrase = errors.New("passphrase cannot be empty")
+
+func promptForNewPassphrase(in io.Reader, out io.Writer) (string, error) {
+	passphrase, err := text.InputSecure(out, text.Prompt("Passphrase: "), in, validatePassphraseNotEmpty)
+	if err != nil {
+		return "", err
+	}
+	confirm, err := text.InputSecure(out, text.Prompt("Confirm passphrase: "), in)
+	if err != nil {
+		return "", err
+	}
+	if passphrase != confirm {
+		return "", ErrPassphraseMismatch
+	}
+	text.Break(out)
+	return passphrase, nil
+}
+
+func validatePassphraseNotEmpty(s string) error {
+	if s == "" {
+		return ErrEmptyPassphrase
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/profile"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ImportCommand represents a Kingpin command.
+type ImportCommand struct {
+	argparser.Base
+
+	file    string
+	profile string
+}
+
+// NewImportCommand returns a new command registered in the parent.
+func NewImportCommand(parent argparser.Registerer, g *global.Data) *ImportCommand {
+	var c ImportCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("import", "Import a user profile from a file written by `fastly profile export`")
+	c.CmdClause.Arg("profile", "Name of the imported profile (defaults to the name it was exported with)").StringVar(&c.profile)
+	c.CmdClause.Flag("file", "Path of the file to read the profile from").Short('f').Required().StringVar(&c.file)
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *ImportCommand) Exec(in io.Reader, out io.Writer) error {
+	data, err := os.ReadFile(c.file)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"File": c.file,
+		})
+		return fmt.Errorf("error reading profile export: %w", err)
+	}
+	var e profile.Export
+	if err := json.Unmarshal(data, &e); err != nil {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("error parsing profile export: %w", err),
+			Remediation: "Check the file was written by `fastly profile export`.",
+		}
+	}
+
+	name := c.profile
+	if name == "" {
+		name = e.Name
+	}
+	if profile.Exist(name, c.Globals.Config.Profiles) {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("profile '%s' already exists", name),
+			Remediation: "Re-run the command and pass a different value for the 'profile' argument.",
+		}
+	}
+
+	var passphrase string
+	if e.EncryptedCredentials != nil {
+		passphrase, err = text.InputSecure(out, text.Prompt("Passphrase: "), in)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		text.Break(out)
+	}
+	p, err := e.Profile(passphrase)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error importing profile: %w", err)
+	}
+
+	// NOTE: The imported profile only becomes the default if there's no other.
+	if _, d := profile.Default(c.Globals.Config.Profiles); d == nil {
+		p.Default = true
+	}
+	if c.Globals.Config.Profiles == nil {
+		c.Globals.Config.Profiles = make(config.Profiles)
+	}
+	c.Globals.Config.Profiles[name] = p
+	if err := c.Globals.Config.Write(c.Globals.ConfigPath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error saving config file: %w", err)
+	}
+
+	text.Success(out, "Profile '%s' imported", name)
+	if p.Token == "" {
+		text.Info(out, "The export didn't include a token. Run `fastly profile update %s` to set one.", name)
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	c.Globals = g
 	c.CmdClause = parent.Command("list", "List user profiles")
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	return &c
 }
 
@@ -34,7 +35,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	if ok, err := c.WriteJSON(out, c.Globals.Config.Profiles); ok {
+	if ok, err := c.WriteOutput(out, c.Globals.Config.Profiles); ok {
 		return err
 	}
 
@@ -2,7 +2,10 @@ package profile_test
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,8 +13,10 @@ import (
 
 	root "github.com/fastly/cli/pkg/commands/profile"
 	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/threadsafe"
 	fsttime "github.com/fastly/cli/pkg/time"
 )
 
@@ -737,3 +742,191 @@ func getUser(i *fastly.GetUserInput) (*fastly.User, error) {
 		UpdatedAt:              &t,
 	}, nil
 }
+
+func TestProfileExportImport(t *testing.T) {
+	dir := t.TempDir()
+	plainFile := filepath.Join(dir, "plain.json")
+	tokenFile := filepath.Join(dir, "token.json")
+	encryptedFile := filepath.Join(dir, "encrypted.json")
+	craftedFile := filepath.Join(dir, "crafted.json")
+	crafted := `{"format": "fastly-cli-profile", "version": 1, "name": "foo", "encrypted_credentials": {"kdf": "scrypt", "n": 1073741824, "r": 8, "p": 1, "salt": "AAAA", "nonce": "AAAA", "ciphertext": "AAAA"}}`
+	if err := os.WriteFile(craftedFile, []byte(crafted), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	env := func() *testutil.EnvConfig {
+		return &testutil.EnvConfig{
+			Opts: &testutil.EnvOpts{
+				Copy: []testutil.FileIO{
+					{
+						Src: filepath.Join("testdata", "config.toml"),
+						Dst: "config.toml",
+					},
+				},
+			},
+			EditScenario: func(scenario *testutil.CLIScenario, rootdir string) {
+				scenario.ConfigPath = filepath.Join(rootdir, "config.toml")
+			},
+		}
+	}
+	// NOTE: A new config is returned for every scenario, as import modifies it.
+	cfg := func() *config.File {
+		return &config.File{
+			Profiles: config.Profiles{
+				"foo": &config.Profile{
+					CustomerID: "abc",
+					Default:    true,
+					Email:      "foo@example.com",
+					Token:      "export-test-token",
+				},
+			},
+		}
+	}
+	assertFile := func(path string, wantToken bool) func(*testing.T, *testutil.CLIScenario, *global.Data, *threadsafe.Buffer) {
+		return func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+			data, err := os.ReadFile(path)
+			testutil.AssertNoError(t, err)
+			testutil.AssertStringContains(t, string(data), `"email": "foo@example.com"`)
+			testutil.AssertBool(t, wantToken, strings.Contains(string(data), "export-test-token"))
+		}
+	}
+
+	exportScenarios := []testutil.CLIScenario{
+		{
+			Name:       "validate the token is excluded by default",
+			Args:       "foo --file " + plainFile,
+			Env:        env(),
+			ConfigFile: cfg(),
+			Validator:  assertFile(plainFile, false),
+			WantOutputs: []string{
+				"Profile 'foo' exported to " + plainFile,
+				"The token wasn't exported",
+			},
+		},
+		{
+			Name:       "validate --include-token writes the token with a warning",
+			Args:       "foo --file " + tokenFile + " --include-token",
+			Env:        env(),
+			ConfigFile: cfg(),
+			Validator:  assertFile(tokenFile, true),
+			WantOutput: "is written unencrypted",
+		},
+		{
+			Name:       "validate --encrypt doesn't write the raw token",
+			Args:       "foo --file " + encryptedFile + " --encrypt",
+			Env:        env(),
+			ConfigFile: cfg(),
+			Stdin:      []string{"hunter2", "hunter2"},
+			Validator:  assertFile(encryptedFile, false),
+			WantOutput: "Profile 'foo' exported to " + encryptedFile,
+		},
+		{
+			Name:       "validate an existing file is made private",
+			Args:       "foo --file " + tokenFile + " --include-token",
+			Env:        env(),
+			ConfigFile: cfg(),
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				if err := os.WriteFile(tokenFile, nil, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				if runtime.GOOS == "windows" {
+					return
+				}
+				fi, err := os.Stat(tokenFile)
+				testutil.AssertNoError(t, err)
+				testutil.AssertEqual(t, os.FileMode(root.ExportFilePermissions), fi.Mode().Perm())
+			},
+			WantOutput: "Profile 'foo' exported to " + tokenFile,
+		},
+		{
+			Name:       "validate mismatched passphrases",
+			Args:       "foo --file " + filepath.Join(dir, "mismatch.json") + " --encrypt",
+			Env:        env(),
+			ConfigFile: cfg(),
+			Stdin:      []string{"hunter2", "hunter3"},
+			WantError:  "the passphrases don't match",
+		},
+		{
+			Name:       "validate --encrypt can't be combined with --include-token",
+			Args:       "foo --file " + filepath.Join(dir, "both.json") + " --encrypt --include-token",
+			Env:        env(),
+			ConfigFile: cfg(),
+			WantError:  "the --encrypt flag is mutually exclusive with the --include-token flag",
+		},
+		{
+			Name:      "validate unknown profile",
+			Args:      "unknown --file " + filepath.Join(dir, "unknown.json"),
+			Env:       env(),
+			WantError: "the profile 'unknown' does not exist",
+		},
+	}
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "export"}, exportScenarios)
+
+	assertProfile := func(name, wantToken string) func(*testing.T, *testutil.CLIScenario, *global.Data, *threadsafe.Buffer) {
+		return func(t *testing.T, _ *testutil.CLIScenario, opts *global.Data, _ *threadsafe.Buffer) {
+			p := opts.Config.Profiles[name]
+			if p == nil {
+				t.Fatalf("profile '%s' wasn't imported", name)
+			}
+			testutil.AssertString(t, "abc", p.CustomerID)
+			testutil.AssertString(t, "foo@example.com", p.Email)
+			testutil.AssertString(t, wantToken, p.Token)
+		}
+	}
+
+	importScenarios := []testutil.CLIScenario{
+		{
+			Name:       "validate an encrypted export round-trips",
+			Args:       "bar --file " + encryptedFile,
+			Env:        env(),
+			ConfigFile: cfg(),
+			Stdin:      []string{"hunter2"},
+			Validator:  assertProfile("bar", "export-test-token"),
+			WantOutput: "Profile 'bar' imported",
+		},
+		{
+			Name:       "validate an incorrect passphrase",
+			Args:       "bar --file " + encryptedFile,
+			Env:        env(),
+			ConfigFile: cfg(),
+			Stdin:      []string{"hunter3"},
+			WantError:  "the passphrase is incorrect",
+		},
+		{
+			Name:       "validate excessive scrypt parameters are rejected",
+			Args:       "bar --file " + craftedFile,
+			Env:        env(),
+			ConfigFile: cfg(),
+			Stdin:      []string{"hunter2"},
+			WantError:  "unsupported scrypt parameters (n=1073741824, r=8, p=1)",
+		},
+		{
+			Name:       "validate an unencrypted export round-trips",
+			Args:       "bar --file " + tokenFile,
+			Env:        env(),
+			ConfigFile: cfg(),
+			Validator:  assertProfile("bar", "export-test-token"),
+			WantOutput: "Profile 'bar' imported",
+		},
+		{
+			Name:      "validate an export without a token",
+			Args:      "--file " + plainFile,
+			Env:       env(),
+			Validator: assertProfile("foo", ""),
+			WantOutputs: []string{
+				"Profile 'foo' imported",
+				"The export didn't include a token",
+			},
+		},
+		{
+			Name:       "validate the profile name defaults to the exported name",
+			Args:       "--file " + plainFile,
+			Env:        env(),
+			ConfigFile: cfg(),
+			WantError:  "profile 'foo' already exists",
+		},
+	}
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "import"}, importScenarios)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
 )
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -22,6 +23,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -46,7 +49,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -80,13 +83,13 @@ func (c *DescribeCommand) print(out io.Writer, o *fastly.ERL) {
 	fmt.Fprintf(out, "WindowSize: %+v\n", fastly.ToValue(o.WindowSize))
 
 	if o.CreatedAt != nil {
-		fmt.Fprintf(out, "Created at: %s\n", o.CreatedAt)
+		fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *o.CreatedAt))
 	}
 	if o.UpdatedAt != nil {
-		fmt.Fprintf(out, "Updated at: %s\n", o.UpdatedAt)
+		fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *o.UpdatedAt))
 	}
 	if o.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted at: %s\n", o.DeletedAt)
+		fmt.Fprintf(out, "Deleted at: %s\n", text.FormatTime(out, *o.DeletedAt))
 	}
 }
 
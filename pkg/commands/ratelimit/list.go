@@ -28,6 +28,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -88,7 +89,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -122,13 +123,13 @@ func (c *ListCommand) printVerbose(out io.Writer, o []*fastly.ERL) {
 		fmt.Fprintf(out, "Version: %+v\n", fastly.ToValue(u.Version))
 		fmt.Fprintf(out, "WindowSize: %+v\n", fastly.ToValue(u.WindowSize))
 		if u.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", u.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *u.CreatedAt))
 		}
 		if u.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", u.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *u.UpdatedAt))
 		}
 		if u.DeletedAt != nil {
-			fmt.Fprintf(out, "Deleted at: %s\n", u.DeletedAt)
+			fmt.Fprintf(out, "Deleted at: %s\n", text.FormatTime(out, *u.DeletedAt))
 		}
 	}
 }
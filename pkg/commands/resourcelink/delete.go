@@ -66,6 +66,7 @@ func NewDeleteCommand(parent argparser.Registerer, g *global.Data) *DeleteComman
 		Dst:    &c.autoClone.Value,
 	})
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -120,7 +121,7 @@ func (c *DeleteCommand) Exec(_ io.Reader, out io.Writer) error {
 			c.input.ServiceVersion,
 			true,
 		}
-		_, err := c.WriteJSON(out, o)
+		_, err := c.WriteOutput(out, o)
 		return err
 	}
 
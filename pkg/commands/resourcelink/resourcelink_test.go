@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/versionlock"
 )
 
 func TestCreateServiceResourceCommand(t *testing.T) {
@@ -281,6 +283,7 @@ func TestDescribeServiceResourceCommand(t *testing.T) {
 	scenarios := []struct {
 		args           string
 		api            mock.API
+		lock           *versionlock.Lock
 		wantAPIInvoked bool
 		wantError      string
 		wantOutput     string
@@ -296,12 +299,39 @@ func TestDescribeServiceResourceCommand(t *testing.T) {
 			wantError:      "error reading service: no service ID found",
 			wantAPIInvoked: false,
 		},
+		{
+			args:           "describe --id LINK-ID --service-id abc",
+			lock:           &versionlock.Lock{ServiceID: "456", Version: 42},
+			wantError:      "the .fastly-version file pins a version of service 456, not abc",
+			wantAPIInvoked: false,
+		},
 		{
 			args:           "describe --service-id abc --version 123",
 			wantError:      "error parsing arguments: required flag --id not provided",
 			wantAPIInvoked: false,
 		},
 		// Success.
+		{
+			args: "describe --service-id 123 --id LINKID",
+			lock: &versionlock.Lock{ServiceID: "123", Version: 42},
+			api: mock.API{
+				ListVersionsFn: func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+					return []*fastly.Version{{Number: fastly.ToPointer(41)}, {Number: fastly.ToPointer(42)}}, nil
+				},
+				GetResourceFn: func(i *fastly.GetResourceInput) (*fastly.Resource, error) {
+					if got, want := i.ServiceVersion, 42; got != want {
+						return nil, fmt.Errorf("ServiceVersion: got %d, want %d", got, want)
+					}
+					return &fastly.Resource{
+						LinkID:         fastly.ToPointer("LINKID"),
+						ServiceID:      fastly.ToPointer("123"),
+						ServiceVersion: fastly.ToPointer(42),
+					}, nil
+				},
+			},
+			wantAPIInvoked: true,
+			wantOutput:     "Service ID: 123\nService Version: 42\nID: LINKID\nName: \nService ID: 123\nService Version: 42\nResource ID: \nResource Type:",
+		},
 		{
 			args: "describe --service-id 123 --version 42 --id LINKID",
 			api: mock.API{
@@ -340,14 +370,21 @@ Service ID: 123
 Service Version: 42
 Resource ID: abc
 Resource Type: secret-store
-Created (UTC): 2023-10-15 12:18
-Last edited (UTC): 2023-10-15 12:18`,
+Created: 2023-10-15T12:18:42Z
+Last edited: 2023-10-15T12:18:42Z`,
 		},
 	}
 
 	for _, testcase := range scenarios {
 		testcase := testcase
 		t.Run(testcase.args, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), versionlock.FileName)
+			defer func(p string) { versionlock.Path = p }(versionlock.Path)
+			versionlock.Path = path
+			if testcase.lock != nil {
+				testutil.AssertNoError(t, versionlock.Write(path, *testcase.lock))
+			}
+
 			var stdout bytes.Buffer
 			args := testutil.SplitArgs(resourcelink.RootName + " " + testcase.args)
 			opts := testutil.MockGlobalData(args, &stdout)
@@ -378,6 +415,7 @@ func TestListServiceResourceCommand(t *testing.T) {
 	scenarios := []struct {
 		args           string
 		api            mock.API
+		lock           *versionlock.Lock
 		wantAPIInvoked bool
 		wantError      string
 		wantOutput     string
@@ -393,7 +431,30 @@ func TestListServiceResourceCommand(t *testing.T) {
 			wantError:      "error reading service: no service ID found",
 			wantAPIInvoked: false,
 		},
+		{
+			args:           "list --service-id abc",
+			lock:           &versionlock.Lock{ServiceID: "456", Version: 42},
+			wantError:      "the .fastly-version file pins a version of service 456, not abc",
+			wantAPIInvoked: false,
+		},
 		// Success.
+		{
+			args: "list --service-id 123",
+			lock: &versionlock.Lock{ServiceID: "123", Version: 42},
+			api: mock.API{
+				ListVersionsFn: func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+					return []*fastly.Version{{Number: fastly.ToPointer(41)}, {Number: fastly.ToPointer(42)}}, nil
+				},
+				ListResourcesFn: func(i *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+					if got, want := i.ServiceVersion, 42; got != want {
+						return nil, fmt.Errorf("ServiceVersion: got %d, want %d", got, want)
+					}
+					return nil, nil
+				},
+			},
+			wantAPIInvoked: true,
+			wantOutput:     "Service ID: 123\nService Version: 42",
+		},
 		{
 			args: "list --service-id 123 --version 42",
 			api: mock.API{
@@ -435,8 +496,8 @@ Resource Link 1/3
   Service Version: 42
   Resource ID: abc
   Resource Type: secret-store
-  Created (UTC): 2023-10-15 12:18
-  Last edited (UTC): 2023-10-15 12:18
+  Created: 2023-10-15T12:18:42Z
+  Last edited: 2023-10-15T12:18:42Z
 
 Resource Link 2/3
   ID: LINKID-01
@@ -445,8 +506,8 @@ Resource Link 2/3
   Service Version: 42
   Resource ID: abc
   Resource Type: secret-store
-  Created (UTC): 2023-10-15 12:18
-  Last edited (UTC): 2023-10-15 12:18
+  Created: 2023-10-15T12:18:42Z
+  Last edited: 2023-10-15T12:18:42Z
 
 Resource Link 3/3
   ID: LINKID-02
@@ -455,14 +516,21 @@ Resource Link 3/3
   Service Version: 42
   Resource ID: abc
   Resource Type: secret-store
-  Created (UTC): 2023-10-15 12:18
-  Last edited (UTC): 2023-10-15 12:18`,
+  Created: 2023-10-15T12:18:42Z
+  Last edited: 2023-10-15T12:18:42Z`,
 		},
 	}
 
 	for _, testcase := range scenarios {
 		testcase := testcase
 		t.Run(testcase.args, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), versionlock.FileName)
+			defer func(p string) { versionlock.Path = p }(versionlock.Path)
+			versionlock.Path = path
+			if testcase.lock != nil {
+				testutil.AssertNoError(t, versionlock.Write(path, *testcase.lock))
+			}
+
 			var stdout bytes.Buffer
 			args := testutil.SplitArgs(resourcelink.RootName + " " + testcase.args)
 			opts := testutil.MockGlobalData(args, &stdout)
@@ -24,6 +24,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	// Optional.
 	c.RegisterFlag(argparser.CursorFlag(&c.Input.Cursor))  // --cursor
 	c.RegisterFlagBool(c.JSONFlag())                       // --json
+	c.RegisterFieldFlag(c.CmdClause)                       // --field
 	c.RegisterFlagInt(argparser.LimitFlag(&c.Input.Limit)) // --limit
 
 	return &c
@@ -83,7 +84,7 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 		break
 	}
 
-	ok, err := c.WriteJSON(out, data)
+	ok, err := c.WriteOutput(out, data)
 	if err != nil {
 		return err
 	}
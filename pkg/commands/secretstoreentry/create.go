@@ -58,6 +58,7 @@ func NewCreateCommand(parent argparser.Registerer, g *global.Data) *CreateComman
 	// Optional.
 	c.RegisterFlag(secretFileFlag(&c.secretFile)) // --file
 	c.RegisterFlagBool(c.JSONFlag())              // --json
+	c.RegisterFieldFlag(c.CmdClause)              // --field
 	c.RegisterFlagBool(argparser.BoolFlagOpts{
 		Name:        "recreate",
 		Description: "Recreate secret by name (errors if secret doesn't already exist)",
@@ -189,7 +190,7 @@ func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -27,6 +27,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	// Optional.
 	c.RegisterFlag(argparser.CursorFlag(&c.Input.Cursor))  // --cursor
 	c.RegisterFlagBool(c.JSONFlag())                       // --json
+	c.RegisterFieldFlag(c.CmdClause)                       // --field
 	c.RegisterFlagInt(argparser.LimitFlag(&c.Input.Limit)) // --limit
 
 	return &c
@@ -53,7 +54,7 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 			return err
 		}
 
-		if ok, err := c.WriteJSON(out, o); ok {
+		if ok, err := c.WriteOutput(out, o); ok {
 			// No pagination prompt w/ JSON output.
 			return err
 		}
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ChangelogCommand summarises the changes made to a service's configuration
+// between consecutive versions.
+type ChangelogCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	kinds        []string
+	serviceName  argparser.OptionalServiceNameID
+	sinceVersion int
+}
+
+// Changelog is the structured (--json) representation of a service changelog.
+type Changelog struct {
+	ServiceID    string           `json:"service_id"`
+	SinceVersion int              `json:"since_version"`
+	Entries      []ChangelogEntry `json:"entries"`
+}
+
+// ChangelogEntry describes the changes between two versions. Skipped lists the
+// versions between them that were deleted (or don't exist).
+type ChangelogEntry struct {
+	From    int              `json:"from"`
+	To      int              `json:"to"`
+	Skipped []int            `json:"skipped,omitempty"`
+	Changes []ResourceChange `json:"changes"`
+}
+
+// ResourceChange is a single resource that was added, removed or changed.
+type ResourceChange struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	Action string   `json:"action"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+)
+
+// changelogKind lists the resources of a kind on a service version.
+type changelogKind struct {
+	name string
+	list func(c api.Interface, serviceID string, version int) (any, error)
+}
+
+// changelogKinds are the resources compared by the changelog, using the
+// existing list operations. They're named after their CLI commands.
+var changelogKinds = []changelogKind{
+	{"acl", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListACLs(&fastly.ListACLsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"backend", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListBackends(&fastly.ListBackendsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"condition", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListConditions(&fastly.ListConditionsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"dictionary", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListDictionaries(&fastly.ListDictionariesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"domain", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListDomains(&fastly.ListDomainsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"healthcheck", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListHealthChecks(&fastly.ListHealthChecksInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-azureblob", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListBlobStorages(&fastly.ListBlobStoragesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-bigquery", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListBigQueries(&fastly.ListBigQueriesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-cloudfiles", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListCloudfiles(&fastly.ListCloudfilesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-datadog", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListDatadog(&fastly.ListDatadogInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-digitalocean", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListDigitalOceans(&fastly.ListDigitalOceansInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-elasticsearch", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListElasticsearch(&fastly.ListElasticsearchInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-ftp", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListFTPs(&fastly.ListFTPsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-gcs", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListGCSs(&fastly.ListGCSsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-googlepubsub", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListPubsubs(&fastly.ListPubsubsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-grafanacloudlogs", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListGrafanaCloudLogs(&fastly.ListGrafanaCloudLogsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-heroku", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListHerokus(&fastly.ListHerokusInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-honeycomb", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListHoneycombs(&fastly.ListHoneycombsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-https", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListHTTPS(&fastly.ListHTTPSInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-kafka", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListKafkas(&fastly.ListKafkasInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-kinesis", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListKinesis(&fastly.ListKinesisInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-loggly", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListLoggly(&fastly.ListLogglyInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-logshuttle", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListLogshuttles(&fastly.ListLogshuttlesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-newrelic", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListNewRelic(&fastly.ListNewRelicInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-newrelicotlp", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListNewRelicOTLP(&fastly.ListNewRelicOTLPInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-openstack", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListOpenstack(&fastly.ListOpenstackInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-papertrail", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListPapertrails(&fastly.ListPapertrailsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-s3", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListS3s(&fastly.ListS3sInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-scalyr", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListScalyrs(&fastly.ListScalyrsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-sftp", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListSFTPs(&fastly.ListSFTPsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-splunk", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListSplunks(&fastly.ListSplunksInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-sumologic", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListSumologics(&fastly.ListSumologicsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"logging-syslog", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListSyslogs(&fastly.ListSyslogsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"rate-limit", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListERLs(&fastly.ListERLsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"resource-link", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListResources(&fastly.ListResourcesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"vcl-custom", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListVCLs(&fastly.ListVCLsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{"vcl-snippet", func(c api.Interface, sid string, v int) (any, error) {
+		return c.ListSnippets(&fastly.ListSnippetsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+}
+
+// ignoredChangelogFields differ between versions without the resource having
+// been changed.
+var ignoredChangelogFields = []string{"service_id", "version"}
+
+// NewChangelogCommand returns a usable command registered under the parent.
+func NewChangelogCommand(parent argparser.Registerer, g *global.Data) *ChangelogCommand {
+	c := ChangelogCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("changelog", "Summarise what changed between each version of a Fastly service, from a given version to the latest")
+
+	// Required.
+	c.CmdClause.Flag("since-version", "The service version to start from").Required().IntVar(&c.sinceVersion)
+
+	// Optional.
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	kinds := make([]string, 0, len(changelogKinds))
+	for _, k := range changelogKinds {
+		kinds = append(kinds, k.name)
+	}
+	c.CmdClause.Flag("kind", "Only compare resources of this kind (repeat for multiple, defaults to all)").HintOptions(kinds...).EnumsVar(&c.kinds, kinds...)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+//
+// NOTE: Each version's resources are listed once (one request per kind) and
+// compared with those of the previous version.
+func (c *ChangelogCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+
+	serviceID, source, flag, err := argparser.ServiceID(c.serviceName, *c.Globals.Manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		argparser.DisplayServiceID(serviceID, flag, source, out)
+	}
+	if source == manifest.SourceUndefined && !c.serviceName.WasSet {
+		err := fsterr.ErrNoServiceID
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	vs, err := c.Globals.APIClient.ListVersions(&fastly.ListVersionsInput{ServiceID: serviceID})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+		})
+		return err
+	}
+
+	versions, skipped := changelogVersions(vs, c.sinceVersion)
+	if len(versions) == 0 {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("service %s has no version %d (or later) to start from", serviceID, c.sinceVersion),
+			Remediation: "Use `fastly service-version list` to find the available versions.",
+		}
+	}
+
+	kinds := changelogKinds
+	if len(c.kinds) > 0 {
+		kinds = slices.DeleteFunc(slices.Clone(kinds), func(k changelogKind) bool {
+			return !slices.Contains(c.kinds, k.name)
+		})
+	}
+
+	changelog := Changelog{
+		ServiceID:    serviceID,
+		SinceVersion: c.sinceVersion,
+		Entries:      []ChangelogEntry{},
+	}
+	var previous map[string]map[string]any
+	for i, v := range versions {
+		resources, err := c.listResources(kinds, serviceID, v)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": v,
+			})
+			return err
+		}
+		if i > 0 {
+			changelog.Entries = append(changelog.Entries, ChangelogEntry{
+				From:    versions[i-1],
+				To:      v,
+				Skipped: skipped[v],
+				Changes: compareResources(kinds, previous, resources),
+			})
+		}
+		previous = resources
+	}
+
+	if ok, err := c.WriteOutput(out, changelog); ok {
+		return err
+	}
+
+	if versions[0] != c.sinceVersion {
+		text.Info(out, "Version %d was deleted (or doesn't exist), so the changelog starts from version %d.\n\n", c.sinceVersion, versions[0])
+	}
+	if len(changelog.Entries) == 0 {
+		text.Info(out, "Version %d is the latest version, so there are no changes to display.", versions[0])
+		return nil
+	}
+	for i, e := range changelog.Entries {
+		if i > 0 {
+			text.Break(out)
+		}
+		text.Output(out, text.Bold(fmt.Sprintf("Version %d -> %d", e.From, e.To)))
+		if len(e.Skipped) > 0 {
+			fmt.Fprintf(out, "  (skipped deleted versions: %s)\n", joinInts(e.Skipped))
+		}
+		if len(e.Changes) == 0 {
+			fmt.Fprintln(out, "  No changes")
+			continue
+		}
+		for _, ch := range e.Changes {
+			switch ch.Action {
+			case changeAdded:
+				fmt.Fprintf(out, "  + %s %s\n", ch.Kind, ch.Name)
+			case changeRemoved:
+				fmt.Fprintf(out, "  - %s %s\n", ch.Kind, ch.Name)
+			default:
+				fmt.Fprintf(out, "  ~ %s %s (%s)\n", ch.Kind, ch.Name, strings.Join(ch.Fields, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// listResources returns the resources of each kind on the service version,
+// keyed by kind and then by resource name.
+func (c *ChangelogCommand) listResources(kinds []changelogKind, serviceID string, version int) (map[string]map[string]any, error) {
+	resources := make(map[string]map[string]any, len(kinds))
+	for _, k := range kinds {
+		items, err := k.list(c.Globals.APIClient, serviceID, version)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s resources of service version %d: %w", k.name, version, err)
+		}
+		resources[k.name] = resourcesByName(items)
+	}
+	return resources, nil
+}
+
+// changelogVersions returns the (ascending) numbers of the versions from since
+// onwards that weren't deleted, along with the deleted (or missing) versions
+// skipped before each of them.
+func changelogVersions(vs []*fastly.Version, since int) ([]int, map[int][]int) {
+	existing := make(map[int]bool)
+	for _, v := range vs {
+		if v.DeletedAt == nil {
+			existing[fastly.ToValue(v.Number)] = true
+		}
+	}
+
+	var versions []int
+	for n := range existing {
+		if n >= since {
+			versions = append(versions, n)
+		}
+	}
+	sort.Ints(versions)
+
+	skipped := make(map[int][]int)
+	for i := 1; i < len(versions); i++ {
+		for n := versions[i-1] + 1; n < versions[i]; n++ {
+			skipped[versions[i]] = append(skipped[versions[i]], n)
+		}
+	}
+	return versions, skipped
+}
+
+// resourcesByName indexes a slice of API resources by their Name field.
+func resourcesByName(items any) map[string]any {
+	byName := make(map[string]any)
+	rv := reflect.ValueOf(items)
+	if rv.Kind() != reflect.Slice {
+		return byName
+	}
+	for i := 0; i < rv.Len(); i++ {
+		item := rv.Index(i)
+		if item.Kind() == reflect.Pointer {
+			if item.IsNil() {
+				continue
+			}
+			item = item.Elem()
+		}
+		name := item.FieldByName("Name")
+		if !name.IsValid() {
+			continue
+		}
+		if name.Kind() == reflect.Pointer {
+			if name.IsNil() {
+				continue
+			}
+			name = name.Elem()
+		}
+		byName[name.String()] = rv.Index(i).Interface()
+	}
+	return byName
+}
+
+// compareResources returns the resources added, removed or changed between
+// two versions, sorted by kind (in the order given) and then name.
+func compareResources(kinds []changelogKind, before, after map[string]map[string]any) []ResourceChange {
+	changes := []ResourceChange{}
+	for _, k := range kinds {
+		b, a := before[k.name], after[k.name]
+		names := make([]string, 0, len(b)+len(a))
+		for name := range b {
+			names = append(names, name)
+		}
+		for name := range a {
+			if _, ok := b[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			ov, inBefore := b[name]
+			nv, inAfter := a[name]
+			switch {
+			case !inBefore:
+				changes = append(changes, ResourceChange{Kind: k.name, Name: name, Action: changeAdded})
+			case !inAfter:
+				changes = append(changes, ResourceChange{Kind: k.name, Name: name, Action: changeRemoved})
+			default:
+				fields := slices.DeleteFunc(argparser.Changes(ov, nv).Fields(), func(f string) bool {
+					return slices.Contains(ignoredChangelogFields, f)
+				})
+				if len(fields) > 0 {
+					changes = append(changes, ResourceChange{Kind: k.name, Name: name, Action: changeChanged, Fields: fields})
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// joinInts formats the numbers as a comma-separated list.
+func joinInts(ns []int) string {
+	s := make([]string, 0, len(ns))
+	for _, n := range ns {
+		s = append(s, fmt.Sprint(n))
+	}
+	return strings.Join(s, ", ")
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
-	"github.com/fastly/cli/pkg/time"
 )
 
 // DescribeCommand calls the Fastly API to describe a service.
@@ -34,6 +33,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -79,7 +80,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -93,13 +94,13 @@ func (c *DescribeCommand) print(s *fastly.ServiceDetail, out io.Writer) error {
 	fmt.Fprintf(out, "Comment: %s\n", fastly.ToValue(s.Comment))
 	fmt.Fprintf(out, "Customer ID: %s\n", fastly.ToValue(s.CustomerID))
 	if s.CreatedAt != nil {
-		fmt.Fprintf(out, "Created (UTC): %s\n", s.CreatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Created: %s\n", text.FormatTime(out, *s.CreatedAt))
 	}
 	if s.UpdatedAt != nil {
-		fmt.Fprintf(out, "Last edited (UTC): %s\n", s.UpdatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Last edited: %s\n", text.FormatTime(out, *s.UpdatedAt))
 	}
 	if s.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", s.DeletedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Deleted: %s\n", text.FormatTime(out, *s.DeletedAt))
 	}
 	if s.ActiveVersion != nil {
 		fmt.Fprintf(out, "Active version:\n")
@@ -11,18 +11,19 @@ import (
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
-	"github.com/fastly/cli/pkg/time"
 )
 
 // ListCommand calls the Fastly API to list services.
 type ListCommand struct {
 	argparser.Base
+	argparser.CountOutput
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
-	direction     string
-	page, perPage int
-	input         fastly.GetServicesInput
-	sort          string
+	direction string
+	page      int
+	input     fastly.GetServicesInput
+	sort      string
 }
 
 // NewListCommand returns a usable command registered under the parent.
@@ -36,9 +37,14 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	// Optional.
 	c.CmdClause.Flag("direction", "Direction in which to sort results").Default(argparser.PaginationDirection[0]).HintOptions(argparser.PaginationDirection...).EnumVar(&c.direction, argparser.PaginationDirection...)
-	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFlagBool(c.CountFlag())          // --count-only
+	c.RegisterFlagBool(c.JSONFlag())           // --json
+	c.RegisterFieldFlag(c.CmdClause)           // --field
+	c.RegisterFlagInt(c.LimitRecordsFlag())    // --limit
+	c.RegisterFlagBool(c.NoAutopaginateFlag()) // --no-autopaginate
+	c.RegisterFlagInt(c.PageSizeFlag())        // --page-size
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.page)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.perPage)
+	c.RegisterFlagInt(c.PerPageFlag()) // --per-page
 	c.CmdClause.Flag("sort", "Field on which to sort").Default("created").StringVar(&c.sort)
 	return &c
 }
@@ -51,33 +57,36 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 
 	c.input.Direction = &c.direction
 	c.input.Page = &c.page
-	c.input.PerPage = &c.perPage
+	c.input.PerPage = c.PageSizeInput(c.Globals, out)
 	c.input.Sort = &c.sort
 	paginator := c.Globals.APIClient.GetServices(&c.input)
 
-	var o []*fastly.Service
-	for paginator.HasNext() {
-		data, err := paginator.GetNext()
+	o, truncated, pageErr := argparser.Paginate(c.PaginationOutput, paginator, c.Globals.ErrLog, nil)
+	if pageErr != nil && !argparser.IsPartialResult(pageErr) {
+		return pageErr
+	}
+
+	if ok, err := c.WriteCount(out, len(o), c.JSONOutput); ok {
 		if err != nil {
-			c.Globals.ErrLog.AddWithContext(err, map[string]any{
-				"Remaining Pages": paginator.Remaining(),
-			})
 			return err
 		}
-		o = append(o, data...)
+		return pageErr
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
-		return err
+	if ok, err := c.WriteOutput(out, o); ok {
+		if err != nil {
+			return err
+		}
+		return pageErr
 	}
 
 	if !c.Globals.Verbose() {
 		tw := text.NewTable(out)
-		tw.AddHeader("NAME", "ID", "TYPE", "ACTIVE VERSION", "LAST EDITED (UTC)")
+		tw.AddHeader("NAME", "ID", "TYPE", "ACTIVE VERSION", "LAST EDITED")
 		for _, service := range o {
 			updatedAt := "n/a"
 			if service.UpdatedAt != nil {
-				updatedAt = service.UpdatedAt.UTC().Format(time.Format)
+				updatedAt = text.FormatTime(out, *service.UpdatedAt)
 			}
 
 			activeVersion := strconv.Itoa(fastly.ToValue(service.ActiveVersion))
@@ -96,7 +105,8 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 			)
 		}
 		tw.Print()
-		return nil
+		c.DisplayTruncated(out, len(o), truncated)
+		return pageErr
 	}
 
 	for i, service := range o {
@@ -104,6 +114,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		text.PrintService(out, "\t", service)
 		fmt.Fprintln(out)
 	}
+	c.DisplayTruncated(out, len(o), truncated)
 
-	return nil
+	return pageErr
 }
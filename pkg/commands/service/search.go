@@ -33,6 +33,7 @@ func NewSearchCommand(parent argparser.Registerer, g *global.Data) *SearchComman
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -51,7 +52,7 @@ func (c *SearchCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, service); ok {
+	if ok, err := c.WriteOutput(out, service); ok {
 		return err
 	}
 
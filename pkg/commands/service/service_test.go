@@ -97,6 +97,97 @@ func TestServiceList(t *testing.T) {
 			args:      args("service list"),
 			wantError: testutil.Err.Error(),
 		},
+		{
+			api: mock.API{
+				GetServicesFn: func(i *fastly.GetServicesInput) *fastly.ListPaginator[fastly.Service] {
+					return fastly.NewPaginator[fastly.Service](&mock.HTTPClient{
+						Errors: []error{nil, testutil.Err},
+						Responses: []*http.Response{
+							{
+								Header: http.Header{
+									"Link": []string{`<https://api.fastly.com/service?page=2>; rel="next", <https://api.fastly.com/service?page=2>; rel="last"`},
+								},
+								Body: io.NopCloser(strings.NewReader(`[
+                  {
+                    "name": "Foo",
+                    "id": "123",
+                    "type": "wasm",
+                    "version": 2,
+                    "updated_at": "2021-06-15T23:00:00Z"
+                  }
+                ]`)),
+							},
+							nil,
+						},
+					}, fastly.ListOpts{}, "/example")
+				},
+			},
+			args:       args("service list"),
+			wantError:  "pagination stopped early at page 2: " + testutil.Err.Error(),
+			wantOutput: listServicesPartialOutput,
+		},
+		{
+			api: mock.API{
+				GetServicesFn: func(i *fastly.GetServicesInput) *fastly.ListPaginator[fastly.Service] {
+					return fastly.NewPaginator[fastly.Service](&mock.HTTPClient{
+						Errors: []error{nil},
+						Responses: []*http.Response{
+							{
+								Header: http.Header{
+									"Link": []string{`<https://api.fastly.com/service?page=2>; rel="next", <https://api.fastly.com/service?page=2>; rel="last"`},
+								},
+								Body: io.NopCloser(strings.NewReader(`[
+                  {
+                    "name": "Foo",
+                    "id": "123",
+                    "type": "wasm",
+                    "version": 2,
+                    "updated_at": "2021-06-15T23:00:00Z"
+                  }
+                ]`)),
+							},
+						},
+					}, fastly.ListOpts{}, "/example")
+				},
+			},
+			args:       args("service list --no-autopaginate"),
+			wantOutput: listServicesPartialOutput + "\nINFO: Only the first page of results was fetched. More results are available: remove --no-autopaginate (or use --page) to fetch them.\n",
+		},
+		{
+			api: mock.API{
+				GetServicesFn: func(i *fastly.GetServicesInput) *fastly.ListPaginator[fastly.Service] {
+					return fastly.NewPaginator[fastly.Service](&mock.HTTPClient{
+						Errors: []error{nil},
+						Responses: []*http.Response{
+							{
+								Body: io.NopCloser(strings.NewReader(`[
+                  {
+                    "name": "Foo",
+                    "id": "123",
+                    "type": "wasm",
+                    "version": 2,
+                    "updated_at": "2021-06-15T23:00:00Z"
+                  },
+                  {
+                    "name": "Bar",
+                    "id": "456",
+                    "type": "wasm",
+                    "version": 1,
+                    "updated_at": "2021-06-15T23:00:00Z"
+                  }
+                ]`)),
+							},
+						},
+					}, fastly.ListOpts{}, "/example")
+				},
+			},
+			args:       args("service list --limit 1"),
+			wantOutput: listServicesPartialOutput + "\nINFO: Results are limited to 1 by --limit. More results are available: increase (or remove) --limit to fetch them.\n",
+		},
+		{
+			args:      args("service list --limit=-1"),
+			wantError: "--limit must not be negative (use 0 for no limit): -1",
+		},
 		{
 			api: mock.API{
 				GetServicesFn: func(i *fastly.GetServicesInput) *fastly.ListPaginator[fastly.Service] {
@@ -261,6 +352,34 @@ func TestServiceDescribe(t *testing.T) {
 			api:       mock.API{GetServiceDetailsFn: describeServiceError},
 			wantError: errTest.Error(),
 		},
+		{
+			args:       args("service describe --service-id 123 --pointer /ActiveVersion/Number"),
+			api:        mock.API{GetServiceDetailsFn: describeServiceOK},
+			wantOutput: "2\n",
+		},
+		{
+			args:       args("service describe --service-id 123 --pointer /Name"),
+			api:        mock.API{GetServiceDetailsFn: describeServiceOK},
+			wantOutput: "Foo\n",
+		},
+		{
+			args:      args("service describe --service-id 123 --pointer /Versions/9"),
+			api:       mock.API{GetServiceDetailsFn: describeServiceOK},
+			wantError: `JSON pointer "/Versions/9" doesn't resolve: index 9 is out of range at /Versions (length 2)`,
+		},
+		{
+			args:      args("service describe --service-id 123 --pointer Name"),
+			api:       mock.API{GetServiceDetailsFn: describeServiceOK},
+			wantError: "invalid JSON pointer",
+		},
+		{
+			args:      args("service delete --service-id 123 --pointer /Name"),
+			wantError: "--pointer is not supported by this command",
+		},
+		{
+			args:      args("service delete --service-id 123 --output service.json"),
+			wantError: "--output is not supported by this command",
+		},
 	}
 	for testcaseIdx := range scenarios {
 		testcase := &scenarios[testcaseIdx]
@@ -516,22 +635,29 @@ func createServiceError(*fastly.CreateServiceInput) (*fastly.Service, error) {
 }
 
 var listServicesShortOutput = strings.TrimSpace(`
-NAME  ID   TYPE  ACTIVE VERSION  LAST EDITED (UTC)
-Foo   123  wasm  2               2021-06-15 23:00
-Bar   456  wasm  1               2021-06-15 23:00
+NAME  ID   TYPE  ACTIVE VERSION  LAST EDITED
+Foo   123  wasm  2               2021-06-15T23:00:00Z
+Bar   456  wasm  1               2021-06-15T23:00:00Z
 Baz   789  vcl   1               n/a
 `) + "\n"
 
+var listServicesPartialOutput = strings.TrimSpace(`
+NAME  ID   TYPE  ACTIVE VERSION  LAST EDITED
+Foo   123  wasm  2               2021-06-15T23:00:00Z
+`) + "\n"
+
 var listServicesVerboseOutput = strings.TrimSpace(`
 Fastly API endpoint: https://api.fastly.com
 Fastly API token provided via config file (profile: user)
 
+Page size: 100 (default)
+
 Service 1/3
 	ID: 123
 	Name: Foo
 	Type: wasm
 	Customer ID: mycustomerid
-	Last edited (UTC): 2021-06-15 23:00
+	Last edited: 2021-06-15T23:00:00Z
 	Active version: 2
 	Versions: 2
 		Version 1/2
@@ -543,9 +669,9 @@ Service 1/3
 			Deployed: false
 			Staged: false
 			Testing: false
-			Created (UTC): 2021-06-15 23:00
-			Last edited (UTC): 2021-06-15 23:00
-			Deleted (UTC): 2021-06-15 23:00
+			Created: 2021-06-15T23:00:00Z
+			Last edited: 2021-06-15T23:00:00Z
+			Deleted: 2021-06-15T23:00:00Z
 		Version 2/2
 			Number: 2
 			Comment: c
@@ -555,15 +681,15 @@ Service 1/3
 			Deployed: true
 			Staged: false
 			Testing: false
-			Created (UTC): 2021-06-15 23:00
-			Last edited (UTC): 2021-06-15 23:00
+			Created: 2021-06-15T23:00:00Z
+			Last edited: 2021-06-15T23:00:00Z
 
 Service 2/3
 	ID: 456
 	Name: Bar
 	Type: wasm
 	Customer ID: mycustomerid
-	Last edited (UTC): 2021-06-15 23:00
+	Last edited: 2021-06-15T23:00:00Z
 	Active version: 1
 	Versions: 0
 
@@ -633,31 +759,31 @@ Name: Foo
 Type: wasm
 Comment: example
 Customer ID: mycustomerid
-Last edited (UTC): 2010-11-15 19:01
+Last edited: 2010-11-15T19:01:02Z
 Active version:
 	Number: 2
 	Comment: c
 	Service ID: d
 	Active: true
 	Deployed: true
-	Created (UTC): 2001-03-03 04:05
-	Last edited (UTC): 2001-03-04 04:05
+	Created: 2001-03-03T04:05:06Z
+	Last edited: 2001-03-04T04:05:06Z
 Versions: 2
 	Version 1/2
 		Number: 1
 		Comment: a
 		Service ID: b
-		Created (UTC): 2001-02-03 04:05
-		Last edited (UTC): 2001-02-04 04:05
-		Deleted (UTC): 2001-02-05 04:05
+		Created: 2001-02-03T04:05:06Z
+		Last edited: 2001-02-04T04:05:06Z
+		Deleted: 2001-02-05T04:05:06Z
 	Version 2/2
 		Number: 2
 		Comment: c
 		Service ID: d
 		Active: true
 		Deployed: true
-		Created (UTC): 2001-03-03 04:05
-		Last edited (UTC): 2001-03-04 04:05
+		Created: 2001-03-03T04:05:06Z
+		Last edited: 2001-03-04T04:05:06Z
 `) + "\n"
 
 var describeServiceVerboseOutput = strings.TrimSpace(`
@@ -671,31 +797,31 @@ Name: Foo
 Type: wasm
 Comment: example
 Customer ID: mycustomerid
-Last edited (UTC): 2010-11-15 19:01
+Last edited: 2010-11-15T19:01:02Z
 Active version:
 	Number: 2
 	Comment: c
 	Service ID: d
 	Active: true
 	Deployed: true
-	Created (UTC): 2001-03-03 04:05
-	Last edited (UTC): 2001-03-04 04:05
+	Created: 2001-03-03T04:05:06Z
+	Last edited: 2001-03-04T04:05:06Z
 Versions: 2
 	Version 1/2
 		Number: 1
 		Comment: a
 		Service ID: b
-		Created (UTC): 2001-02-03 04:05
-		Last edited (UTC): 2001-02-04 04:05
-		Deleted (UTC): 2001-02-05 04:05
+		Created: 2001-02-03T04:05:06Z
+		Last edited: 2001-02-04T04:05:06Z
+		Deleted: 2001-02-05T04:05:06Z
 	Version 2/2
 		Number: 2
 		Comment: c
 		Service ID: d
 		Active: true
 		Deployed: true
-		Created (UTC): 2001-03-03 04:05
-		Last edited (UTC): 2001-03-04 04:05
+		Created: 2001-03-03T04:05:06Z
+		Last edited: 2001-03-04T04:05:06Z
 `) + "\n"
 
 func searchServiceOK(_ *fastly.SearchServiceInput) (*fastly.Service, error) {
@@ -732,23 +858,23 @@ ID: 123
 Name: Foo
 Type: wasm
 Customer ID: mycustomerid
-Last edited (UTC): 2010-11-15 19:01
+Last edited: 2010-11-15T19:01:02Z
 Versions: 2
 	Version 1/2
 		Number: 1
 		Comment: a
 		Service ID: b
-		Created (UTC): 2001-02-03 04:05
-		Last edited (UTC): 2001-02-04 04:05
-		Deleted (UTC): 2001-02-05 04:05
+		Created: 2001-02-03T04:05:06Z
+		Last edited: 2001-02-04T04:05:06Z
+		Deleted: 2001-02-05T04:05:06Z
 	Version 2/2
 		Number: 2
 		Comment: c
 		Service ID: d
 		Active: true
 		Deployed: true
-		Created (UTC): 2001-03-03 04:05
-		Last edited (UTC): 2001-03-04 04:05
+		Created: 2001-03-03T04:05:06Z
+		Last edited: 2001-03-04T04:05:06Z
 `) + "\n"
 
 var searchServiceVerboseOutput = strings.TrimSpace(`
@@ -759,23 +885,23 @@ ID: 123
 Name: Foo
 Type: wasm
 Customer ID: mycustomerid
-Last edited (UTC): 2010-11-15 19:01
+Last edited: 2010-11-15T19:01:02Z
 Versions: 2
 	Version 1/2
 		Number: 1
 		Comment: a
 		Service ID: b
-		Created (UTC): 2001-02-03 04:05
-		Last edited (UTC): 2001-02-04 04:05
-		Deleted (UTC): 2001-02-05 04:05
+		Created: 2001-02-03T04:05:06Z
+		Last edited: 2001-02-04T04:05:06Z
+		Deleted: 2001-02-05T04:05:06Z
 	Version 2/2
 		Number: 2
 		Comment: c
 		Service ID: d
 		Active: true
 		Deployed: true
-		Created (UTC): 2001-03-03 04:05
-		Last edited (UTC): 2001-03-04 04:05
+		Created: 2001-03-03T04:05:06Z
+		Last edited: 2001-03-04T04:05:06Z
 `) + "\n"
 
 func updateServiceOK(_ *fastly.UpdateServiceInput) (*fastly.Service, error) {
@@ -795,3 +921,93 @@ func deleteServiceOK(*fastly.DeleteServiceInput) error {
 func deleteServiceError(*fastly.DeleteServiceInput) error {
 	return errTest
 }
+
+func TestServiceChangelog(t *testing.T) {
+	deleted := testutil.MustParseTimeRFC3339("2024-01-03T00:00:00Z")
+	listVersions := func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+		return []*fastly.Version{
+			{ServiceID: fastly.ToPointer(i.ServiceID), Number: fastly.ToPointer(5), Active: fastly.ToPointer(true)},
+			{ServiceID: fastly.ToPointer(i.ServiceID), Number: fastly.ToPointer(3), DeletedAt: deleted},
+			{ServiceID: fastly.ToPointer(i.ServiceID), Number: fastly.ToPointer(2)},
+			{ServiceID: fastly.ToPointer(i.ServiceID), Number: fastly.ToPointer(1)},
+		}, nil
+	}
+	backend := func(name string, port, version int) *fastly.Backend {
+		return &fastly.Backend{
+			Name:           fastly.ToPointer(name),
+			Port:           fastly.ToPointer(port),
+			ServiceID:      fastly.ToPointer("123"),
+			ServiceVersion: fastly.ToPointer(version),
+		}
+	}
+	api := mock.API{
+		ListVersionsFn: listVersions,
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			switch i.ServiceVersion {
+			case 1:
+				return []*fastly.Backend{backend("origin", 80, 1)}, nil
+			case 2:
+				return []*fastly.Backend{backend("origin", 443, 2), backend("api", 443, 2)}, nil
+			case 5:
+				return []*fastly.Backend{backend("api", 443, 5)}, nil
+			}
+			return nil, errors.New("unexpected service version")
+		},
+		ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+			domains := []*fastly.Domain{{Name: fastly.ToPointer("example.com"), ServiceVersion: fastly.ToPointer(i.ServiceVersion)}}
+			if i.ServiceVersion == 5 {
+				domains = append(domains, &fastly.Domain{Name: fastly.ToPointer("www.example.com"), ServiceVersion: fastly.ToPointer(5)})
+			}
+			return domains, nil
+		},
+	}
+
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:      "--service-id 123",
+			WantError: "error parsing arguments: required flag --since-version not provided",
+		},
+		{
+			Args:      "--service-id 123 --since-version 1 --kind widget",
+			WantError: "enum value must be one of",
+		},
+		{
+			Args: "--service-id 123 --since-version 1 --kind backend --kind domain",
+			API:  api,
+			WantOutputs: []string{
+				"Version 1 -> 2\n  + backend api\n  ~ backend origin (port)\n",
+				"Version 2 -> 5\n  (skipped deleted versions: 3, 4)\n  - backend origin\n  + domain www.example.com\n",
+			},
+		},
+		{
+			Args:       "--service-id 123 --since-version 5 --kind domain",
+			API:        api,
+			WantOutput: "INFO: Version 5 is the latest version, so there are no changes to display.",
+		},
+		{
+			Args: "--service-id 123 --since-version 3 --kind domain",
+			API: mock.API{
+				ListVersionsFn: listVersions,
+				ListDomainsFn:  api.ListDomainsFn,
+			},
+			WantOutput: "Version 3 was deleted (or doesn't exist), so the changelog starts from version 5.",
+		},
+		{
+			Args:      "--service-id 123 --since-version 6",
+			API:       api,
+			WantError: "service 123 has no version 6 (or later) to start from",
+		},
+		{
+			Args: "--service-id 123 --since-version 1 --kind backend --json",
+			API:  api,
+			WantOutputs: []string{
+				`"from": 2`,
+				`"to": 5`,
+				`"skipped": [` + "\n" + `        3,` + "\n" + `        4` + "\n" + `      ]`,
+				`"kind": "backend",` + "\n" + `          "name": "origin",` + "\n" + `          "action": "changed",` + "\n" + `          "fields": [` + "\n" + `            "port"`,
+			},
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{"service", "changelog"}, scenarios)
+}
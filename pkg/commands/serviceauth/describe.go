@@ -9,7 +9,7 @@ import (
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
-	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/cli/pkg/text"
 )
 
 // DescribeCommand calls the Fastly API to describe a service authorization.
@@ -34,6 +34,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	return &c
 }
 
@@ -51,7 +53,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -65,13 +67,13 @@ func (c *DescribeCommand) print(s *fastly.ServiceAuthorization, out io.Writer) e
 	fmt.Fprintf(out, "Permission: %s\n", s.Permission)
 
 	if s.CreatedAt != nil {
-		fmt.Fprintf(out, "Created (UTC): %s\n", s.CreatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Created: %s\n", text.FormatTime(out, *s.CreatedAt))
 	}
 	if s.UpdatedAt != nil {
-		fmt.Fprintf(out, "Last edited (UTC): %s\n", s.UpdatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Last edited: %s\n", text.FormatTime(out, *s.UpdatedAt))
 	}
 	if s.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", s.DeletedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Deleted: %s\n", text.FormatTime(out, *s.DeletedAt))
 	}
 
 	return nil
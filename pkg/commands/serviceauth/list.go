@@ -8,7 +8,6 @@ import (
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
-	"github.com/fastly/cli/pkg/time"
 	"github.com/fastly/go-fastly/v9/fastly"
 )
 
@@ -16,6 +15,7 @@ import (
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	input fastly.ListServiceAuthorizationsInput
 }
@@ -28,8 +28,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.input.PageNumber)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.input.PageSize)
+	c.RegisterFlagInt(c.PageSizeFlag()) // --page-size
+	c.RegisterFlagInt(c.PerPageFlag())  // --per-page
 	return &c
 }
 
@@ -39,6 +41,10 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
+	if size := c.PageSizeInput(c.Globals, out); size != nil {
+		c.input.PageSize = *size
+	}
+
 	o, err := c.Globals.APIClient.ListServiceAuthorizations(&c.input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -48,7 +54,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -73,13 +79,13 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		fmt.Fprintf(out, "Permission: %s\n", s.Permission)
 
 		if s.CreatedAt != nil {
-			fmt.Fprintf(out, "Created (UTC): %s\n", s.CreatedAt.UTC().Format(time.Format))
+			fmt.Fprintf(out, "Created: %s\n", text.FormatTime(out, *s.CreatedAt))
 		}
 		if s.UpdatedAt != nil {
-			fmt.Fprintf(out, "Last edited (UTC): %s\n", s.UpdatedAt.UTC().Format(time.Format))
+			fmt.Fprintf(out, "Last edited: %s\n", text.FormatTime(out, *s.UpdatedAt))
 		}
 		if s.DeletedAt != nil {
-			fmt.Fprintf(out, "Deleted (UTC): %s\n", s.DeletedAt.UTC().Format(time.Format))
+			fmt.Fprintf(out, "Deleted: %s\n", text.FormatTime(out, *s.DeletedAt))
 		}
 	}
 
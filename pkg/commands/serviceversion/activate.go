@@ -10,6 +10,7 @@ import (
 	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/versionlock"
 )
 
 // ActivateCommand calls the Fastly API to activate a service version.
@@ -84,5 +85,29 @@ func (c *ActivateCommand) Exec(_ io.Reader, out io.Writer) error {
 	}
 
 	text.Success(out, "Activated service %s version %d", fastly.ToValue(ver.ServiceID), c.Input.ServiceVersion)
+	updateVersionLock(c.Globals, out, serviceID, c.Input.ServiceVersion)
 	return nil
 }
+
+// updateVersionLock updates an existing .fastly-version lockfile written for
+// the service, so it pins the activated version.
+//
+// NOTE: The lockfile is never created here (see `service-version pin`).
+func updateVersionLock(g *global.Data, out io.Writer, serviceID string, version int) {
+	lock, ok, err := versionlock.Read(versionlock.Path)
+	if err != nil {
+		g.ErrLog.Add(err)
+		text.Warning(out, "The %s file wasn't updated: %s", versionlock.FileName, err)
+		return
+	}
+	if !ok || lock.ServiceID != serviceID || lock.Version == version {
+		return
+	}
+	lock.Version = version
+	if err := versionlock.Write(versionlock.Path, lock); err != nil {
+		g.ErrLog.Add(err)
+		text.Warning(out, "The %s file wasn't updated: %s", versionlock.FileName, err)
+		return
+	}
+	text.Info(out, "Updated %s to pin version %d", versionlock.FileName, version)
+}
@@ -0,0 +1,405 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// BatchCommand either clones a service version and applies the requested
+// operations (e.g. updating the comment, activating) to the clone, reporting
+// the outcome of every step, or applies one operation (activate, deactivate or
+// clone) to each of a list of versions (see --versions).
+//
+// NOTE: Changing the configuration of the clone (e.g. its backends) isn't a
+// batch operation, it's done with the resource commands and --version set to
+// the clone before activating it.
+type BatchCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+
+	activate    bool
+	cloneFrom   argparser.OptionalServiceVersion
+	comment     argparser.OptionalString
+	onError     string
+	operation   string
+	serviceName argparser.OptionalServiceNameID
+	versions    []string
+}
+
+// The operations that can be applied to a list of versions (see --operation).
+const (
+	batchActivate   = "activate"
+	batchClone      = "clone"
+	batchDeactivate = "deactivate"
+)
+
+// batchOperations is the list of values accepted by the --operation flag.
+var batchOperations = []string{batchActivate, batchClone, batchDeactivate}
+
+// BatchStep is the outcome of a single step of the batch.
+type BatchStep struct {
+	// Step is the operation (e.g. "clone").
+	Step string `json:"step"`
+	// Version is the service version the operation was applied to.
+	Version int `json:"version,omitempty"`
+	// Status is the outcome of the step.
+	Status argparser.BulkStatus `json:"status"`
+	// Error is the reason the step failed.
+	Error string `json:"error,omitempty"`
+}
+
+// BatchReport is the JSON representation of the batch.
+type BatchReport struct {
+	ServiceID string `json:"service_id"`
+	// SourceVersion is the version that was cloned.
+	SourceVersion int `json:"source_version"`
+	// Version is the clone (zero if cloning failed).
+	Version int `json:"version,omitempty"`
+	// PreviousActiveVersion is the version that was active before the batch
+	// (zero if no version was active or --activate wasn't set).
+	PreviousActiveVersion int         `json:"previous_active_version,omitempty"`
+	Steps                 []BatchStep `json:"steps"`
+}
+
+// NewBatchCommand returns a usable command registered under the parent.
+func NewBatchCommand(parent argparser.Registerer, g *global.Data) *BatchCommand {
+	c := BatchCommand{
+		Base: argparser.Base{
+			Globals: g,
+		},
+	}
+	c.CmdClause = parent.Command("batch", "Clone a Fastly service version and apply operations (e.g. --comment, --activate) to the clone, or apply an operation to a list of versions (--versions)")
+
+	// Optional.
+	c.CmdClause.Flag("activate", "Activate the clone once the other operations succeed").BoolVar(&c.activate)
+	c.CmdClause.Flag("clone-from", "The version to clone: 'latest', 'active', 'editable', or the number of a specific version (required unless --versions is set)").Action(c.cloneFrom.Set).StringVar(&c.cloneFrom.Value)
+	c.CmdClause.Flag("comment", "Human-readable comment to set on the clone").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	c.CmdClause.Flag(argparser.FlagOnErrorName, argparser.FlagOnErrorDesc+" (only with --versions)").Default(argparser.OnErrorAbort).HintOptions(argparser.OnErrorBehaviours...).EnumVar(&c.onError, argparser.OnErrorBehaviours...)
+	c.CmdClause.Flag("operation", "The operation to apply to each of the --versions, in order").HintOptions(batchOperations...).EnumVar(&c.operation, batchOperations...)
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.CmdClause.Flag("versions", "The numbers of the versions to apply the --operation to (comma-separated or repeated)").StringsVar(&c.versions)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *BatchCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.JSONOutput.Enabled {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+	if len(c.versions) > 0 {
+		return c.execList(out)
+	}
+	if c.operation != "" {
+		return fmt.Errorf("error parsing arguments: the --operation flag requires the --versions flag")
+	}
+	if !c.cloneFrom.WasSet {
+		return fmt.Errorf("error parsing arguments: required flag --clone-from not provided (or set --versions)")
+	}
+
+	serviceID, source, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.cloneFrom,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(source),
+		})
+		return err
+	}
+
+	report := BatchReport{
+		ServiceID:     serviceID,
+		SourceVersion: fastly.ToValue(source.Number),
+	}
+	err = c.run(&report)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": report.SourceVersion,
+			"Clone":           report.Version,
+		})
+	}
+
+	if ok, jsonErr := c.WriteOutput(out, report); ok {
+		if jsonErr != nil {
+			return jsonErr
+		}
+		if err == nil && c.activate {
+			updateVersionLock(c.Globals, io.Discard, serviceID, report.Version)
+		}
+		return batchError(report, err)
+	}
+
+	tw := text.NewTable(out)
+	tw.AddHeader("STEP", "VERSION", "STATUS", "ERROR")
+	for _, s := range report.Steps {
+		tw.AddLine(s.Step, s.Version, s.Status, s.Error)
+	}
+	tw.Print()
+	text.Break(out)
+
+	if err != nil {
+		return batchError(report, err)
+	}
+	if !c.activate {
+		text.Success(out, "Cloned service %s version %d to version %d", serviceID, report.SourceVersion, report.Version)
+		return nil
+	}
+	text.Success(out, "Cloned service %s version %d to version %d and activated it", serviceID, report.SourceVersion, report.Version)
+	updateVersionLock(c.Globals, out, serviceID, report.Version)
+	if report.PreviousActiveVersion > 0 {
+		text.Info(out, "To roll back, reactivate the previously active version:")
+		text.Indent(out, 4, "fastly service-version activate --service-id %s --version %d", serviceID, report.PreviousActiveVersion)
+	}
+	return nil
+}
+
+// execList applies the --operation to each of the --versions in order,
+// reporting the outcome for every version.
+func (c *BatchCommand) execList(out io.Writer) error {
+	switch {
+	case c.cloneFrom.WasSet || c.comment.WasSet || c.activate:
+		return fmt.Errorf("error parsing arguments: the --versions flag can't be combined with --clone-from, --comment or --activate (use --operation instead)")
+	case c.operation == "":
+		return fmt.Errorf("error parsing arguments: the --versions flag requires the --operation flag")
+	}
+	versions, err := parseVersionList(c.versions)
+	if err != nil {
+		return err
+	}
+
+	serviceID, source, flag, err := argparser.ServiceID(c.serviceName, *c.Globals.Manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if source == manifest.SourceUndefined {
+		return fsterr.ErrNoServiceID
+	}
+	if c.Globals.Verbose() {
+		argparser.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	result := argparser.BulkResult{Action: c.operation, Noun: "versions", OnError: c.onError}
+	var (
+		activated int
+		clones    []string
+	)
+	for _, v := range versions {
+		id := strconv.Itoa(v)
+		if result.Aborted() {
+			result.Skipped(id)
+			continue
+		}
+		clone, err := c.applyOperation(serviceID, v)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": v,
+				"Operation":       c.operation,
+			})
+			result.Failed(id, fsterr.Deduce(err).Inner)
+			continue
+		}
+		result.Succeeded(id)
+		if c.operation == batchActivate {
+			activated = v
+		}
+		if clone != nil {
+			clones = append(clones, fmt.Sprintf("%d to version %d", v, fastly.ToValue(clone.Number)))
+		}
+	}
+
+	if err := result.Render(out, c.JSONOutput); err != nil {
+		return err
+	}
+	if len(clones) > 0 && !c.JSONOutput.Enabled {
+		text.Break(out)
+		text.Info(out, "Cloned version %s.", strings.Join(clones, ", "))
+	}
+
+	// NOTE: The versions are activated in order, so the last one activated is
+	// the active version.
+	if activated > 0 {
+		if c.JSONOutput.Enabled {
+			updateVersionLock(c.Globals, io.Discard, serviceID, activated)
+		} else {
+			text.Break(out)
+			updateVersionLock(c.Globals, out, serviceID, activated)
+		}
+	}
+	return result.Err(false)
+}
+
+// applyOperation applies the --operation to the service version, returning
+// the clone if the operation is clone.
+func (c *BatchCommand) applyOperation(serviceID string, version int) (*fastly.Version, error) {
+	switch c.operation {
+	case batchActivate:
+		_, err := c.Globals.APIClient.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+		})
+		return nil, err
+	case batchDeactivate:
+		_, err := c.Globals.APIClient.DeactivateVersion(&fastly.DeactivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+		})
+		return nil, err
+	default:
+		return c.Globals.APIClient.CloneVersion(&fastly.CloneVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+		})
+	}
+}
+
+// parseVersionList returns the version numbers of the --versions flag values,
+// each of which can be a comma-separated list.
+func parseVersionList(values []string) ([]int, error) {
+	var versions []int
+	for _, value := range values {
+		for _, s := range strings.Split(value, ",") {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				continue
+			}
+			v, err := strconv.Atoi(s)
+			if err != nil || v < 1 {
+				return nil, fmt.Errorf("error parsing arguments: invalid version number '%s' in --versions", s)
+			}
+			versions = append(versions, v)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("error parsing arguments: the --versions flag requires at least one version number")
+	}
+	return versions, nil
+}
+
+// batchOperation is a step of the batch.
+type batchOperation struct {
+	name string
+	fn   func() error
+}
+
+// run executes the steps in order, stopping at the first that fails (the
+// remaining steps are recorded as skipped).
+func (c *BatchCommand) run(report *BatchReport) error {
+	steps := []batchOperation{
+		{"clone", func() error {
+			v, err := c.Globals.APIClient.CloneVersion(&fastly.CloneVersionInput{
+				ServiceID:      report.ServiceID,
+				ServiceVersion: report.SourceVersion,
+			})
+			if err == nil {
+				report.Version = fastly.ToValue(v.Number)
+			}
+			return err
+		}},
+	}
+	if c.comment.WasSet {
+		steps = append(steps, batchOperation{"update", func() error {
+			_, err := c.Globals.APIClient.UpdateVersion(&fastly.UpdateVersionInput{
+				ServiceID:      report.ServiceID,
+				ServiceVersion: report.Version,
+				Comment:        &c.comment.Value,
+			})
+			return err
+		}})
+	}
+	if c.activate {
+		steps = append(steps, batchOperation{"activate", func() error {
+			vs, err := c.Globals.APIClient.ListVersions(&fastly.ListVersionsInput{ServiceID: report.ServiceID})
+			if err != nil {
+				return fmt.Errorf("error listing versions: %w", err)
+			}
+			if active, err := argparser.GetActiveVersion(vs); err == nil {
+				report.PreviousActiveVersion = fastly.ToValue(active.Number)
+			}
+			_, err = c.Globals.APIClient.ActivateVersion(&fastly.ActivateVersionInput{
+				ServiceID:      report.ServiceID,
+				ServiceVersion: report.Version,
+			})
+			return err
+		}})
+	}
+
+	var failed error
+	for _, s := range steps {
+		step := BatchStep{Step: s.name, Version: report.Version}
+		if s.name == "clone" {
+			step.Version = report.SourceVersion
+		}
+		switch {
+		case failed != nil:
+			step.Status = argparser.BulkSkipped
+		default:
+			step.Status = argparser.BulkSucceeded
+			if err := s.fn(); err != nil {
+				failed = fmt.Errorf("error running the %s step: %w", s.name, err)
+				step.Status = argparser.BulkFailed
+				step.Error = fsterr.Deduce(err).Inner.Error()
+			}
+		}
+		report.Steps = append(report.Steps, step)
+	}
+	return failed
+}
+
+// batchError returns err with guidance on how to recover from the state the
+// service was left in (see BatchReport).
+func batchError(report BatchReport, err error) error {
+	if err == nil {
+		return nil
+	}
+	if report.Version == 0 {
+		return fsterr.RemediationError{
+			Inner:       err,
+			Remediation: "The version wasn't cloned, so the service is unchanged.",
+		}
+	}
+	var steps []string
+	for _, s := range report.Steps {
+		if s.Status != argparser.BulkSucceeded && s.Step != "clone" {
+			steps = append(steps, s.Step)
+		}
+	}
+	return fsterr.RemediationError{
+		Inner: err,
+		Remediation: strings.Join([]string{
+			fmt.Sprintf("Version %d was cloned to version %d, but the %s step(s) weren't completed.", report.SourceVersion, report.Version, strings.Join(steps, " and ")),
+			"The active version is unchanged and the clone remains editable (it can be left unused).",
+			"Fix the cause of the error, then run the remaining steps against the clone, e.g.",
+			fmt.Sprintf("`fastly service-version activate --service-id %s --version %d`.", report.ServiceID, report.Version),
+		}, " "),
+	}
+}
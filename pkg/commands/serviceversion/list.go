@@ -11,7 +11,6 @@ import (
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/text"
-	fsttime "github.com/fastly/cli/pkg/time"
 )
 
 // ListCommand calls the Fastly API to list services.
@@ -32,6 +31,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	}
 	c.CmdClause = parent.Command("list", "List Fastly service versions")
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -71,19 +71,19 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
 	if !c.Globals.Verbose() {
 		tw := text.NewTable(out)
-		tw.AddHeader("NUMBER", "ACTIVE", "STAGED", "LAST EDITED (UTC)")
+		tw.AddHeader("NUMBER", "ACTIVE", "STAGED", "LAST EDITED")
 		for _, version := range o {
 			tw.AddLine(
 				fastly.ToValue(version.Number),
 				fastly.ToValue(version.Active),
 				fastly.ToValue(version.Staging),
-				parseTime(version.UpdatedAt),
+				parseTime(out, version.UpdatedAt),
 			)
 		}
 		tw.Print()
@@ -100,9 +100,9 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 	return nil
 }
 
-func parseTime(ua *time.Time) string {
+func parseTime(out io.Writer, ua *time.Time) string {
 	if ua == nil {
 		return ""
 	}
-	return ua.UTC().Format(fsttime.Format)
+	return text.FormatTime(out, *ua)
 }
@@ -0,0 +1,78 @@
+package serviceversion
+
+import (
+	"io"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/versionlock"
+)
+
+// PinCommand writes the .fastly-version lockfile, pinning the service version
+// used by commands run in the working directory.
+type PinCommand struct {
+	argparser.Base
+	serviceName    argparser.OptionalServiceNameID
+	serviceVersion argparser.OptionalServiceVersion
+}
+
+// NewPinCommand returns a usable command registered under the parent.
+func NewPinCommand(parent argparser.Registerer, g *global.Data) *PinCommand {
+	var c PinCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("pin", "Pin the Fastly service version used by commands run in this directory (via a "+versionlock.FileName+" file)")
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagServiceIDName,
+		Description: argparser.FlagServiceIDDesc,
+		Dst:         &g.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        argparser.FlagServiceName,
+		Description: argparser.FlagServiceNameDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(argparser.StringFlagOpts{
+		Name:        argparser.FlagVersionName,
+		Description: argparser.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *PinCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := argparser.ServiceDetails(argparser.ServiceDetailsOpts{
+		APIClient:          c.Globals.APIClient,
+		Manifest:           *c.Globals.Manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flags.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	lock := versionlock.Lock{
+		ServiceID: serviceID,
+		Version:   fastly.ToValue(serviceVersion.Number),
+	}
+	if err := versionlock.Write(versionlock.Path, lock); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	text.Success(out, "Pinned service %s to version %d (via %s)", lock.ServiceID, lock.Version, versionlock.FileName)
+	return nil
+}
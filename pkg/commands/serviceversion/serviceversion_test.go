@@ -1,14 +1,18 @@
 package serviceversion_test
 
 import (
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/fastly/go-fastly/v9/fastly"
 
 	root "github.com/fastly/cli/pkg/commands/serviceversion"
+	"github.com/fastly/cli/pkg/global"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/threadsafe"
+	"github.com/fastly/cli/pkg/versionlock"
 )
 
 func TestVersionClone(t *testing.T) {
@@ -23,6 +27,27 @@ func TestVersionClone(t *testing.T) {
 			Args:      "--service-id 123",
 			WantError: "error parsing arguments: required flag --version not provided",
 		},
+		{
+			Name: "validate the version is read from the lockfile when --version isn't set",
+			Args: "--service-id 123",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+			},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				testutil.AssertNoError(t, versionlock.Write(tempVersionLock(t), versionlock.Lock{ServiceID: "123", Version: 1}))
+			},
+			WantOutput: "Cloned service 123 version 1 to version 4",
+		},
+		{
+			Name: "validate a lockfile for another service doesn't provide the version",
+			Args: "--service-id 123",
+			API:  mock.API{ListVersionsFn: testutil.ListVersions},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				testutil.AssertNoError(t, versionlock.Write(tempVersionLock(t), versionlock.Lock{ServiceID: "456", Version: 1}))
+			},
+			WantError: "the .fastly-version file pins a version of service 456, not 123",
+		},
 		{
 			Name: "validate successful clone",
 			Args: "--service-id 123 --version 1",
@@ -164,11 +189,228 @@ func TestVersionActivate(t *testing.T) {
 			},
 			WantOutput: "Activated service 123 version 3",
 		},
+		{
+			Name: "validate an existing lockfile is updated",
+			Args: "--service-id 123 --version 3",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				ActivateVersionFn: activateVersionOK,
+			},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				path := tempVersionLock(t)
+				testutil.AssertNoError(t, versionlock.Write(path, versionlock.Lock{ServiceID: "123", Version: 1}))
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				lock, ok, err := versionlock.Read(versionlock.Path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertBool(t, true, ok)
+				testutil.AssertEqual(t, versionlock.Lock{ServiceID: "123", Version: 3}, lock)
+			},
+			WantOutput: "Updated .fastly-version to pin version 3",
+		},
+		{
+			Name: "validate a lockfile for another service is left as-is",
+			Args: "--service-id 123 --version 3",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				ActivateVersionFn: activateVersionOK,
+			},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				path := tempVersionLock(t)
+				testutil.AssertNoError(t, versionlock.Write(path, versionlock.Lock{ServiceID: "456", Version: 1}))
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				lock, _, err := versionlock.Read(versionlock.Path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertEqual(t, versionlock.Lock{ServiceID: "456", Version: 1}, lock)
+			},
+			DontWantOutput: "Updated .fastly-version",
+		},
 	}
 
 	testutil.RunCLIScenarios(t, []string{root.CommandName, "activate"}, scenarios)
 }
 
+func TestVersionBatch(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Name:      "validate missing --clone-from flag",
+			Args:      "--service-id 123",
+			WantError: "error parsing arguments: required flag --clone-from not provided (or set --versions)",
+		},
+		{
+			Name:      "validate --versions requires --operation",
+			Args:      "--service-id 123 --versions 1,2",
+			WantError: "error parsing arguments: the --versions flag requires the --operation flag",
+		},
+		{
+			Name:      "validate --versions can't be combined with --clone-from",
+			Args:      "--service-id 123 --versions 1 --operation clone --clone-from 1",
+			WantError: "error parsing arguments: the --versions flag can't be combined with --clone-from, --comment or --activate",
+		},
+		{
+			Name:      "validate an invalid version number",
+			Args:      "--service-id 123 --versions 1,latest --operation activate",
+			WantError: "error parsing arguments: invalid version number 'latest' in --versions",
+		},
+		{
+			Name: "validate cloning a list of versions",
+			Args: "--service-id 123 --versions 1,2 --versions 3 --operation clone",
+			API: mock.API{
+				CloneVersionFn: func(i *fastly.CloneVersionInput) (*fastly.Version, error) {
+					return &fastly.Version{Number: fastly.ToPointer(i.ServiceVersion + 10)}, nil
+				},
+			},
+			WantOutputs: []string{
+				"3      3          0       0",
+				"Cloned version 1 to version 11, 2 to version 12, 3 to version 13.",
+			},
+		},
+		{
+			Name: "validate deactivating a list of versions continues past a failure",
+			Args: "--service-id 123 --versions 1,2 --operation deactivate --on-error continue",
+			API: mock.API{
+				DeactivateVersionFn: func(i *fastly.DeactivateVersionInput) (*fastly.Version, error) {
+					if i.ServiceVersion == 1 {
+						return nil, testutil.Err
+					}
+					return deactivateVersionOK(i)
+				},
+			},
+			WantError: "failed to deactivate 1 of 2 versions",
+			WantOutputs: []string{
+				"2      1          1       0",
+				"1       " + testutil.Err.Error(),
+			},
+		},
+		{
+			Name: "validate activating a list of versions aborts at the first failure",
+			Args: "--service-id 123 --versions 1,2 --operation activate --json",
+			API: mock.API{
+				ActivateVersionFn: activateVersionError,
+			},
+			WantError: "failed to activate 1 of 2 versions",
+			WantOutputs: []string{
+				`"failed": 1`,
+				`"skipped": 1`,
+			},
+		},
+		{
+			Name: "validate activating a list of versions updates an existing lockfile",
+			Args: "--service-id 123 --versions 2,3 --operation activate",
+			API: mock.API{
+				ActivateVersionFn: activateVersionOK,
+			},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				path := tempVersionLock(t)
+				testutil.AssertNoError(t, versionlock.Write(path, versionlock.Lock{ServiceID: "123", Version: 1}))
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				lock, ok, err := versionlock.Read(versionlock.Path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertBool(t, true, ok)
+				testutil.AssertEqual(t, versionlock.Lock{ServiceID: "123", Version: 3}, lock)
+			},
+			WantOutput: "Updated .fastly-version to pin version 3",
+		},
+		{
+			Name: "validate clone, update and activate",
+			Args: "--service-id 123 --clone-from active --comment foo --activate",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(5),
+				UpdateVersionFn:   updateVersionOK,
+				ActivateVersionFn: activateVersionOK,
+			},
+			WantOutputs: []string{
+				"clone     1        succeeded",
+				"update    5        succeeded",
+				"activate  5        succeeded",
+				"Cloned service 123 version 1 to version 5 and activated it",
+				"fastly service-version activate --service-id 123 --version 1",
+			},
+		},
+		{
+			Name: "validate the remaining steps are skipped once a step fails",
+			Args: "--service-id 123 --clone-from 3 --comment foo --activate",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				CloneVersionFn:  testutil.CloneVersionResult(5),
+				UpdateVersionFn: updateVersionError,
+			},
+			WantError: "error running the update step: " + testutil.Err.Error(),
+			WantOutputs: []string{
+				"update    5        failed     " + testutil.Err.Error(),
+				"activate  5        skipped",
+			},
+		},
+		{
+			Name: "validate the service is unchanged if cloning fails",
+			Args: "--service-id 123 --clone-from 1 --activate --json",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionError,
+			},
+			WantError: "error running the clone step: " + testutil.Err.Error(),
+			WantOutputs: []string{
+				`"source_version": 1`,
+				`"step": "clone"`,
+				`"status": "failed"`,
+				`"status": "skipped"`,
+			},
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "batch"}, scenarios)
+}
+
+func TestVersionPin(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Args:      "--service-id 123",
+			WantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			Args: "--service-id 123 --version latest",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				tempVersionLock(t)
+			},
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data, _ *threadsafe.Buffer) {
+				lock, ok, err := versionlock.Read(versionlock.Path)
+				testutil.AssertNoError(t, err)
+				testutil.AssertBool(t, true, ok)
+				testutil.AssertEqual(t, versionlock.Lock{ServiceID: "123", Version: 4}, lock)
+			},
+			WantOutput: "Pinned service 123 to version 4 (via .fastly-version)",
+		},
+		{
+			Args: "--service-id 123 --version 5",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			Setup: func(t *testing.T, _ *testutil.CLIScenario, _ *global.Data) {
+				tempVersionLock(t)
+			},
+			WantError: "specified service version not found: 5",
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "pin"}, scenarios)
+}
+
+// tempVersionLock points the lockfile at a temporary directory (for the
+// duration of the test) and returns its path.
+func tempVersionLock(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), versionlock.FileName)
+	original := versionlock.Path
+	versionlock.Path = path
+	t.Cleanup(func() { versionlock.Path = original })
+	return path
+}
+
 func TestVersionDeactivate(t *testing.T) {
 	scenarios := []testutil.CLIScenario{
 		{
@@ -326,11 +568,11 @@ func TestVersionUnstage(t *testing.T) {
 }
 
 var listVersionsShortOutput = strings.TrimSpace(`
-NUMBER  ACTIVE  STAGED  LAST EDITED (UTC)
-1       true    false   2000-01-01 01:00
-2       false   false   2000-01-02 01:00
-3       false   false   2000-01-03 01:00
-4       false   true    2000-01-04 01:00
+NUMBER  ACTIVE  STAGED  LAST EDITED
+1       true    false   2000-01-01T01:00:00Z
+2       false   false   2000-01-02T01:00:00Z
+3       false   false   2000-01-03T01:00:00Z
+4       false   true    2000-01-04T01:00:00Z
 `) + "\n"
 
 var listVersionsVerboseOutput = strings.TrimSpace(`
@@ -344,21 +586,21 @@ Versions: 4
 		Number: 1
 		Service ID: 123
 		Active: true
-		Last edited (UTC): 2000-01-01 01:00
+		Last edited: 2000-01-01T01:00:00Z
 	Version 2/4
 		Number: 2
 		Service ID: 123
 		Locked: true
-		Last edited (UTC): 2000-01-02 01:00
+		Last edited: 2000-01-02T01:00:00Z
 	Version 3/4
 		Number: 3
 		Service ID: 123
-		Last edited (UTC): 2000-01-03 01:00
+		Last edited: 2000-01-03T01:00:00Z
 	Version 4/4
 		Number: 4
 		Service ID: 123
 		Staged: true
-		Last edited (UTC): 2000-01-04 01:00
+		Last edited: 2000-01-04T01:00:00Z
 `) + "\n\n"
 
 func updateVersionOK(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
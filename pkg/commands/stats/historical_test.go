@@ -61,7 +61,7 @@ By: day
 Region: all
 ---
 Service ID:                                    123
-Start Time:          1970-01-01 00:00:00 +0000 UTC
+Start Time:                   1970-01-01T00:00:00Z
 --------------------------------------------------
 Hit Rate:                                    0.00%
 Avg Hit Time:                               0.00µs
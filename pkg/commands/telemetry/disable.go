@@ -0,0 +1,34 @@
+package telemetry
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DisableCommand permanently opts-out of anonymous usage reporting.
+type DisableCommand struct {
+	argparser.Base
+}
+
+// NewDisableCommand returns a usable command registered under the parent.
+func NewDisableCommand(parent argparser.Registerer, g *global.Data) *DisableCommand {
+	var c DisableCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("disable", "Permanently disable anonymous usage reporting (overrides FASTLY_TELEMETRY)")
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DisableCommand) Exec(_ io.Reader, out io.Writer) error {
+	c.Globals.Config.CLI.TelemetryDisabled = true
+	if err := c.Globals.Config.Write(c.Globals.ConfigPath); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error saving config file: %w", err)
+	}
+	text.Success(out, "Anonymous usage reporting disabled")
+	return nil
+}
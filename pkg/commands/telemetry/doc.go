@@ -0,0 +1,3 @@
+// Package telemetry contains commands to inspect and manage anonymous usage
+// reporting.
+package telemetry
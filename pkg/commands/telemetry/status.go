@@ -0,0 +1,69 @@
+package telemetry
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/argparser"
+	"github.com/fastly/cli/pkg/env"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/telemetry"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// StatusCommand displays whether anonymous usage reporting is enabled.
+type StatusCommand struct {
+	argparser.Base
+	argparser.JSONOutput
+}
+
+// NewStatusCommand returns a usable command registered under the parent.
+func NewStatusCommand(parent argparser.Registerer, g *global.Data) *StatusCommand {
+	var c StatusCommand
+	c.Globals = g
+	c.CmdClause = parent.Command("status", "Display whether anonymous usage reporting is enabled")
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
+	return &c
+}
+
+// Status is the structured representation of the telemetry status.
+type Status struct {
+	Enabled  bool   `json:"enabled"`
+	Reason   string `json:"reason"`
+	Endpoint string `json:"endpoint"`
+}
+
+// Exec invokes the application logic for the command.
+func (c *StatusCommand) Exec(_ io.Reader, out io.Writer) error {
+	s := Status{
+		Enabled:  telemetry.Enabled(c.Globals.Env, c.Globals.Config.CLI),
+		Endpoint: telemetry.EndpointFromEnv(c.Globals.Env),
+	}
+	switch {
+	case telemetry.Disabled || telemetry.DisabledByEnv(c.Globals.Env):
+		s.Reason = "disabled via " + env.DisableTelemetry
+	case c.Globals.Config.CLI.TelemetryDisabled:
+		s.Reason = "disabled via `fastly telemetry disable`"
+	case s.Enabled:
+		s.Reason = "enabled via " + env.Telemetry + "=1"
+	default:
+		s.Reason = "disabled by default (set " + env.Telemetry + "=1 to opt-in)"
+	}
+
+	if ok, err := c.WriteOutput(out, s); ok {
+		return err
+	}
+
+	if s.Enabled {
+		text.Success(out, "Anonymous usage reporting is %s.", s.Reason)
+		text.Break(out)
+		if s.Endpoint == "" {
+			text.Output(out, "Nothing is sent as no endpoint is set (see %s).", env.TelemetryEndpoint)
+			return nil
+		}
+		text.Output(out, "Only command names are sent (never arguments, flag values or IDs) to: %s", s.Endpoint)
+		return nil
+	}
+	text.Info(out, "Anonymous usage reporting is %s.", s.Reason)
+	return nil
+}
@@ -0,0 +1,83 @@
+package telemetry_test
+
+import (
+	"testing"
+
+	root "github.com/fastly/cli/pkg/commands/telemetry"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/threadsafe"
+)
+
+func TestTelemetryStatus(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Name:       "validate disabled by default",
+			WantOutput: "Anonymous usage reporting is disabled by default (set FASTLY_TELEMETRY=1 to opt-in).",
+		},
+		{
+			Name: "validate enabled via environment",
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.Env.Telemetry = "1"
+			},
+			WantOutputs: []string{
+				"Anonymous usage reporting is enabled via FASTLY_TELEMETRY=1.",
+				"Nothing is sent as no endpoint is set (see FASTLY_TELEMETRY_ENDPOINT).",
+			},
+		},
+		{
+			Name: "validate enabled with an endpoint",
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.Env.Telemetry = "1"
+				opts.Env.TelemetryEndpoint = "http://127.0.0.1/telemetry"
+			},
+			WantOutput: "Only command names are sent (never arguments, flag values or IDs) to: http://127.0.0.1/telemetry",
+		},
+		{
+			Name: "validate disabled via config overrides environment",
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.Env.Telemetry = "1"
+			},
+			ConfigFile: &config.File{
+				CLI: config.CLI{TelemetryDisabled: true},
+			},
+			WantOutput: "Anonymous usage reporting is disabled via `fastly telemetry disable`.",
+		},
+		{
+			Name: "validate disabled via environment overrides opt-in",
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.Env.Telemetry = "1"
+				opts.Env.DisableTelemetry = "1"
+			},
+			WantOutput:     "Anonymous usage reporting is disabled via FASTLY_DISABLE_TELEMETRY.",
+			DontWantOutput: "Only command names are sent",
+		},
+		{
+			Name:       "validate --json output",
+			Args:       "--json",
+			WantOutput: `"enabled": false`,
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "status"}, scenarios)
+}
+
+func TestTelemetryDisable(t *testing.T) {
+	scenarios := []testutil.CLIScenario{
+		{
+			Name: "validate telemetry is disabled in config",
+			Setup: func(_ *testing.T, _ *testutil.CLIScenario, opts *global.Data) {
+				opts.Env.Telemetry = "1"
+			},
+			WantOutput: "Anonymous usage reporting disabled",
+			Validator: func(t *testing.T, _ *testutil.CLIScenario, opts *global.Data, _ *threadsafe.Buffer) {
+				if !opts.Config.CLI.TelemetryDisabled {
+					t.Error("want telemetry_disabled to be set in the config")
+				}
+			},
+		},
+	}
+
+	testutil.RunCLIScenarios(t, []string{root.CommandName, "disable"}, scenarios)
+}
@@ -23,8 +23,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	c.CmdClause.Flag("filter-bulk", "Optionally filter by the bulk attribute").Action(c.filterBulk.Set).BoolVar(&c.filterBulk.Value)
 	c.CmdClause.Flag("include", "Include related objects (comma-separated values)").HintOptions(include).EnumVar(&c.include, include)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.pageNumber)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.pageSize)
+	c.RegisterFlagInt(c.PageSizeFlag()) // --page-size
+	c.RegisterFlagInt(c.PerPageFlag())  // --per-page
 
 	return &c
 }
@@ -33,11 +35,11 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	filterBulk argparser.OptionalBool
 	include    string
 	pageNumber int
-	pageSize   int
 }
 
 // Exec invokes the application logic for the command.
@@ -46,7 +48,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	input := c.constructInput()
+	input := c.constructInput(out)
 
 	o, err := c.Globals.APIClient.ListCustomTLSConfigurations(input)
 	if err != nil {
@@ -54,12 +56,12 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 			"Filter Bulk": c.filterBulk,
 			"Include":     c.include,
 			"Page Number": c.pageNumber,
-			"Page Size":   c.pageSize,
+			"Page Size":   c.PageSize.Value,
 		})
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -75,7 +77,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
-func (c *ListCommand) constructInput() *fastly.ListCustomTLSConfigurationsInput {
+func (c *ListCommand) constructInput(out io.Writer) *fastly.ListCustomTLSConfigurationsInput {
 	var input fastly.ListCustomTLSConfigurationsInput
 
 	if c.filterBulk.WasSet {
@@ -87,8 +89,8 @@ func (c *ListCommand) constructInput() *fastly.ListCustomTLSConfigurationsInput
 	if c.pageNumber > 0 {
 		input.PageNumber = c.pageNumber
 	}
-	if c.pageSize > 0 {
-		input.PageSize = c.pageSize
+	if size := c.PageSizeInput(c.Globals, out); size != nil {
+		input.PageSize = *size
 	}
 
 	return &input
@@ -127,10 +129,10 @@ func (c *ListCommand) printVerbose(out io.Writer, rs []*fastly.CustomTLSConfigur
 		}
 
 		if r.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 		}
 		if r.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", r.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *r.UpdatedAt))
 		}
 
 		fmt.Fprintf(out, "\n")
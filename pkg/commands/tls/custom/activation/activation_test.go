@@ -121,7 +121,7 @@ func TestTLSCustomActivationDescribe(t *testing.T) {
 				},
 			},
 			Args:       "--id example",
-			WantOutput: "\nID: " + mockResponseID + "\nCreated at: 2021-06-15 23:00:00 +0000 UTC\n",
+			WantOutput: "\nID: " + mockResponseID + "\nCreated at: 2021-06-15T23:00:00Z\n",
 		},
 	}
 
@@ -153,7 +153,7 @@ func TestTLSCustomActivationList(t *testing.T) {
 				},
 			},
 			Args:       "--verbose",
-			WantOutput: "\nID: " + mockResponseID + "\nCreated at: 2021-06-15 23:00:00 +0000 UTC\n",
+			WantOutput: "\nID: " + mockResponseID + "\nCreated at: 2021-06-15T23:00:00Z\n",
 		},
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
 )
 
 var include = []string{"tls_certificate", "tls_configuration", "tls_domain"}
@@ -25,6 +26,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 	// Optional.
 	c.CmdClause.Flag("include", "Include related objects (comma-separated values)").HintOptions(include...).EnumVar(&c.include, include...)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -54,7 +57,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -79,7 +82,7 @@ func (c *DescribeCommand) print(out io.Writer, r *fastly.TLSActivation) error {
 	fmt.Fprintf(out, "\nID: %s\n", r.ID)
 
 	if r.CreatedAt != nil {
-		fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+		fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 	}
 
 	return nil
@@ -24,8 +24,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	c.CmdClause.Flag("filter-domain", "Limit the returned rules to a specific domain name").StringVar(&c.filterTLSDomainID)
 	c.CmdClause.Flag("include", "Include related objects (comma-separated values)").HintOptions(include...).EnumVar(&c.include, include...)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.pageNumber)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.pageSize)
+	c.RegisterFlagInt(c.PageSizeFlag()) // --page-size
+	c.RegisterFlagInt(c.PerPageFlag())  // --per-page
 
 	return &c
 }
@@ -34,13 +36,13 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	filterTLSCertID   string
 	filterTLSConfigID string
 	filterTLSDomainID string
 	include           string
 	pageNumber        int
-	pageSize          int
 }
 
 // Exec invokes the application logic for the command.
@@ -49,7 +51,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	input := c.constructInput()
+	input := c.constructInput(out)
 
 	o, err := c.Globals.APIClient.ListTLSActivations(input)
 	if err != nil {
@@ -59,12 +61,12 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 			"Filter TLS Domain ID":        c.filterTLSDomainID,
 			"Include":                     c.include,
 			"Page Number":                 c.pageNumber,
-			"Page Size":                   c.pageSize,
+			"Page Size":                   c.PageSize.Value,
 		})
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -80,7 +82,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
-func (c *ListCommand) constructInput() *fastly.ListTLSActivationsInput {
+func (c *ListCommand) constructInput(out io.Writer) *fastly.ListTLSActivationsInput {
 	var input fastly.ListTLSActivationsInput
 
 	if c.filterTLSCertID != "" {
@@ -98,8 +100,8 @@ func (c *ListCommand) constructInput() *fastly.ListTLSActivationsInput {
 	if c.pageNumber > 0 {
 		input.PageNumber = c.pageNumber
 	}
-	if c.pageSize > 0 {
-		input.PageSize = c.pageSize
+	if size := c.PageSizeInput(c.Globals, out); size != nil {
+		input.PageSize = *size
 	}
 
 	return &input
@@ -112,7 +114,7 @@ func (c *ListCommand) printVerbose(out io.Writer, rs []*fastly.TLSActivation) {
 		fmt.Fprintf(out, "\nID: %s\n", r.ID)
 
 		if r.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 		}
 
 		fmt.Fprintf(out, "\n")
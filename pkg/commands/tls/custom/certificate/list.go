@@ -25,8 +25,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	c.CmdClause.Flag("filter-domain", "Limit the returned certificates to those that include the specific domain").StringVar(&c.filterTLSDomainID)
 	c.CmdClause.Flag("include", "Include related objects (comma-separated values)").HintOptions("tls_activations").EnumVar(&c.include, "tls_activations")
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.pageNumber)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.pageSize)
+	c.RegisterFlagInt(c.PageSizeFlag()) // --page-size
+	c.RegisterFlagInt(c.PerPageFlag())  // --per-page
 	c.CmdClause.Flag("sort", "The order in which to list the results by creation date").StringVar(&c.sort)
 
 	return &c
@@ -36,12 +38,12 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	filterNotAfter    string
 	filterTLSDomainID string
 	include           string
 	pageNumber        int
-	pageSize          int
 	sort              string
 }
 
@@ -51,7 +53,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	input := c.constructInput()
+	input := c.constructInput(out)
 
 	o, err := c.Globals.APIClient.ListCustomTLSCertificates(input)
 	if err != nil {
@@ -60,13 +62,13 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 			"Filter TLS Domain ID": c.filterTLSDomainID,
 			"Include":              c.include,
 			"Page Number":          c.pageNumber,
-			"Page Size":            c.pageSize,
+			"Page Size":            c.PageSize.Value,
 			"Sort":                 c.sort,
 		})
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -82,7 +84,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
-func (c *ListCommand) constructInput() *fastly.ListCustomTLSCertificatesInput {
+func (c *ListCommand) constructInput(out io.Writer) *fastly.ListCustomTLSCertificatesInput {
 	var input fastly.ListCustomTLSCertificatesInput
 
 	if c.filterNotAfter != emptyString {
@@ -97,8 +99,8 @@ func (c *ListCommand) constructInput() *fastly.ListCustomTLSCertificatesInput {
 	if c.pageNumber > 0 {
 		input.PageNumber = c.pageNumber
 	}
-	if c.pageSize > 0 {
-		input.PageSize = c.pageSize
+	if size := c.PageSizeInput(c.Globals, out); size != nil {
+		input.PageSize = *size
 	}
 	if c.sort != "" {
 		input.Sort = c.sort
@@ -117,10 +119,10 @@ func printVerbose(out io.Writer, rs []*fastly.CustomTLSCertificate) {
 		fmt.Fprintf(out, "Name: %s\n", r.Name)
 
 		if r.NotAfter != nil {
-			fmt.Fprintf(out, "Not after: %s\n", r.NotAfter)
+			fmt.Fprintf(out, "Not after: %s\n", text.FormatTime(out, *r.NotAfter))
 		}
 		if r.NotBefore != nil {
-			fmt.Fprintf(out, "Not before: %s\n", r.NotBefore)
+			fmt.Fprintf(out, "Not before: %s\n", text.FormatTime(out, *r.NotBefore))
 		}
 
 		fmt.Fprintf(out, "Replace: %t\n", r.Replace)
@@ -128,10 +130,10 @@ func printVerbose(out io.Writer, rs []*fastly.CustomTLSCertificate) {
 		fmt.Fprintf(out, "Signature algorithm: %s\n", r.SignatureAlgorithm)
 
 		if r.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 		}
 		if r.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", r.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *r.UpdatedAt))
 		}
 
 		fmt.Fprintf(out, "\n")
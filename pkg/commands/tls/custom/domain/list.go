@@ -26,8 +26,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	c.CmdClause.Flag("filter-subscription", "Limit the returned domains to those for a given TLS subscription").StringVar(&c.filterTLSSubsID)
 	c.CmdClause.Flag("include", "Include related objects (comma-separated values)").HintOptions("tls_activations").EnumVar(&c.include, "tls_activations")
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.pageNumber)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.pageSize)
+	c.RegisterFlagInt(c.PageSizeFlag()) // --page-size
+	c.RegisterFlagInt(c.PerPageFlag())  // --per-page
 	c.CmdClause.Flag("sort", "The order in which to list the results by creation date").StringVar(&c.sort)
 
 	return &c
@@ -37,13 +39,13 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	filterInUse      argparser.OptionalBool
 	filterTLSCertsID string
 	filterTLSSubsID  string
 	include          string
 	pageNumber       int
-	pageSize         int
 	sort             string
 }
 
@@ -53,7 +55,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	input := c.constructInput()
+	input := c.constructInput(out)
 
 	o, err := c.Globals.APIClient.ListTLSDomains(input)
 	if err != nil {
@@ -63,13 +65,13 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 			"Filter TLS Subscriptions": c.filterTLSSubsID,
 			"Include":                  c.include,
 			"Page Number":              c.pageNumber,
-			"Page Size":                c.pageSize,
+			"Page Size":                c.PageSize.Value,
 			"Sort":                     c.sort,
 		})
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -85,7 +87,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
-func (c *ListCommand) constructInput() *fastly.ListTLSDomainsInput {
+func (c *ListCommand) constructInput(out io.Writer) *fastly.ListTLSDomainsInput {
 	var input fastly.ListTLSDomainsInput
 
 	if c.filterInUse.WasSet {
@@ -103,8 +105,8 @@ func (c *ListCommand) constructInput() *fastly.ListTLSDomainsInput {
 	if c.pageNumber > 0 {
 		input.PageNumber = c.pageNumber
 	}
-	if c.pageSize > 0 {
-		input.PageSize = c.pageSize
+	if size := c.PageSizeInput(c.Globals, out); size != nil {
+		input.PageSize = *size
 	}
 	if c.sort != "" {
 		input.Sort = c.sort
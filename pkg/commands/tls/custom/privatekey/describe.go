@@ -9,6 +9,7 @@ import (
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
 )
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -22,6 +23,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 
 	return &c
 }
@@ -50,7 +53,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -75,7 +78,7 @@ func (c *DescribeCommand) print(out io.Writer, r *fastly.PrivateKey) error {
 	fmt.Fprintf(out, "Public Key SHA1: %s\n", r.PublicKeySHA1)
 
 	if r.CreatedAt != nil {
-		fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+		fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 	}
 
 	fmt.Fprintf(out, "Replace: %t\n", r.Replace)
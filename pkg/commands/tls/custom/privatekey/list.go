@@ -21,8 +21,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	// Optional.
 	c.CmdClause.Flag("filter-in-use", "Limit the returned keys to those without any matching TLS certificates").HintOptions("false").EnumVar(&c.filterInUse, "false")
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.pageNumber)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.pageSize)
+	c.RegisterFlagInt(c.PageSizeFlag()) // --page-size
+	c.RegisterFlagInt(c.PerPageFlag())  // --per-page
 
 	return &c
 }
@@ -31,10 +33,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	filterInUse string
 	pageNumber  int
-	pageSize    int
 }
 
 // Exec invokes the application logic for the command.
@@ -43,19 +45,19 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	input := c.constructInput()
+	input := c.constructInput(out)
 
 	o, err := c.Globals.APIClient.ListPrivateKeys(input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
 			"Filter In Use": c.filterInUse,
 			"Page Number":   c.pageNumber,
-			"Page Size":     c.pageSize,
+			"Page Size":     c.PageSize.Value,
 		})
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -71,7 +73,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
-func (c *ListCommand) constructInput() *fastly.ListPrivateKeysInput {
+func (c *ListCommand) constructInput(out io.Writer) *fastly.ListPrivateKeysInput {
 	var input fastly.ListPrivateKeysInput
 
 	if c.filterInUse != "" {
@@ -80,8 +82,8 @@ func (c *ListCommand) constructInput() *fastly.ListPrivateKeysInput {
 	if c.pageNumber > 0 {
 		input.PageNumber = c.pageNumber
 	}
-	if c.pageSize > 0 {
-		input.PageSize = c.pageSize
+	if size := c.PageSizeInput(c.Globals, out); size != nil {
+		input.PageSize = *size
 	}
 
 	return &input
@@ -98,7 +100,7 @@ func printVerbose(out io.Writer, rs []*fastly.PrivateKey) {
 		fmt.Fprintf(out, "Public Key SHA1: %s\n", r.PublicKeySHA1)
 
 		if r.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 		}
 
 		fmt.Fprintf(out, "Replace: %t\n", r.Replace)
@@ -153,7 +153,7 @@ func TestTLSCustomPrivateKeyDescribe(t *testing.T) {
 				},
 			},
 			Args:       "--id example",
-			WantOutput: "\nID: " + mockResponseID + "\nName: example\nKey Length: 123\nKey Type: example\nPublic Key SHA1: example\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nReplace: false\n",
+			WantOutput: "\nID: " + mockResponseID + "\nName: example\nKey Length: 123\nKey Type: example\nPublic Key SHA1: example\nCreated at: 2021-06-15T23:00:00Z\nReplace: false\n",
 		},
 	}
 
@@ -189,7 +189,7 @@ func TestTLSCustomPrivateKeyList(t *testing.T) {
 				},
 			},
 			Args:       "--verbose",
-			WantOutput: "\nID: " + mockResponseID + "\nName: example\nKey Length: 123\nKey Type: example\nPublic Key SHA1: example\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nReplace: false\n",
+			WantOutput: "\nID: " + mockResponseID + "\nName: example\nKey Length: 123\nKey Type: example\nPublic Key SHA1: example\nCreated at: 2021-06-15T23:00:00Z\nReplace: false\n",
 		},
 	}
 
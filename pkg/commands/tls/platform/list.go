@@ -21,8 +21,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 	// Optional.
 	c.CmdClause.Flag("filter-domain", "Optionally filter by the bulk attribute").StringVar(&c.filterTLSDomainID)
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.pageNumber)
-	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.pageSize)
+	c.RegisterFlagInt(c.PageSizeFlag()) // --page-size
+	c.RegisterFlagInt(c.PerPageFlag())  // --per-page
 	c.CmdClause.Flag("sort", "The order in which to list the results by creation date").StringVar(&c.sort)
 
 	return &c
@@ -32,10 +34,10 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 type ListCommand struct {
 	argparser.Base
 	argparser.JSONOutput
+	argparser.PaginationOutput
 
 	filterTLSDomainID string
 	pageNumber        int
-	pageSize          int
 	sort              string
 }
 
@@ -45,20 +47,20 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fsterr.ErrInvalidVerboseJSONCombo
 	}
 
-	input := c.constructInput()
+	input := c.constructInput(out)
 
 	o, err := c.Globals.APIClient.ListBulkCertificates(input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
 			"Filter TLS Domain ID": c.filterTLSDomainID,
 			"Page Number":          c.pageNumber,
-			"Page Size":            c.pageSize,
+			"Page Size":            c.PageSize.Value,
 			"Sort":                 c.sort,
 		})
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -74,7 +76,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 }
 
 // constructInput transforms values parsed from CLI flags into an object to be used by the API client library.
-func (c *ListCommand) constructInput() *fastly.ListBulkCertificatesInput {
+func (c *ListCommand) constructInput(out io.Writer) *fastly.ListBulkCertificatesInput {
 	var input fastly.ListBulkCertificatesInput
 
 	if c.filterTLSDomainID != "" {
@@ -83,8 +85,8 @@ func (c *ListCommand) constructInput() *fastly.ListBulkCertificatesInput {
 	if c.pageNumber > 0 {
 		input.PageNumber = c.pageNumber
 	}
-	if c.pageSize > 0 {
-		input.PageSize = c.pageSize
+	if size := c.PageSizeInput(c.Globals, out); size != nil {
+		input.PageSize = *size
 	}
 	if c.sort != "" {
 		input.Sort = c.sort
@@ -100,16 +102,16 @@ func printVerbose(out io.Writer, rs []*fastly.BulkCertificate) {
 		fmt.Fprintf(out, "ID: %s\n", r.ID)
 
 		if r.NotAfter != nil {
-			fmt.Fprintf(out, "Not after: %s\n", r.NotAfter)
+			fmt.Fprintf(out, "Not after: %s\n", text.FormatTime(out, *r.NotAfter))
 		}
 		if r.NotBefore != nil {
-			fmt.Fprintf(out, "Not before: %s\n", r.NotBefore)
+			fmt.Fprintf(out, "Not before: %s\n", text.FormatTime(out, *r.NotBefore))
 		}
 		if r.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", r.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *r.CreatedAt))
 		}
 		if r.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", r.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *r.UpdatedAt))
 		}
 
 		fmt.Fprintf(out, "Replace: %t\n", r.Replace)
@@ -118,7 +118,7 @@ func TestTLSPlatformDescribe(t *testing.T) {
 				},
 			},
 			Args:       "--id example",
-			WantOutput: "\nID: 123\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nUpdated at: 2021-06-15 23:00:00 +0000 UTC\nReplace: true\n",
+			WantOutput: "\nID: 123\nCreated at: 2021-06-15T23:00:00Z\nUpdated at: 2021-06-15T23:00:00Z\nReplace: true\n",
 		},
 	}
 
@@ -152,7 +152,7 @@ func TestTLSPlatformList(t *testing.T) {
 				},
 			},
 			Args:       "--verbose",
-			WantOutput: "\nID: " + mockResponseID + "\nCreated at: 2021-06-15 23:00:00 +0000 UTC\nUpdated at: 2021-06-15 23:00:00 +0000 UTC\nReplace: true\n",
+			WantOutput: "\nID: " + mockResponseID + "\nCreated at: 2021-06-15T23:00:00Z\nUpdated at: 2021-06-15T23:00:00Z\nReplace: true\n",
 		},
 	}
 
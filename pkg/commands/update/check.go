@@ -1,15 +1,33 @@
 package update
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 
+	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/github"
 )
 
+// CheckInterval is how long a cached latest version is considered fresh
+// before the release metadata is requested again.
+const CheckInterval = 24 * time.Hour
+
+// CheckWait is how long the results of a check in progress are waited for
+// before the CLI exits, so a fast command still caches the latest version
+// (rather than requesting it again on every invocation).
+const CheckWait = 500 * time.Millisecond
+
+// CachePath is the location of the cached update check result. It sits
+// alongside the error log in the user's config directory.
+var CachePath = filepath.Join(filepath.Dir(fsterr.LogPath), "update-check.json")
+
 // Check if the CLI can be updated.
 func Check(currentVersion string, av github.AssetVersioner) (current, latest semver.Version, shouldUpdate bool) {
 	// nosemgrep (invalid-usage-of-modified-variable)
@@ -23,13 +41,25 @@ func Check(currentVersion string, av github.AssetVersioner) (current, latest sem
 		return current, latest, false
 	}
 
-	// nosemgrep (invalid-usage-of-modified-variable)
-	latest, err = semver.Parse(v)
+	r := compare(currentVersion, v)
+	return r.current, r.latest, r.shouldUpdate
+}
+
+// compare the current CLI version against the latest release version.
+func compare(currentVersion, latestVersion string) (r checkResult) {
+	current, err := semver.Parse(strings.TrimPrefix(currentVersion, "v"))
 	if err != nil {
-		return current, latest, false
+		return r
 	}
+	r.current = current
 
-	return current, latest, latest.GT(current)
+	latest, err := semver.Parse(latestVersion)
+	if err != nil {
+		return r
+	}
+	r.latest = latest
+	r.shouldUpdate = latest.GT(current)
+	return r
 }
 
 type checkResult struct {
@@ -38,12 +68,50 @@ type checkResult struct {
 	shouldUpdate bool
 }
 
+// cache is the persisted result of the most recent update check.
+type cache struct {
+	LastChecked   time.Time `json:"last_checked"`
+	LatestVersion string    `json:"latest_version"`
+}
+
+// readCache returns the cached update check result.
+func readCache(path string) (c cache, err error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the path is determined by the CLI.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+// writeCache persists the update check result.
+func writeCache(path string, c cache) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
 // CheckAsync is a helper function for running Check asynchronously.
 //
-// Launches a goroutine to perform a check for the latest CLI version using the
-// provided context and return a function that will print an informative message
+// The latest version is read from the cache at cachePath if it was checked
+// within CheckInterval. Otherwise a goroutine requests the latest CLI version
+// and caches the result. The returned function prints an informative message
 // to the writer if there is a newer version available.
 //
+// The returned function waits at most CheckWait for a check in progress: if
+// it hasn't completed by then nothing is printed and nothing is cached. A
+// failed check (e.g. due to a network error) is cached like a successful one,
+// but only reports the previously cached version.
+//
 // Callers should invoke CheckAsync via
 //
 //	f := CheckAsync(...)
@@ -52,15 +120,35 @@ func CheckAsync(
 	currentVersion string,
 	av github.AssetVersioner,
 	quietMode bool,
+	cachePath string,
 ) (printResults func(io.Writer)) {
 	results := make(chan checkResult, 1)
-	go func() {
-		current, latest, shouldUpdate := Check(currentVersion, av)
-		results <- checkResult{current, latest, shouldUpdate}
-	}()
+	c, err := readCache(cachePath)
+	if err == nil && time.Since(c.LastChecked) < CheckInterval {
+		results <- compare(currentVersion, c.LatestVersion)
+	} else {
+		go func() {
+			// NOTE: A failed check (e.g. when offline) still records the time of
+			// the attempt, keeping the previously cached version (if any), so
+			// it isn't attempted again until CheckInterval has passed.
+			v, err := av.LatestVersion()
+			if err != nil {
+				v = c.LatestVersion
+			}
+			// NOTE: The result is cached before it's made available, so the
+			// cache is written once the returned function has received it.
+			_ = writeCache(cachePath, cache{LastChecked: time.Now(), LatestVersion: v})
+			results <- compare(currentVersion, v)
+		}()
+	}
 
 	return func(w io.Writer) {
-		result := <-results
+		var result checkResult
+		select {
+		case result = <-results:
+		case <-time.After(CheckWait):
+			return
+		}
 		if result.shouldUpdate && !quietMode {
 			fmt.Fprintf(w, "\n")
 			fmt.Fprintf(w, "A new version of the Fastly CLI is available.\n")
@@ -2,15 +2,16 @@ package update_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/blang/semver"
 	"github.com/fastly/cli/pkg/commands/update"
-	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/github"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/google/go-cmp/cmp"
@@ -68,46 +69,138 @@ func TestCheck(t *testing.T) {
 }
 
 func TestCheckAsync(t *testing.T) {
+	const newVersionOutput = "\nA new version of the Fastly CLI is available.\nCurrent version: 0.0.1\nLatest version: 0.0.2\nRun `fastly update` to get the latest version.\n\n"
+
 	for _, testcase := range []struct {
 		name           string
-		file           config.File
+		cache          string
 		currentVersion string
 		av             github.AssetVersioner
 		wantOutput     string
+		wantCached     string
 	}{
 		{
 			name:           "no last_check same version",
 			currentVersion: "0.0.1",
 			av:             mock.AssetVersioner{AssetVersion: "0.0.1"},
+			wantCached:     "0.0.1",
 		},
 		{
 			name:           "no last_check new version",
 			currentVersion: "0.0.1",
 			av:             mock.AssetVersioner{AssetVersion: "0.0.2"},
-			wantOutput:     "\nA new version of the Fastly CLI is available.\nCurrent version: 0.0.1\nLatest version: 0.0.2\nRun `fastly update` to get the latest version.\n\n",
+			wantOutput:     newVersionOutput,
+			wantCached:     "0.0.2",
 		},
 		{
 			name:           "recent last_check new version",
+			cache:          fmt.Sprintf(`{"last_checked": %q, "latest_version": "0.0.2"}`, time.Now().Add(-time.Hour).Format(time.RFC3339)),
+			currentVersion: "0.0.1",
+			av:             mock.AssetVersioner{AssetVersion: "0.0.3"},
+			wantOutput:     newVersionOutput,
+		},
+		{
+			name:           "stale last_check new version",
+			cache:          fmt.Sprintf(`{"last_checked": %q, "latest_version": "0.0.1"}`, time.Now().Add(-2*update.CheckInterval).Format(time.RFC3339)),
 			currentVersion: "0.0.1",
 			av:             mock.AssetVersioner{AssetVersion: "0.0.2"},
-			wantOutput:     "\nA new version of the Fastly CLI is available.\nCurrent version: 0.0.1\nLatest version: 0.0.2\nRun `fastly update` to get the latest version.\n\n",
+			wantOutput:     newVersionOutput,
+			wantCached:     "0.0.2",
 		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
-			configFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("fastly_TestCheckAsync_%d", time.Now().UnixNano()))
-			defer os.RemoveAll(configFilePath)
+			cachePath := filepath.Join(t.TempDir(), "update-check.json")
+			if testcase.cache != "" {
+				if err := os.WriteFile(cachePath, []byte(testcase.cache), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			var buf bytes.Buffer
 			f := update.CheckAsync(
 				testcase.currentVersion,
 				testcase.av,
 				false,
+				cachePath,
 			)
 			f(&buf)
 
 			if want, have := testcase.wantOutput, buf.String(); want != have {
 				t.Error(cmp.Diff(want, have))
 			}
+			if testcase.wantCached != "" {
+				assertCached(t, cachePath, testcase.wantCached)
+			}
 		})
 	}
 }
+
+func TestCheckAsyncNetworkError(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "update-check.json")
+
+	var buf bytes.Buffer
+	f := update.CheckAsync("0.0.1", mock.AssetVersioner{LatestVersionError: errors.New("network unreachable")}, false, cachePath)
+	f(&buf)
+
+	if have := buf.String(); have != "" {
+		t.Errorf("want no output, have %q", have)
+	}
+
+	// The attempt is cached, so the check isn't repeated (and waited for) by
+	// the next invocation.
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		t.Fatalf("want the attempt to be cached, have %v", err)
+	}
+	if !strings.Contains(string(data), `"last_checked"`) {
+		t.Errorf("want the attempt time to be cached, have %s", data)
+	}
+
+	av := slowAssetVersioner{mock.AssetVersioner{AssetVersion: "0.0.2"}}
+	start := time.Now()
+	update.CheckAsync("0.0.1", av, false, cachePath)(&buf)
+	if have := time.Since(start); have >= update.CheckWait {
+		t.Errorf("want the cached attempt to be used, have waited %s", have)
+	}
+}
+
+// TestCheckAsyncSlowCheck validates a check that doesn't complete within
+// update.CheckWait doesn't block the CLI from exiting.
+func TestCheckAsyncSlowCheck(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "update-check.json")
+
+	var buf bytes.Buffer
+	f := update.CheckAsync("0.0.1", slowAssetVersioner{mock.AssetVersioner{AssetVersion: "0.0.2"}}, false, cachePath)
+	start := time.Now()
+	f(&buf)
+
+	if have := time.Since(start); have > 2*update.CheckWait {
+		t.Errorf("want the check to be waited for at most %s, have %s", update.CheckWait, have)
+	}
+	if have := buf.String(); have != "" {
+		t.Errorf("want no output, have %q", have)
+	}
+}
+
+// slowAssetVersioner is an AssetVersioner whose check takes longer than
+// update.CheckWait.
+type slowAssetVersioner struct {
+	mock.AssetVersioner
+}
+
+func (av slowAssetVersioner) LatestVersion() (string, error) {
+	time.Sleep(4 * update.CheckWait)
+	return av.AssetVersioner.LatestVersion()
+}
+
+// assertCached validates the check cached the want version.
+func assertCached(t *testing.T, path, want string) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), fmt.Sprintf("%q", want)) {
+		t.Errorf("want %s to cache version %s, have %s", path, want, data)
+	}
+}
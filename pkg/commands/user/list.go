@@ -24,6 +24,7 @@ func NewListCommand(parent argparser.Registerer, g *global.Data) *ListCommand {
 		Action:      c.customerID.Set,
 	})
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	return &c
 }
 
@@ -54,7 +55,7 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -95,13 +96,13 @@ func (c *ListCommand) printVerbose(out io.Writer, us []*fastly.User) {
 		fmt.Fprintf(out, "Two Factor Setup Required: %t\n\n", fastly.ToValue(u.TwoFactorSetupRequired))
 
 		if u.CreatedAt != nil {
-			fmt.Fprintf(out, "Created at: %s\n", u.CreatedAt)
+			fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *u.CreatedAt))
 		}
 		if u.UpdatedAt != nil {
-			fmt.Fprintf(out, "Updated at: %s\n", u.UpdatedAt)
+			fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *u.UpdatedAt))
 		}
 		if u.DeletedAt != nil {
-			fmt.Fprintf(out, "Deleted at: %s\n", u.DeletedAt)
+			fmt.Fprintf(out, "Deleted at: %s\n", text.FormatTime(out, *u.DeletedAt))
 		}
 	}
 }
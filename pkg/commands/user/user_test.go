@@ -292,9 +292,9 @@ Require New Password: true
 Two Factor Auth Enabled: true
 Two Factor Setup Required: true
 
-Created at: 2021-06-15 23:00:00 +0000 UTC
-Updated at: 2021-06-15 23:00:00 +0000 UTC
-Deleted at: 2021-06-15 23:00:00 +0000 UTC
+Created at: 2021-06-15T23:00:00Z
+Updated at: 2021-06-15T23:00:00Z
+Deleted at: 2021-06-15T23:00:00Z
 `
 }
 
@@ -312,9 +312,9 @@ Require New Password: false
 Two Factor Auth Enabled: false
 Two Factor Setup Required: false
 
-Created at: 2021-06-15 23:00:00 +0000 UTC
-Updated at: 2021-06-15 23:00:00 +0000 UTC
-Deleted at: 2021-06-15 23:00:00 +0000 UTC
+Created at: 2021-06-15T23:00:00Z
+Updated at: 2021-06-15T23:00:00Z
+Deleted at: 2021-06-15T23:00:00Z
 `
 }
 
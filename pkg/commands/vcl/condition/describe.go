@@ -38,6 +38,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional flags
 	c.RegisterFlagBool(c.JSONFlag())
+	c.RegisterYAMLFlag(c.CmdClause)
+	c.RegisterFieldFlag(c.CmdClause)
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -90,7 +92,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, r); ok {
+	if ok, err := c.WriteOutput(out, r); ok {
 		return err
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/fastly/cli/pkg/argparser"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/text"
 )
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -31,6 +32,8 @@ func NewDescribeCommand(parent argparser.Registerer, g *global.Data) *DescribeCo
 
 	// Optional.
 	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterYAMLFlag(c.CmdClause)  // --yaml
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	c.RegisterFlag(argparser.StringFlagOpts{
 		Name:        argparser.FlagServiceIDName,
 		Description: argparser.FlagServiceIDDesc,
@@ -90,7 +93,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if ok, err := c.WriteJSON(out, o); ok {
+	if ok, err := c.WriteOutput(out, o); ok {
 		return err
 	}
 
@@ -118,13 +121,13 @@ func (c *DescribeCommand) print(out io.Writer, v *fastly.VCL) error {
 	fmt.Fprintf(out, "Main: %t\n", fastly.ToValue(v.Main))
 	fmt.Fprintf(out, "Content: \n%s\n\n", fastly.ToValue(v.Content))
 	if v.CreatedAt != nil {
-		fmt.Fprintf(out, "Created at: %s\n", v.CreatedAt)
+		fmt.Fprintf(out, "Created at: %s\n", text.FormatTime(out, *v.CreatedAt))
 	}
 	if v.UpdatedAt != nil {
-		fmt.Fprintf(out, "Updated at: %s\n", v.UpdatedAt)
+		fmt.Fprintf(out, "Updated at: %s\n", text.FormatTime(out, *v.UpdatedAt))
 	}
 	if v.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted at: %s\n", v.DeletedAt)
+		fmt.Fprintf(out, "Deleted at: %s\n", text.FormatTime(out, *v.DeletedAt))
 	}
 	return nil
 }
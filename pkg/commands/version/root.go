@@ -5,6 +5,7 @@ import (
 	"io"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
 
@@ -29,6 +30,7 @@ func init() {
 // It should be installed under the primary root command.
 type RootCommand struct {
 	argparser.Base
+	argparser.JSONOutput
 }
 
 // CommandName is the string to be used to invoke this command
@@ -42,11 +44,24 @@ func NewRootCommand(parent argparser.Registerer, g *global.Data) *RootCommand {
 		},
 	}
 	c.CmdClause = parent.Command(CommandName, "Display version information for the Fastly CLI")
+	c.RegisterFlagBool(c.JSONFlag()) // --json
+	c.RegisterFieldFlag(c.CmdClause) // --field
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.JSONOutput.Enabled {
+		_, err := c.WriteOutput(out, BuildInfo{
+			Version:   revision.AppVersion,
+			GitSHA:    revision.GitCommit,
+			BuildDate: revision.BuildDate,
+			GoVersion: runtime.Version(),
+			Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+		})
+		return err
+	}
+
 	fmt.Fprintf(out, "Fastly CLI version %s (%s)\n", revision.AppVersion, revision.GitCommit)
 	fmt.Fprintf(out, "Built with %s (%s)\n", revision.GoVersion, Now().Format("2006-01-02"))
 
@@ -66,6 +81,15 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
 	return nil
 }
 
+// BuildInfo is the structured representation of the CLI build information.
+type BuildInfo struct {
+	Version   string `json:"version"`
+	GitSHA    string `json:"git_sha"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+}
+
 // IsPreRelease determines if the given app version is a pre-release.
 //
 // NOTE: this is indicated by the presence of a hyphen, e.g. `v1.0.0-rc.1`.
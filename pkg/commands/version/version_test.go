@@ -2,6 +2,7 @@ package version_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -99,3 +100,26 @@ func TestVersion(t *testing.T) {
 		"",
 	}, "\n"), stdout.String())
 }
+
+func TestVersionJSON(t *testing.T) {
+	var stdout bytes.Buffer
+	args := testutil.SplitArgs("version --json")
+	opts := testutil.MockGlobalData(args, &stdout)
+	app.Init = func(_ []string, _ io.Reader) (*global.Data, error) {
+		return opts, nil
+	}
+	err := app.Run(args, nil)
+	testutil.AssertNoError(t, err)
+
+	var have version.BuildInfo
+	if err := json.Unmarshal(stdout.Bytes(), &have); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, version.BuildInfo{
+		Version:   "v0.0.0-unknown",
+		GitSHA:    "unknown",
+		BuildDate: "unknown",
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}, have)
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/fatih/color"
 	toml "github.com/pelletier/go-toml"
 
 	"github.com/fastly/cli/pkg/env"
@@ -83,9 +84,27 @@ type WasmMetadata struct {
 
 // CLI represents CLI specific configuration.
 type CLI struct {
+	// APIVersion pins the Fastly API version used for requests (see
+	// --api-version).
+	APIVersion string `toml:"api_version"`
+	// ErrorLogBackups is the number of rotated error logs kept (see
+	// --error-log-backups).
+	ErrorLogBackups *int `toml:"error_log_backups,omitempty"`
+	// ErrorLogMaxDays is the age (in days) of the oldest entry in the error
+	// log at which it's rotated (see --error-log-max-days).
+	ErrorLogMaxDays *int `toml:"error_log_max_days,omitempty"`
+	// ErrorLogMaxSize is the size (in bytes) of the error log at which it's
+	// rotated (see --error-log-max-size).
+	ErrorLogMaxSize *int64 `toml:"error_log_max_size,omitempty"`
 	// MetadataNoticeDisplayed indicates if the user has been notified of the
 	// metadata behaviours being enabled by default and how they can opt-out.
 	MetadataNoticeDisplayed bool `toml:"metadata_notice_displayed"`
+	// TelemetryDisabled indicates the user has permanently opted-out of
+	// anonymous usage reporting (see FASTLY_TELEMETRY).
+	TelemetryDisabled bool `toml:"telemetry_disabled"`
+	// TelemetryNoticeDisplayed indicates if the user has been notified of what
+	// anonymous usage reporting collects.
+	TelemetryNoticeDisplayed bool `toml:"telemetry_notice_displayed"`
 	// Version indicates the CLI configuration version.
 	// It is updated each time a change is made to the config structure.
 	Version string `toml:"version"`
@@ -322,7 +341,17 @@ func (f *File) Read(
 	}
 
 	if f.NeedsUpdating(data, out, errLog, verbose) {
-		return f.UseStatic(path)
+		if !f.NeedsMigrating(data) {
+			return f.UseStatic(path)
+		}
+		m, err := f.Migrate(path, data)
+		if err != nil {
+			errLog.Add(err)
+			return err
+		}
+		// NOTE: The notice is written to stderr so it isn't mixed with the
+		// command output (e.g. --json).
+		m.Print(color.Error)
 	}
 
 	return nil
@@ -456,6 +485,15 @@ type Environment struct {
 	APIToken string
 	// DebugMode indicates to the CLI it can display debug information.
 	DebugMode string
+	// DisableTelemetry disables all outbound telemetry when set to a true
+	// value, overriding Telemetry.
+	DisableTelemetry string
+	// NoUpdateCheck disables the background check for a newer CLI version.
+	NoUpdateCheck string
+	// Telemetry enables anonymous usage reporting when set to "1".
+	Telemetry string
+	// TelemetryEndpoint is the endpoint usage counts are sent to.
+	TelemetryEndpoint string
 	// UseSSO indicates if user wants to use SSO/OAuth token flow.
 	// 1: enabled, 0: disabled.
 	UseSSO string
@@ -471,6 +509,10 @@ func (e *Environment) Read(state map[string]string) {
 	e.APIEndpoint = state[env.APIEndpoint]
 	e.APIToken = state[env.APIToken]
 	e.DebugMode = state[env.DebugMode]
+	e.DisableTelemetry = state[env.DisableTelemetry]
+	e.NoUpdateCheck = state[env.NoUpdateCheck]
+	e.Telemetry = state[env.Telemetry]
+	e.TelemetryEndpoint = state[env.TelemetryEndpoint]
 	e.UseSSO = state[env.UseSSO]
 	e.WasmMetadataDisable = state[env.WasmMetadataDisable]
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/fatih/color"
 	toml "github.com/pelletier/go-toml"
 
 	"github.com/fastly/cli/pkg/config"
@@ -393,3 +394,85 @@ func TestNeedsUpdating(t *testing.T) {
 		})
 	}
 }
+
+// TestMigrate validates an older config is backed up and migrated to the
+// current schema, and that running the migration again is a no-op.
+func TestMigrate(t *testing.T) {
+	backupStatic := config.Static
+	defer func() {
+		config.Static = backupStatic
+	}()
+	config.Static = staticConfig
+
+	original, err := os.ReadFile(filepath.Join("testdata", "config-migrate-v0.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T:     t,
+		Write: []testutil.FileIO{{Src: string(original), Dst: "config.toml"}},
+	})
+	defer os.RemoveAll(rootdir)
+	configPath := filepath.Join(rootdir, "config.toml")
+	backupPath := configPath + ".v0.bak"
+
+	originalStderr := color.Error
+	defer func() {
+		color.Error = originalStderr
+	}()
+	var out, stderr bytes.Buffer
+	color.Error = &stderr
+
+	var f config.File
+	err = f.Read(configPath, strings.NewReader(""), &out, fsterr.MockLog{}, false)
+	testutil.AssertNoError(t, err)
+
+	// The notice isn't mixed with the command output.
+	testutil.AssertString(t, "", out.String())
+	testutil.AssertStringContains(t, stderr.String(), "migrated from config_version 0 to 1")
+	testutil.AssertStringContains(t, stderr.String(), backupPath)
+	testutil.AssertStringContains(t, stderr.String(), "- cli.ttl")
+	testutil.AssertStringContains(t, stderr.String(), "~ language.rust.toolchain_constraint")
+	testutil.AssertStringContains(t, stderr.String(), "- language.rust.wasm_bindgen_version")
+	testutil.AssertStringContains(t, stderr.String(), "+ starter-kits.rust")
+	if strings.Contains(stderr.String(), "foobar") {
+		t.Fatalf("expected token value not to be displayed: %s", stderr.String())
+	}
+
+	backup, err := os.ReadFile(backupPath)
+	if err != nil {
+		t.Fatalf("expected the original config to be backed up: %v", err)
+	}
+	testutil.AssertString(t, string(original), string(backup))
+
+	var migrated config.File
+	b, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := toml.Unmarshal(b, &migrated); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, 1, migrated.ConfigVersion)
+	testutil.AssertString(t, ">= 1.49.0 < 2.0.0", migrated.Language.Rust.ToolchainConstraint)
+	if p, ok := migrated.Profiles["user"]; !ok || p.Token != "foobar" || !p.Default {
+		t.Fatalf("expected the profile data to be preserved: %+v", migrated.Profiles)
+	}
+
+	// Running again shouldn't migrate (or back up) the config a second time.
+	out.Reset()
+	stderr.Reset()
+	if err := os.WriteFile(backupPath, []byte("unchanged"), config.FilePermissions); err != nil {
+		t.Fatal(err)
+	}
+	var again config.File
+	err = again.Read(configPath, strings.NewReader(""), &out, fsterr.MockLog{}, false)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "", out.String())
+	testutil.AssertString(t, "", stderr.String())
+	backup, err = os.ReadFile(backupPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, "unchanged", string(backup))
+}
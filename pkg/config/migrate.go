@@ -0,0 +1,145 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	toml "github.com/pelletier/go-toml"
+
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Migration describes the changes made when migrating a config file from an
+// older schema (i.e. config_version) to the current schema.
+type Migration struct {
+	// FromVersion is the config_version of the original config.
+	FromVersion int
+	// ToVersion is the config_version of the migrated config.
+	ToVersion int
+	// BackupPath is where the original config was backed up to.
+	BackupPath string
+	// Added is the list of keys not present in the original config.
+	Added []string
+	// Changed is the list of keys whose value was changed.
+	Changed []string
+	// Removed is the list of keys not present in the migrated config.
+	Removed []string
+}
+
+// NeedsMigrating indicates if the config data uses an older (or otherwise
+// incompatible) schema and so must be migrated to the current schema.
+//
+// NOTE: This is a subset of NeedsUpdating, which also returns true for a
+// change in CLI version that doesn't require the schema to change.
+func (f *File) NeedsMigrating(data []byte) bool {
+	tree, err := toml.LoadBytes(data)
+	if err != nil {
+		return false
+	}
+	return tree.Get("user") != nil || f.ConfigVersion != CurrentConfigVersion
+}
+
+// Migrate backs up the original config data and rewrites the config at path
+// using the current schema, preserving the user's profile data.
+//
+// The backup is named after the original config_version (e.g.
+// config.toml.v4.bak) and an existing backup is never overwritten, so running
+// the migration again won't lose the original config.
+func (f *File) Migrate(path string, data []byte) (Migration, error) {
+	m := Migration{
+		FromVersion: f.ConfigVersion,
+		BackupPath:  fmt.Sprintf("%s.v%d.bak", path, f.ConfigVersion),
+	}
+
+	if _, err := os.Stat(m.BackupPath); errors.Is(err, fs.ErrNotExist) {
+		if err := os.WriteFile(m.BackupPath, data, FilePermissions); err != nil {
+			return m, fmt.Errorf("error backing up config file: %w", err)
+		}
+	}
+
+	if err := f.UseStatic(path); err != nil {
+		return m, err
+	}
+	m.ToVersion = f.ConfigVersion
+
+	migrated, err := toml.Marshal(f)
+	if err != nil {
+		return m, fmt.Errorf("error encoding migrated config file: %w", err)
+	}
+	m.Added, m.Changed, m.Removed = diffConfig(data, migrated)
+
+	return m, nil
+}
+
+// Print displays a summary of the migration.
+//
+// NOTE: Only the affected keys are displayed (not their values) so that
+// sensitive data, such as profile tokens, isn't exposed.
+func (m Migration) Print(out io.Writer) {
+	text.Info(out, "Your configuration file was migrated from config_version %d to %d. The original was backed up to %s", m.FromVersion, m.ToVersion, m.BackupPath)
+	for _, c := range []struct {
+		prefix string
+		keys   []string
+	}{
+		{"+", m.Added},
+		{"~", m.Changed},
+		{"-", m.Removed},
+	} {
+		for _, k := range c.keys {
+			fmt.Fprintf(out, "\t%s %s\n", c.prefix, k)
+		}
+	}
+	text.Break(out)
+}
+
+// diffConfig returns the dotted keys that were added, changed or removed
+// between the before and after TOML data.
+func diffConfig(before, after []byte) (added, changed, removed []string) {
+	o, n := flattenConfig(before), flattenConfig(after)
+	for k, v := range n {
+		ov, ok := o[k]
+		switch {
+		case !ok:
+			added = append(added, k)
+		case !reflect.DeepEqual(ov, v):
+			changed = append(changed, k)
+		}
+	}
+	for k := range o {
+		if _, ok := n[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+	return added, changed, removed
+}
+
+// flattenConfig decodes TOML data into a map of dotted keys to leaf values.
+func flattenConfig(data []byte) map[string]any {
+	flat := make(map[string]any)
+	tree, err := toml.LoadBytes(data)
+	if err != nil {
+		return flat
+	}
+	var walk func(prefix []string, m map[string]any)
+	walk = func(prefix []string, m map[string]any) {
+		for k, v := range m {
+			key := append(append([]string{}, prefix...), k)
+			if sub, ok := v.(map[string]any); ok {
+				walk(key, sub)
+				continue
+			}
+			flat[strings.Join(key, ".")] = v
+		}
+	}
+	walk(nil, tree.ToMap())
+	return flat
+}
@@ -31,9 +31,25 @@ const (
 	// Set to "true" to enable debug mode.
 	DebugMode = "FASTLY_DEBUG_MODE"
 
+	// DisableTelemetry disables all outbound telemetry, overriding Telemetry
+	// (e.g. for build agents that mustn't send any).
+	// Set to "true" (or "1") to disable.
+	DisableTelemetry = "FASTLY_DISABLE_TELEMETRY"
+
+	// NoUpdateCheck disables the background check for a newer CLI version.
+	// Set to "true" to disable the check.
+	NoUpdateCheck = "FASTLY_NO_UPDATE_CHECK"
+
 	// ServiceID is the env var we look in for the required Service ID.
 	ServiceID = "FASTLY_SERVICE_ID"
 
+	// Telemetry enables anonymous command usage reporting.
+	// Set to "1" to enable (it is disabled by default).
+	Telemetry = "FASTLY_TELEMETRY"
+
+	// TelemetryEndpoint is the endpoint usage counts are sent to.
+	TelemetryEndpoint = "FASTLY_TELEMETRY_ENDPOINT"
+
 	// UseSSO enables the CLI to validate the token as an OAuth token.
 	// These tokens aren't traditional tokens generated by the UI.
 	// Instead they generated via an OAuth flow (producing access/refresh tokens).
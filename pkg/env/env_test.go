@@ -1,7 +1,11 @@
 package env
 
 import (
+	"maps"
+	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"golang.org/x/exp/slices"
@@ -41,3 +45,110 @@ func TestVars(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDotenv(t *testing.T) {
+	tcs := []struct {
+		name     string
+		data     string
+		expected map[string]string
+		err      string
+	}{
+		{
+			name: "formats",
+			data: strings.Join([]string{
+				"# a comment",
+				"",
+				"FASTLY_A=plain",
+				"export FASTLY_B = spaced # trailing comment",
+				`FASTLY_C="double \"quoted\"\nvalue" # comment`,
+				`FASTLY_D='single # not a comment \n'`,
+				"FASTLY_E=",
+				"FASTLY_F=a=b\r",
+			}, "\n"),
+			expected: map[string]string{
+				"FASTLY_A": "plain",
+				"FASTLY_B": "spaced",
+				"FASTLY_C": "double \"quoted\"\nvalue",
+				"FASTLY_D": `single # not a comment \n`,
+				"FASTLY_E": "",
+				"FASTLY_F": "a=b",
+			},
+		},
+		{
+			name: "missing equals",
+			data: "FASTLY_A=1\nFASTLY_B",
+			err:  "line 2: expected KEY=VALUE",
+		},
+		{
+			name: "invalid key",
+			data: "FASTLY-A=1",
+			err:  "line 1: expected KEY=VALUE",
+		},
+		{
+			name: "unterminated quote",
+			data: `FASTLY_A="value`,
+			err:  "line 1: unterminated quoted value",
+		},
+		{
+			name: "characters after quote",
+			data: `FASTLY_A='value' extra`,
+			err:  "line 1: unexpected characters after quoted value",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			vars, err := parseDotenv(tc.data)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("want error %q, have %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(tc.expected, vars) {
+				t.Errorf("want %v, have %v", tc.expected, vars)
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	data := "FASTLY_SERVICE_ID=from-file\nFASTLY_CUSTOMER_ID=from-file\nHOME=/ignored\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Explicitly exported variables take precedence over the file.
+	t.Setenv(CustomerID, "exported")
+	t.Setenv(ServiceID, "")
+	if err := os.Unsetenv(ServiceID); err != nil {
+		t.Fatal(err)
+	}
+	home := os.Getenv("HOME")
+
+	loaded, err := LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Unsetenv(ServiceID) })
+
+	if !slices.Equal([]string{ServiceID}, loaded) {
+		t.Errorf("want %v loaded, have %v", []string{ServiceID}, loaded)
+	}
+	if v := os.Getenv(ServiceID); v != "from-file" {
+		t.Errorf("want %s=from-file, have %q", ServiceID, v)
+	}
+	if v := os.Getenv(CustomerID); v != "exported" {
+		t.Errorf("want %s=exported, have %q", CustomerID, v)
+	}
+	if v := os.Getenv("HOME"); v != home {
+		t.Errorf("want non-FASTLY variables to be ignored, have HOME=%q", v)
+	}
+
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("want an error for a missing file")
+	}
+}
@@ -0,0 +1,150 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Prefix is the prefix shared by all environment variables the CLI reads.
+// Only variables with this prefix are loaded from an --env-file.
+const Prefix = "FASTLY_"
+
+// keyRegEx matches a valid environment variable name.
+var keyRegEx = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// LoadFile reads a dotenv-style file and sets every FASTLY_ prefixed variable
+// that isn't already set in the environment (so explicitly exported values
+// take precedence over the file). The names of the variables that were set
+// are returned.
+func LoadFile(path string) ([]string, error) {
+	vars, err := ParseFile(path)
+	if err != nil {
+		return nil, err
+	}
+	loaded := make([]string, 0, len(vars))
+	for k, v := range vars {
+		if _, ok := os.LookupEnv(k); ok {
+			continue
+		}
+		if err := os.Setenv(k, v); err != nil {
+			return nil, fmt.Errorf("error setting %s: %w", k, err)
+		}
+		loaded = append(loaded, k)
+	}
+	sort.Strings(loaded)
+	return loaded, nil
+}
+
+// ParseFile reads a dotenv-style file and returns its FASTLY_ prefixed
+// variables. Any other variables are ignored.
+func ParseFile(path string) (map[string]string, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we require a user to configure their own environment.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading env file: %w", err)
+	}
+	vars, err := parseDotenv(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing env file '%s': %w", path, err)
+	}
+	for k := range vars {
+		if !strings.HasPrefix(k, Prefix) {
+			delete(vars, k)
+		}
+	}
+	return vars, nil
+}
+
+// parseDotenv parses KEY=VALUE lines.
+//
+// Blank lines and lines starting with '#' are ignored, as is an optional
+// leading `export`. Values may be unquoted (an inline ' #' starts a comment),
+// single-quoted (taken literally) or double-quoted (supporting the \n, \t, \"
+// and \\ escape sequences).
+func parseDotenv(data string) (map[string]string, error) {
+	vars := make(map[string]string)
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(strings.TrimSuffix(line, "\r"))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		k, v, ok := strings.Cut(line, "=")
+		k = strings.TrimSpace(k)
+		if !ok || !keyRegEx.MatchString(k) {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", i+1)
+		}
+		v, err := parseDotenvValue(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		vars[k] = v
+	}
+	return vars, nil
+}
+
+// parseDotenvValue unquotes a single value.
+func parseDotenvValue(v string) (string, error) {
+	if v == "" {
+		return v, nil
+	}
+
+	switch quote := v[0]; quote {
+	case '\'':
+		end := strings.IndexByte(v[1:], '\'')
+		if end < 0 {
+			return "", errors.New("unterminated quoted value")
+		}
+		if err := checkTrailing(v[end+2:]); err != nil {
+			return "", err
+		}
+		return v[1 : end+1], nil
+	case '"':
+		var b strings.Builder
+		for i := 1; i < len(v); i++ {
+			c := v[i]
+			switch {
+			case c == '\\' && i+1 < len(v):
+				i++
+				switch v[i] {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				default:
+					b.WriteByte(v[i])
+				}
+			case c == '"':
+				if err := checkTrailing(v[i+1:]); err != nil {
+					return "", err
+				}
+				return b.String(), nil
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return "", errors.New("unterminated quoted value")
+	}
+
+	if i := strings.Index(v, " #"); i >= 0 {
+		v = v[:i]
+	}
+	return strings.TrimSpace(v), nil
+}
+
+// checkTrailing ensures only a comment follows a quoted value.
+func checkTrailing(rest string) error {
+	rest = strings.TrimSpace(rest)
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		return errors.New("unexpected characters after quoted value")
+	}
+	return nil
+}
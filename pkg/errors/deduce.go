@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
@@ -13,9 +14,23 @@ import (
 // Deduce attempts to deduce a RemediationError from a plain error. If the error
 // is already a RemediationError it is returned directly. Certain deep error
 // types, like a Fastly SDK HTTPError, are detected and converted in appropriate
-// cases to e.g. AuthRemediation. If no specific remediation can be suggested, a
+// cases to e.g. AuthRemediation (see ClassifyStatus). If no specific remediation can be suggested, a
 // remediation to file a bug is used.
 func Deduce(err error) RemediationError {
+	// NOTE: A PaginationError must be checked first as the underlying error
+	// might itself be a RemediationError.
+	var pe PaginationError
+	if errors.As(err, &pe) {
+		re := Deduce(pe.Err)
+		re.Inner = fmt.Errorf("pagination stopped early at page %d: %w", pe.Page, re.Inner)
+		remediation := fmt.Sprintf(PaginationRemediation, pe.Page)
+		if re.Remediation != "" && re.Remediation != BugRemediation {
+			remediation += "\n\n" + re.Remediation
+		}
+		re.Remediation = remediation
+		return re
+	}
+
 	var re RemediationError
 	if errors.As(err, &re) {
 		return re // assume the useful suggestion is already baked-in
@@ -23,13 +38,25 @@ func Deduce(err error) RemediationError {
 
 	var httpError *fastly.HTTPError
 	if errors.As(err, &httpError) {
-		remediation := BugRemediation
+		if c, ok := statusClasses[ClassifyStatus(httpError)]; ok {
+			return RemediationError{Inner: fmt.Errorf("%s: %w", c.message, SimplifyFastlyError(*httpError)), Remediation: c.remediation}
+		}
+		return RemediationError{Inner: SimplifyFastlyError(*httpError), Remediation: BugRemediation}
+	}
+
+	if dnsErr, ok := DNSError(err); ok {
+		return RemediationError{Inner: fmt.Errorf("DNS lookup failed for %s: %s", dnsErr.Name, dnsErr.Err), Remediation: DNSRemediation}
+	}
 
-		if httpError.StatusCode == http.StatusUnauthorized {
-			remediation = AuthRemediation
+	if IsUnreachable(err) {
+		if isAPIEndpoint(UnreachableEndpoint(err)) {
+			return RemediationError{Inner: unreachableError(err), Remediation: UnreachableRemediation}
 		}
+		return RemediationError{Inner: err, Remediation: NetworkRemediation}
+	}
 
-		return RemediationError{Inner: SimplifyFastlyError(*httpError), Remediation: remediation}
+	if IsTimeout(err) {
+		return RemediationError{Inner: err, Remediation: TimeoutRemediation}
 	}
 
 	if errors.Is(err, os.ErrNotExist) {
@@ -67,3 +94,30 @@ func SimplifyFastlyError(httpError fastly.HTTPError) error {
 		)
 	}
 }
+
+// APIEndpoint is the Fastly API endpoint (via --api, the environment or the
+// config) for the current CLI invocation.
+//
+// NOTE: It's assigned by the app package once the flags are parsed, so that a
+// network error is only reported as the Fastly API being unreachable if the
+// request was made to it (rather than e.g. a package registry).
+var APIEndpoint string
+
+// isAPIEndpoint indicates if endpoint (see UnreachableEndpoint) is the scheme
+// and host of APIEndpoint.
+func isAPIEndpoint(endpoint string) bool {
+	if endpoint == "" || APIEndpoint == "" {
+		return false
+	}
+	u, err := url.Parse(APIEndpoint)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(endpoint, u.Scheme+"://"+u.Host)
+}
+
+// unreachableError rewrites a low-level network error into a friendlier
+// message that identifies the endpoint that couldn't be reached.
+func unreachableError(err error) error {
+	return fmt.Errorf("could not reach the Fastly API at %s", UnreachableEndpoint(err))
+}
@@ -2,8 +2,11 @@ package errors_test
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"syscall"
 	"testing"
 
 	"github.com/fastly/cli/pkg/errors"
@@ -12,12 +15,32 @@ import (
 )
 
 func TestDeduce(t *testing.T) {
+	defer func() { errors.APIEndpoint = "" }()
+	errors.APIEndpoint = "https://api.fastly.com"
+
 	var (
 		re1             = errors.RemediationError{Inner: fmt.Errorf("foo")}
 		re2             = errors.RemediationError{Inner: fmt.Errorf("bar"), Remediation: "Reticulate your splines."}
 		http503         = &fastly.HTTPError{StatusCode: http.StatusInternalServerError}
 		http401         = &fastly.HTTPError{StatusCode: http.StatusUnauthorized}
+		http403         = &fastly.HTTPError{StatusCode: http.StatusForbidden}
+		http404         = &fastly.HTTPError{StatusCode: http.StatusNotFound}
 		wrappedNotExist = fmt.Errorf("couldn't do the thing: %w", os.ErrNotExist)
+		connRefused     = &url.Error{
+			Op:  "Get",
+			URL: "https://api.fastly.com/service/123/version",
+			Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)},
+		}
+		connRefusedOther = &url.Error{
+			Op:  "Get",
+			URL: "https://registry.example.com/package",
+			Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)},
+		}
+		dnsFailure = &url.Error{
+			Op:  "Get",
+			URL: "https://api.example.com/service",
+			Err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.example.com"}},
+		}
 	)
 
 	for _, testcase := range []struct {
@@ -43,13 +66,46 @@ func TestDeduce(t *testing.T) {
 		{
 			name:  "fastly.HTTPError 401",
 			input: http401,
-			want:  errors.RemediationError{Inner: errors.SimplifyFastlyError(*http401), Remediation: errors.AuthRemediation},
+			want:  errors.RemediationError{Inner: fmt.Errorf("authentication failed: %w", errors.SimplifyFastlyError(*http401)), Remediation: errors.AuthRemediation},
+		},
+		{
+			name:  "fastly.HTTPError 403",
+			input: http403,
+			want:  errors.RemediationError{Inner: fmt.Errorf("permission denied: %w", errors.SimplifyFastlyError(*http403)), Remediation: errors.PermissionRemediation},
+		},
+		{
+			name:  "wrapped fastly.HTTPError 404",
+			input: fmt.Errorf("error getting service version: %w", http404),
+			want:  errors.RemediationError{Inner: fmt.Errorf("not found: %w", errors.SimplifyFastlyError(*http404)), Remediation: errors.NotFoundRemediation},
 		},
 		{
 			name:  "wrapped os.ErrNotExist",
 			input: wrappedNotExist,
 			want:  errors.RemediationError{Inner: wrappedNotExist, Remediation: errors.HostRemediation},
 		},
+		{
+			name:  "connection refused",
+			input: fmt.Errorf("error listing versions: %w", connRefused),
+			want:  errors.RemediationError{Inner: fmt.Errorf("could not reach the Fastly API at https://api.fastly.com"), Remediation: errors.UnreachableRemediation},
+		},
+		{
+			name:  "connection refused by another host",
+			input: connRefusedOther,
+			want:  errors.RemediationError{Inner: connRefusedOther, Remediation: errors.NetworkRemediation},
+		},
+		{
+			name:  "DNS failure",
+			input: dnsFailure,
+			want:  errors.RemediationError{Inner: fmt.Errorf("DNS lookup failed for api.example.com: no such host"), Remediation: errors.DNSRemediation},
+		},
+		{
+			name:  "pagination error",
+			input: errors.PaginationError{Page: 3, Err: http401},
+			want: errors.RemediationError{
+				Inner:       fmt.Errorf("pagination stopped early at page 3: authentication failed: %w", errors.SimplifyFastlyError(*http401)),
+				Remediation: fmt.Sprintf(errors.PaginationRemediation, 3) + "\n\n" + errors.AuthRemediation,
+			},
+		},
 		{
 			name:  "temporary network error",
 			input: isTemporary{fmt.Errorf("baz")},
@@ -117,11 +117,40 @@ var ErrInvalidVerboseJSONCombo = RemediationError{
 	Remediation: "Use either --verbose or --json, not both.",
 }
 
-// ErrInvalidDeleteAllJSONKeyCombo means the user provided both a --all and
-// --json flag which are mutually exclusive behaviours.
-var ErrInvalidDeleteAllJSONKeyCombo = RemediationError{
-	Inner:       fmt.Errorf("invalid flag combination, --all and --json"),
-	Remediation: "Use either --all or --json, not both.",
+// ErrInvalidJSONStyleCombo means the user provided both a --json-compact and
+// --json-pretty flag which are mutually exclusive behaviours.
+var ErrInvalidJSONStyleCombo = RemediationError{
+	Inner:       fmt.Errorf("invalid flag combination, --json-compact and --json-pretty"),
+	Remediation: "Use either --json-compact or --json-pretty, not both.",
+}
+
+// ErrTerminalInputJSONErrorsOnly means the command tried to read from a
+// terminal (e.g. to prompt the user) with --json-errors-only, which discards
+// the prompt.
+var ErrTerminalInputJSONErrorsOnly = RemediationError{
+	Inner:       fmt.Errorf("invalid flag combination, --json-errors-only can't be used when the command prompts for input"),
+	Remediation: "Use --non-interactive (or --accept-defaults and --auto-yes) to answer the prompts automatically, pipe the input to the command, or remove --json-errors-only.",
+}
+
+// ErrOutputFileUnsupported means the user provided an --output flag for a
+// command that doesn't support structured output.
+var ErrOutputFileUnsupported = RemediationError{
+	Inner:       fmt.Errorf("invalid flag, --output is not supported by this command"),
+	Remediation: "Use --output only with commands that support the --json flag.",
+}
+
+// ErrRedactOutputUnsupported means the user provided a --redact-output flag
+// for a command whose text output isn't redacted.
+var ErrRedactOutputUnsupported = RemediationError{
+	Inner:       fmt.Errorf("invalid flag, --redact-output is only supported by this command with structured output"),
+	Remediation: "Use --redact-output with --json (or another --format), or remove it.",
+}
+
+// ErrJSONPointerUnsupported means the user provided a --pointer flag for a
+// command that doesn't support JSON output.
+var ErrJSONPointerUnsupported = RemediationError{
+	Inner:       fmt.Errorf("invalid flag, --pointer is not supported by this command"),
+	Remediation: "Use --pointer only with commands that support the --json flag.",
 }
 
 // ErrInvalidDeleteAllKeyCombo means the user provided both a --all and --key
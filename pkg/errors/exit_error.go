@@ -1,7 +1,11 @@
 package errors
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/fastly/cli/pkg/text"
 )
@@ -34,3 +38,111 @@ func (ee SkipExitError) Print(w io.Writer) {
 		text.Error(w, "%s.", ee.Err.Error())
 	}
 }
+
+// ExitCodeWarnings is the exit code used when warnings were escalated to an
+// error via --fail-on-warning. It allows CI to distinguish warning escalation
+// from a command failure.
+const ExitCodeWarnings = 3
+
+// WarningsError indicates warnings were emitted while --fail-on-warning was set.
+type WarningsError struct {
+	Warnings []string
+}
+
+// Error returns a summary of the escalated warnings.
+func (we WarningsError) Error() string {
+	if len(we.Warnings) == 1 {
+		return "1 warning was emitted and --fail-on-warning is set"
+	}
+	return fmt.Sprintf("%d warnings were emitted and --fail-on-warning is set", len(we.Warnings))
+}
+
+// ExitCodeBulkPartial is the exit code used when some (but not all) items of a
+// bulk operation failed.
+const ExitCodeBulkPartial = 4
+
+// ExitCodeBulkFailed is the exit code used when none of the items of a bulk
+// operation succeeded.
+const ExitCodeBulkFailed = 5
+
+// ExitCodeTimeout is the exit code used when an operation was abandoned
+// because a deadline was exceeded (e.g. --timeout-per-item). It allows scripts
+// to distinguish a timeout from a failure reported by the API.
+const ExitCodeTimeout = 6
+
+// ExitCodeAuth is the exit code used when the API rejected the token (401).
+const ExitCodeAuth = 7
+
+// ExitCodePermission is the exit code used when the token isn't permitted to
+// perform the operation (403).
+const ExitCodePermission = 8
+
+// ExitCodeNotFound is the exit code used when the requested resource doesn't
+// exist (404).
+const ExitCodeNotFound = 9
+
+// TimeoutError indicates an operation was abandoned because it didn't complete
+// within Timeout.
+type TimeoutError struct {
+	// Timeout is the deadline that was exceeded.
+	Timeout time.Duration
+	// Err is the underlying error (typically context.DeadlineExceeded).
+	Err error
+}
+
+// Unwrap returns the inner error.
+func (te TimeoutError) Unwrap() error {
+	return te.Err
+}
+
+// Error reports the timeout that was exceeded.
+func (te TimeoutError) Error() string {
+	return fmt.Sprintf("operation timed out after %s", te.Timeout)
+}
+
+// BulkError indicates items of a bulk operation (e.g. deleting all the keys in
+// a store) failed. Skipped items count towards the total but not as failures.
+type BulkError struct {
+	// Action is the operation (e.g. "delete").
+	Action string `json:"action"`
+	// Noun is the plural of the item type (e.g. "keys").
+	Noun string `json:"noun"`
+	// IDs identifies the failed items (possibly truncated).
+	IDs []string `json:"failed_ids"`
+	// Failed is the number of items that failed.
+	Failed int `json:"failed"`
+	// Succeeded is the number of items that succeeded.
+	Succeeded int `json:"succeeded"`
+	// Total is the number of items.
+	Total int `json:"total"`
+}
+
+// Error returns a summary of the failed items.
+func (be BulkError) Error() string {
+	return fmt.Sprintf("failed to %s %d of %d %s: %s", be.Action, be.Failed, be.Total, be.Noun, strings.Join(be.IDs, ", "))
+}
+
+// ExitCode returns the process exit code for the given error.
+//
+// NOTE: A bulk operation whose items timed out exits with a bulk exit code, as
+// the per-item errors are summarised by the BulkError.
+func ExitCode(err error) int {
+	var we WarningsError
+	if errors.As(err, &we) {
+		return ExitCodeWarnings
+	}
+	var be BulkError
+	if errors.As(err, &be) {
+		if be.Succeeded > 0 {
+			return ExitCodeBulkPartial
+		}
+		return ExitCodeBulkFailed
+	}
+	if IsTimeout(err) {
+		return ExitCodeTimeout
+	}
+	if c, ok := statusClasses[ClassifyStatus(err)]; ok {
+		return c.exitCode
+	}
+	return 1
+}
@@ -0,0 +1,86 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestExitCode(t *testing.T) {
+	warnings := errors.WarningsError{Warnings: []string{"foo", "bar"}}
+
+	for _, testcase := range []struct {
+		name  string
+		input error
+		want  int
+	}{
+		{
+			name:  "generic error",
+			input: fmt.Errorf("foo"),
+			want:  1,
+		},
+		{
+			name:  "warnings error",
+			input: warnings,
+			want:  errors.ExitCodeWarnings,
+		},
+		{
+			name:  "wrapped warnings error",
+			input: errors.RemediationError{Inner: warnings, Remediation: errors.FailOnWarningRemediation},
+			want:  errors.ExitCodeWarnings,
+		},
+		{
+			name:  "partially failed bulk operation",
+			input: errors.BulkError{Action: "delete", Noun: "keys", IDs: []string{"foo"}, Failed: 1, Succeeded: 2, Total: 3},
+			want:  errors.ExitCodeBulkPartial,
+		},
+		{
+			name:  "failed bulk operation",
+			input: fmt.Errorf("wrapped: %w", errors.BulkError{Action: "delete", Noun: "keys", IDs: []string{"foo"}, Failed: 1, Total: 3}),
+			want:  errors.ExitCodeBulkFailed,
+		},
+		{
+			name:  "timed out operation",
+			input: fmt.Errorf("error deleting key: %w", errors.TimeoutError{Timeout: time.Second, Err: context.DeadlineExceeded}),
+			want:  errors.ExitCodeTimeout,
+		},
+		{
+			name:  "unauthorized API request",
+			input: &fastly.HTTPError{StatusCode: http.StatusUnauthorized},
+			want:  errors.ExitCodeAuth,
+		},
+		{
+			name:  "forbidden API request",
+			input: fmt.Errorf("error listing services: %w", &fastly.HTTPError{StatusCode: http.StatusForbidden}),
+			want:  errors.ExitCodePermission,
+		},
+		{
+			name:  "not found API request",
+			input: &fastly.HTTPError{StatusCode: http.StatusNotFound},
+			want:  errors.ExitCodeNotFound,
+		},
+		{
+			name:  "failed API request",
+			input: &fastly.HTTPError{StatusCode: http.StatusInternalServerError},
+			want:  1,
+		},
+		{
+			name:  "canceled operation",
+			input: fmt.Errorf("error deleting key: %w", context.Canceled),
+			want:  1,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			testutil.AssertEqual(t, testcase.want, errors.ExitCode(testcase.input))
+		})
+	}
+
+	testutil.AssertString(t, "2 warnings were emitted and --fail-on-warning is set", warnings.Error())
+}
@@ -0,0 +1,262 @@
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Explanation provides structured guidance for a failed command.
+type Explanation struct {
+	// Category is a short classification of the error.
+	Category string `json:"category"`
+	// Causes are the likely reasons the error occurred.
+	Causes []string `json:"likely_causes"`
+	// Suggestions are commands the user could run next.
+	Suggestions []string `json:"suggested_commands"`
+}
+
+// Explanation categories.
+const (
+	CategoryAuth        = "authentication"
+	CategoryAPI         = "api"
+	CategoryFlags       = "invalid flags"
+	CategoryHost        = "host environment"
+	CategoryManifest    = "manifest"
+	CategoryNetwork     = "network"
+	CategoryNotFound    = "not found"
+	CategoryPermissions = "permissions"
+	CategoryService     = "missing service"
+	CategoryTimeout     = "timeout"
+	CategoryUnknown     = "unknown"
+)
+
+// explanations maps known sentinel errors to their explanation.
+var explanations = []struct {
+	err         error
+	explanation Explanation
+}{
+	{
+		err: ErrNoToken,
+		explanation: Explanation{
+			Category:    CategoryAuth,
+			Causes:      []string{"No API token was found via --token, the FASTLY_API_TOKEN environment variable or a configured profile."},
+			Suggestions: []string{"fastly profile create", "fastly profile list"},
+		},
+	},
+	{
+		err: ErrNoServiceID,
+		explanation: Explanation{
+			Category:    CategoryService,
+			Causes:      []string{"No --service-id or --service-name flag was provided.", "No FASTLY_SERVICE_ID environment variable is set.", "The current directory doesn't contain a fastly.toml with a service_id."},
+			Suggestions: []string{"fastly service list"},
+		},
+	},
+	{
+		err: ErrNoCustomerID,
+		explanation: Explanation{
+			Category:    CategoryFlags,
+			Causes:      []string{"No --customer-id flag was provided and the FASTLY_CUSTOMER_ID environment variable is not set."},
+			Suggestions: []string{"fastly whoami"},
+		},
+	},
+	{
+		err: ErrInvalidVerboseJSONCombo,
+		explanation: Explanation{
+			Category:    CategoryFlags,
+			Causes:      []string{"The --verbose and --json flags are mutually exclusive."},
+			Suggestions: []string{"Re-run the command with only one of --verbose or --json."},
+		},
+	},
+	{
+		err: ErrInvalidJSONStyleCombo,
+		explanation: Explanation{
+			Category:    CategoryFlags,
+			Causes:      []string{"The --json-compact and --json-pretty flags are mutually exclusive."},
+			Suggestions: []string{"Re-run the command with only one of --json-compact or --json-pretty."},
+		},
+	},
+	{
+		err: ErrInvalidDeleteAllKeyCombo,
+		explanation: Explanation{
+			Category:    CategoryFlags,
+			Causes:      []string{"The --all and --key flags are mutually exclusive."},
+			Suggestions: []string{"Re-run the command with only one of --all or --key."},
+		},
+	},
+	{
+		err: ErrInvalidEnableDisableFlagCombo,
+		explanation: Explanation{
+			Category:    CategoryFlags,
+			Causes:      []string{"The --enable and --disable flags are mutually exclusive."},
+			Suggestions: []string{"Re-run the command with only one of --enable or --disable."},
+		},
+	},
+	{
+		err: ErrReadingManifest,
+		explanation: Explanation{
+			Category:    CategoryManifest,
+			Causes:      []string{"The current directory doesn't contain a fastly.toml manifest."},
+			Suggestions: []string{"fastly compute init"},
+		},
+	},
+	{
+		err: ErrParsingManifest,
+		explanation: Explanation{
+			Category:    CategoryManifest,
+			Causes:      []string{"The fastly.toml manifest contains invalid TOML or unrecognised fields."},
+			Suggestions: []string{"fastly compute init"},
+		},
+	},
+}
+
+// Explain returns structured guidance for the given error.
+//
+// Known sentinel errors are matched first, followed by well-known error types
+// (e.g. a Fastly API HTTP error). A generic explanation is returned otherwise.
+func Explain(err error) Explanation {
+	for _, e := range explanations {
+		if errors.Is(err, e.err) {
+			return e.explanation
+		}
+	}
+
+	var httpError *fastly.HTTPError
+	if errors.As(err, &httpError) {
+		return explainHTTPError(httpError.StatusCode)
+	}
+
+	if IsDNSError(err) {
+		return Explanation{
+			Category:    CategoryNetwork,
+			Causes:      []string{"The API hostname couldn't be resolved, possibly due to a flaky network or DNS resolver.", "The --api flag or FASTLY_API_ENDPOINT environment variable points to the wrong host."},
+			Suggestions: []string{"Verify your DNS resolver configuration and re-run the command (DNS failures are often transient).", "Re-run the command with --verbose to display the API endpoint."},
+		}
+	}
+
+	if IsUnreachable(err) {
+		return Explanation{
+			Category:    CategoryNetwork,
+			Causes:      []string{"The API host refused the connection or couldn't be resolved.", "The --api flag or FASTLY_API_ENDPOINT environment variable points to the wrong host."},
+			Suggestions: []string{"Verify your network connection and DNS configuration, then re-run the command with --verbose to display the API endpoint."},
+		}
+	}
+
+	if IsTimeout(err) {
+		return Explanation{
+			Category:    CategoryTimeout,
+			Causes:      []string{"The operation didn't complete before its deadline, possibly due to a slow network or a busy API."},
+			Suggestions: []string{"Re-run the command, or increase the timeout (e.g. --timeout-per-item)."},
+		}
+	}
+
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, os.ErrPermission) {
+		return Explanation{
+			Category:    CategoryHost,
+			Causes:      []string{"A file or directory is missing or has too-restrictive permissions."},
+			Suggestions: []string{"Check the paths provided to the command exist and are accessible."},
+		}
+	}
+
+	if t, ok := err.(interface{ Temporary() bool }); ok && t.Temporary() {
+		return Explanation{
+			Category:    CategoryNetwork,
+			Causes:      []string{"A transient network issue prevented the request from completing."},
+			Suggestions: []string{"Verify your network connection and DNS configuration, then re-run the command."},
+		}
+	}
+
+	return Explanation{
+		Category:    CategoryUnknown,
+		Causes:      []string{"The error wasn't recognised by the CLI."},
+		Suggestions: []string{"Re-run the command with --verbose (or --debug-mode) for more detail.", "fastly version"},
+	}
+}
+
+// explainHTTPError returns an explanation for a Fastly API status code.
+func explainHTTPError(status int) Explanation {
+	switch {
+	case status == http.StatusUnauthorized:
+		return Explanation{
+			Category:    CategoryAuth,
+			Causes:      []string{"The API token is missing, incorrect or expired."},
+			Suggestions: []string{"fastly whoami", "fastly profile list"},
+		}
+	case status == http.StatusForbidden:
+		return Explanation{
+			Category:    CategoryPermissions,
+			Causes:      []string{"The API token doesn't have the scope or role required for this operation."},
+			Suggestions: []string{"fastly whoami"},
+		}
+	case status == http.StatusNotFound:
+		return Explanation{
+			Category:    CategoryNotFound,
+			Causes:      []string{"The requested resource doesn't exist, or the service ID/version is incorrect."},
+			Suggestions: []string{"fastly service list", "fastly service-version list"},
+		}
+	case status >= http.StatusInternalServerError:
+		return Explanation{
+			Category:    CategoryAPI,
+			Causes:      []string{"The Fastly API experienced an internal error."},
+			Suggestions: []string{"Check https://fastlystatus.com and re-run the command."},
+		}
+	}
+	return Explanation{
+		Category:    CategoryAPI,
+		Causes:      []string{fmt.Sprintf("The Fastly API rejected the request (%d %s).", status, http.StatusText(status))},
+		Suggestions: []string{"Re-run the command with --debug-mode to inspect the request."},
+	}
+}
+
+// Print the explanation to the io.Writer for human consumption.
+func (e Explanation) Print(w io.Writer) {
+	text.Break(w)
+	fmt.Fprintf(w, "Category: %s\n", e.Category)
+	if len(e.Causes) > 0 {
+		fmt.Fprintf(w, "Likely causes:\n\t%s\n", strings.Join(e.Causes, "\n\t"))
+	}
+	if len(e.Suggestions) > 0 {
+		fmt.Fprintf(w, "Suggested next steps:\n\t%s\n", strings.Join(e.Suggestions, "\n\t"))
+	}
+}
+
+// ExplainedError is the JSON representation of an error and its explanation.
+type ExplainedError struct {
+	Explanation
+	Error       string `json:"error"`
+	Remediation string `json:"remediation,omitempty"`
+	// ExitCode is the exit code the CLI terminates with (see ExitCode).
+	ExitCode int `json:"exit_code"`
+	// Bulk summarises the items of a failed bulk operation.
+	Bulk *BulkError `json:"bulk,omitempty"`
+	// CorrelationID ties the invocation to the server-side logs.
+	CorrelationID string `json:"correlation_id,omitempty"`
+}
+
+// WriteExplainJSON writes the error and its explanation to the io.Writer as
+// JSON.
+func WriteExplainJSON(w io.Writer, err error) error {
+	re := Deduce(err)
+	ee := ExplainedError{
+		Explanation:   Explain(err),
+		Error:         re.Error(),
+		Remediation:   re.Remediation,
+		ExitCode:      ExitCode(err),
+		CorrelationID: CorrelationID,
+	}
+	var be BulkError
+	if errors.As(err, &be) {
+		ee.Bulk = &be
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ee)
+}
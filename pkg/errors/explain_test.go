@@ -0,0 +1,83 @@
+package errors_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestExplain(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input error
+		want  string
+	}{
+		{
+			name:  "sentinel",
+			input: errors.ErrInvalidVerboseJSONCombo,
+			want:  errors.CategoryFlags,
+		},
+		{
+			name:  "wrapped sentinel",
+			input: fmt.Errorf("failed to process token: %w", errors.ErrNoToken),
+			want:  errors.CategoryAuth,
+		},
+		{
+			name:  "fastly.HTTPError 404",
+			input: &fastly.HTTPError{StatusCode: http.StatusNotFound},
+			want:  errors.CategoryNotFound,
+		},
+		{
+			name:  "temporary network error",
+			input: isTemporary{fmt.Errorf("baz")},
+			want:  errors.CategoryNetwork,
+		},
+		{
+			name:  "DNS failure",
+			input: dnsError,
+			want:  errors.CategoryNetwork,
+		},
+		{
+			name:  "timeout",
+			input: fmt.Errorf("error deleting key: %w", errors.TimeoutError{Timeout: time.Second, Err: context.DeadlineExceeded}),
+			want:  errors.CategoryTimeout,
+		},
+		{
+			name:  "unrecognised",
+			input: fmt.Errorf("whoops"),
+			want:  errors.CategoryUnknown,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			have := errors.Explain(testcase.input)
+			testutil.AssertString(t, testcase.want, have.Category)
+			if len(have.Suggestions) == 0 {
+				t.Fatal("expected at least one suggestion")
+			}
+		})
+	}
+}
+
+func TestWriteExplainJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := errors.WriteExplainJSON(&buf, errors.ErrInvalidVerboseJSONCombo); err != nil {
+		t.Fatal(err)
+	}
+
+	var have map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &have); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, errors.CategoryFlags, have["category"].(string))
+	testutil.AssertString(t, errors.ErrInvalidVerboseJSONCombo.Error(), have["error"].(string))
+	testutil.AssertString(t, errors.ErrInvalidVerboseJSONCombo.Remediation, have["remediation"].(string))
+}
@@ -0,0 +1,109 @@
+package errors_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+// logWriterEnv is set when the test binary is re-executed as one of the
+// processes concurrently appending to the error log (see
+// TestLogPersistConcurrentProcesses).
+const logWriterEnv = "FASTLY_TEST_LOG_WRITER"
+
+func TestLogPersistConcurrentProcesses(t *testing.T) {
+	const (
+		processes = 4
+		records   = 25
+		entries   = 5
+	)
+
+	if path := os.Getenv(logWriterEnv); path != "" {
+		id := os.Getenv(logWriterEnv + "_ID")
+		for i := 0; i < records; i++ {
+			le := new(errors.LogEntries)
+			for j := 0; j < entries; j++ {
+				le.Add(fmt.Errorf("writer %s record %d entry %d", id, i, j))
+			}
+			if err := le.Persist(path, []string{"writer", id, strconv.Itoa(i)}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		return
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: string(""), Dst: "errors.log"},
+		},
+	})
+	path := filepath.Join(rootdir, "errors.log")
+	defer os.RemoveAll(rootdir)
+
+	cmds := make([]*exec.Cmd, 0, processes)
+	for p := 0; p < processes; p++ {
+		// gosec flagged this:
+		// G204 (CWE-78): Subprocess launched with variable
+		// Disabling as we control this command.
+		// #nosec
+		// nosemgrep: go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
+		cmd := exec.Command(os.Args[0], "-test.run=^TestLogPersistConcurrentProcesses$")
+		cmd.Env = append(os.Environ(), logWriterEnv+"="+path, fmt.Sprintf("%s_ID=%d", logWriterEnv, p))
+		cmds = append(cmds, cmd)
+	}
+	for _, cmd := range cmds {
+		if err := cmd.Start(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, cmd := range cmds {
+		if err := cmd.Wait(); err != nil {
+			t.Fatalf("writer process failed: %v", err)
+		}
+	}
+
+	have, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each record must hold the command header followed by all of its own
+	// entries (in order), and nothing from any other record.
+	seen := make(map[string]bool)
+	for _, record := range strings.Split(string(have), "------------------------------\n\n") {
+		if record == "" {
+			continue
+		}
+		var id string
+		var i int
+		if _, err := fmt.Sscanf(record, "\nCOMMAND:\nfastly writer %s %d\n", &id, &i); err != nil {
+			t.Fatalf("malformed record: %q", record)
+		}
+		key := fmt.Sprintf("%s/%d", id, i)
+		if seen[key] {
+			t.Fatalf("duplicate record %s", key)
+		}
+		seen[key] = true
+
+		testutil.AssertEqual(t, 1, strings.Count(record, "COMMAND:"))
+		testutil.AssertEqual(t, entries, strings.Count(record, "ERROR:"))
+		offset := 0
+		for j := 0; j < entries; j++ {
+			entry := fmt.Sprintf("ERROR:\nwriter %s record %d entry %d\n", id, i, j)
+			n := strings.Index(record[offset:], entry)
+			if n < 0 {
+				t.Fatalf("record %s is missing (or misordered) entry %d: %q", key, j, record)
+			}
+			offset += n + len(entry)
+		}
+	}
+	testutil.AssertEqual(t, processes*records, len(seen))
+}
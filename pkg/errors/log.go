@@ -1,17 +1,28 @@
 package errors
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"maps"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"runtime"
+	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
 	"text/template"
 	"time"
 
 	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/text"
 )
 
 // LogPath is the location of the fastly CLI error log.
@@ -25,11 +36,17 @@ var LogPath = func() string {
 	panic("unable to deduce user config dir or user home dir")
 }()
 
+// JSONLogPath is the location of the fastly CLI error log written as JSON
+// lines (see --error-log-json).
+var JSONLogPath = filepath.Join(filepath.Dir(LogPath), "errors.jsonl")
+
 // LogInterface represents the LogEntries behaviours.
 type LogInterface interface {
 	Add(err error)
 	AddWithContext(err error, ctx map[string]any)
+	AddWithStack(err error)
 	Persist(logPath string, args []string) error
+	PersistJSON(logPath string, args []string) error
 }
 
 // MockLog is a no-op Log type.
@@ -41,11 +58,19 @@ func (ml MockLog) Add(_ error) {}
 // AddWithContext adds an error and context to the mock log.
 func (ml MockLog) AddWithContext(_ error, _ map[string]any) {}
 
+// AddWithStack adds an error and stack trace to the mock log.
+func (ml MockLog) AddWithStack(_ error) {}
+
 // Persist writes the error data to logPath.
 func (ml MockLog) Persist(_ string, _ []string) error {
 	return nil
 }
 
+// PersistJSON writes the error data to logPath as JSON lines.
+func (ml MockLog) PersistJSON(_ string, _ []string) error {
+	return nil
+}
+
 // Log is the primary interface for consumers.
 var Log = new(LogEntries)
 
@@ -54,73 +79,136 @@ type LogEntries []LogEntry
 
 // Add adds a new log entry.
 func (l *LogEntries) Add(err error) {
-	logMutex.Lock()
-	*l = append(*l, createLogEntry(err))
-	logMutex.Unlock()
+	l.append(createLogEntry(err))
 }
 
 // AddWithContext adds a new log entry with extra contextual data.
 func (l *LogEntries) AddWithContext(err error, ctx map[string]any) {
 	le := createLogEntry(err)
-	le.Context = ctx
+	if le.Context != nil {
+		// NOTE: The entries are merged into the DNS context (a new map) so the
+		// caller's map isn't modified.
+		for k, v := range ctx {
+			le.Context[k] = v
+		}
+	} else {
+		le.Context = ctx
+	}
+	l.append(le)
+}
+
+// AddWithStack adds a new log entry, including the stack trace of the calling
+// goroutine (under the STACK key of the Caller) when AllowInstrumentation is
+// set. It's intended for unexpected failures, such as a recovered panic, where
+// the location of the error alone isn't enough to diagnose it.
+func (l *LogEntries) AddWithStack(err error) {
+	le := createLogEntry(err)
+	if AllowInstrumentation {
+		if le.Caller == nil {
+			le.Caller = make(map[string]any)
+		}
+		le.Caller["STACK"] = strings.TrimSpace(string(debug.Stack()))
+	}
+	l.append(le)
+}
 
+// append records the entry, dropping the oldest entries once there are more
+// than MaxLogEntries so a long-running process doesn't grow without bound.
+func (l *LogEntries) append(le LogEntry) {
 	logMutex.Lock()
+	defer logMutex.Unlock()
+
+	if MaxLogEntries > 0 && len(*l) >= MaxLogEntries {
+		n := len(*l) - MaxLogEntries + 1
+		// NOTE: The entries are shifted within the existing backing array (and
+		// the vacated slots cleared) so the dropped entries can be collected.
+		copy(*l, (*l)[n:])
+		clear((*l)[len(*l)-n:])
+		*l = (*l)[:len(*l)-n]
+		DroppedLogEntries += n
+	}
 	*l = append(*l, le)
-	logMutex.Unlock()
+}
+
+// Clear removes the recorded log entries (and resets DroppedLogEntries), so a
+// long-running process can start afresh once the entries are persisted.
+func (l *LogEntries) Clear() {
+	logMutex.Lock()
+	defer logMutex.Unlock()
+
+	// NOTE: The backing array is cleared so the entries can be collected.
+	clear(*l)
+	*l = (*l)[:0]
+	DroppedLogEntries = 0
 }
 
 // Persist persists recorded log entries to disk.
+//
+// NOTE: The entries aren't cleared once persisted (see Clear), so persisting
+// them again writes them again.
 func (l LogEntries) Persist(logPath string, args []string) error {
 	if len(l) == 0 {
 		return nil
 	}
 	cmd := "fastly " + strings.Join(args, " ")
-	errMsg := "error accessing audit log file: %w"
 
-	// gosec flagged this:
-	// G304 (CWE-22): Potential file inclusion via variable
-	//
-	// Disabling as the input is determined from our own package.
-	/* #nosec */
-	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	f, release, err := openLogFile(logPath)
 	if err != nil {
-		return fmt.Errorf(errMsg, err)
+		return err
 	}
+	defer release()
 
-	if fi, err := f.Stat(); err == nil {
-		if fi.Size() >= FileRotationSize {
-			err = f.Close()
-			if err != nil {
-				return err
-			}
-
-			// gosec flagged this:
-			// G304 (CWE-22): Potential file inclusion via variable
-			//
-			// Disabling as the input is determined from our own package.
-			/* #nosec */
-			f, err = os.Create(logPath)
-			if err != nil {
-				return fmt.Errorf(errMsg, err)
-			}
+	cmd = "\nCOMMAND:\n" + cmd + "\n\n"
+	if CorrelationID != "" {
+		cmd += "CORRELATION ID:\n" + CorrelationID + "\n\n"
+	}
+	if APIVersion != "" {
+		cmd += "API VERSION:\n" + APIVersion + "\n\n"
+	}
+	if len(Labels) > 0 {
+		keys := make([]string, 0, len(Labels))
+		for k := range Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		cmd += "LABELS:\n"
+		for _, k := range keys {
+			cmd += k + "=" + Labels[k] + "\n"
 		}
+		cmd += "\n"
 	}
-
-	// G307 (CWE-): Deferring unsafe method "*os.File" on type "Close".
-	// gosec flagged this:
-	// Disabling because this file isn't critical to the functioning of the CLI
-	// and we only attempt to close it at the end of the user's execution flow.
-	/* #nosec */
-	defer f.Close()
-
-	cmd = "\nCOMMAND:\n" + cmd + "\n\n"
-	if _, err := f.Write([]byte(cmd)); err != nil {
-		return err
+	if ServiceResolution != "" {
+		cmd += "SERVICE RESOLUTION:\n" + ServiceResolution + "\n\n"
+	}
+	if RetryBudget != "" {
+		cmd += "RETRY BUDGET EXHAUSTED:\n" + RetryBudget + "\n\n"
+	}
+	if Stdin != nil {
+		if summary := Stdin.Summary(); summary != "" {
+			cmd += "STDIN:\n" + summary + "\n\n"
+		}
+	}
+	logMutex.Lock()
+	dropped := DroppedLogEntries
+	logMutex.Unlock()
+	if dropped > 0 {
+		cmd += fmt.Sprintf("DROPPED ENTRIES:\n%d earlier errors were dropped (only the most recent %d are recorded)\n\n", dropped, len(l))
+	}
+	// NOTE: Everything written is passed through FilterSecrets, so a secret
+	// can't leak into the log via the command line, an error or its context.
+	if _, err := f.Write([]byte(FilterSecrets(cmd))); err != nil {
+		return &LogPersistError{Path: logPath, Err: err}
 	}
 
 	record := `TIMESTAMP:
 {{.Time}}
+{{ if gt .Count 1 }}
+LAST TIMESTAMP:
+{{.LastTime}}
 
+COUNT:
+{{.Count}}
+{{ end }}
 ERROR:
 {{.Err}}
 {{ range $key, $value := .Caller }}
@@ -132,32 +220,328 @@ ERROR:
 {{ end }}
 `
 	t := template.Must(template.New("record").Parse(record))
-	for _, entry := range l {
-		err := t.Execute(f, entry)
-		if err != nil {
-			return err
+	for _, group := range l.collapse() {
+		var b strings.Builder
+		if err := t.Execute(&b, group); err != nil {
+			return &LogPersistError{Path: logPath, Err: err}
+		}
+		if _, err := f.Write([]byte(FilterSecrets(b.String()))); err != nil {
+			return &LogPersistError{Path: logPath, Err: err}
 		}
 	}
 
 	if _, err := f.Write([]byte("------------------------------\n\n")); err != nil {
+		return &LogPersistError{Path: logPath, Err: err}
+	}
+
+	return nil
+}
+
+// LogPersistError is returned (as a pointer) by Persist and PersistJSON when
+// the log file can't be opened, rotated or written to (including when a record
+// can't be rendered). The underlying error is typically an *fs.PathError, so
+// the cause (e.g. fs.ErrPermission, or syscall.ENOSPC when the disk is full)
+// can be checked with errors.Is.
+type LogPersistError struct {
+	// Path is the location of the log file.
+	Path string
+	// Err is the underlying error.
+	Err error
+}
+
+// Unwrap returns the inner error.
+func (lpe *LogPersistError) Unwrap() error {
+	return lpe.Err
+}
+
+// Error describes the failure to access the log file.
+func (lpe *LogPersistError) Error() string {
+	return fmt.Sprintf("error accessing audit log file: %v", lpe.Err)
+}
+
+// openLogFile opens (creating if necessary) the log file at logPath for
+// appending, and locks it. The returned function unlocks and closes the file.
+func openLogFile(logPath string) (*os.File, func(), error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the input is determined from our own package.
+	/* #nosec */
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, nil, &LogPersistError{Path: logPath, Err: err}
+	}
+
+	// NOTE: logMutex only guards the in-memory entries, so the file itself is
+	// locked to stop concurrent CLI processes (appending to the same log) from
+	// interleaving their records. The lock is held until the record is
+	// written, including while the file is rotated.
+	if err := lockFile(f); err != nil {
+		_ = f.Close()
+		return nil, nil, &LogPersistError{Path: logPath, Err: err}
+	}
+	release := func() {
+		_ = unlockFile(f)
+		// G307 (CWE-): Deferring unsafe method "*os.File" on type "Close".
+		// gosec flagged this:
+		// Disabling because this file isn't critical to the functioning of the
+		// CLI and we only attempt to close it at the end of the user's
+		// execution flow.
+		/* #nosec */
+		_ = f.Close()
+	}
+
+	if fi, err := f.Stat(); err == nil && needsRotation(f, fi.Size()) {
+		if err := rotateLogFile(f, logPath, fi.Size()); err != nil {
+			release()
+			return nil, nil, &LogPersistError{Path: logPath, Err: err}
+		}
+	}
+	return f, release, nil
+}
+
+// needsRotation reports whether the log file f (of the given size) has
+// reached FileRotationSize, or its oldest entry is older than FileRotationAge.
+func needsRotation(f *os.File, size int64) bool {
+	if size == 0 {
+		return false
+	}
+	if FileRotationSize > 0 && size >= FileRotationSize {
+		return true
+	}
+	if FileRotationAge > 0 {
+		if t, ok := oldestLogEntry(f); ok && Now().Sub(t) > FileRotationAge {
+			return true
+		}
+	}
+	return false
+}
+
+// logTimestampRegEx matches the timestamp of an entry written by Persist.
+var logTimestampRegEx = regexp.MustCompile(`TIMESTAMP:\r?\n([^\r\n]+)`)
+
+// oldestLogEntry returns the timestamp of the first entry in the log file f,
+// which is written either by Persist or PersistJSON.
+func oldestLogEntry(f *os.File) (time.Time, bool) {
+	buf := make([]byte, 64*1024)
+	n, err := f.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return time.Time{}, false
+	}
+	buf = buf[:n]
+
+	if line, _, _ := bytes.Cut(buf, []byte("\n")); bytes.HasPrefix(line, []byte("{")) {
+		var r JSONLogRecord
+		if err := json.Unmarshal(line, &r); err != nil || r.Time.IsZero() {
+			return time.Time{}, false
+		}
+		return r.Time, true
+	}
+
+	m := logTimestampRegEx.FindSubmatch(buf)
+	if m == nil {
+		return time.Time{}, false
+	}
+	// NOTE: The timestamp is formatted by time.Time.String, which includes the
+	// monotonic clock reading (e.g. "m=+0.001") when there is one.
+	s, _, _ := strings.Cut(string(m[1]), " m=")
+	t, err := time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// rotateLogFile copies the contents of the log file f (of the given size) to
+// the previous log file (logPath with a .1 suffix) and then empties f.
+//
+// The earlier log files are shifted along first (.1 to .2 and so on), keeping
+// at most FileRotationCount of them, so the oldest is dropped once the cap is
+// reached.
+//
+// NOTE: The file is truncated (rather than recreated) when rotated, so the
+// lock (which is tied to the open file) remains valid.
+func rotateLogFile(f *os.File, logPath string, size int64) error {
+	if FileRotationCount <= 0 {
+		return f.Truncate(0)
+	}
+	if err := os.Remove(fmt.Sprintf("%s.%d", logPath, FileRotationCount)); err != nil && !errors.Is(err, fs.ErrNotExist) {
 		return err
 	}
+	for i := FileRotationCount - 1; i >= 1; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", logPath, i), fmt.Sprintf("%s.%d", logPath, i+1))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the input is determined from our own package.
+	/* #nosec */
+	prev, err := os.OpenFile(logPath+".1", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(prev, io.NewSectionReader(f, 0, size)); err != nil {
+		_ = prev.Close()
+		return err
+	}
+	if err := prev.Close(); err != nil {
+		return err
+	}
+	return f.Truncate(0)
+}
+
+// JSONLogRecord is the representation of a LogEntry written by PersistJSON (one
+// JSON object per line).
+type JSONLogRecord struct {
+	Time              time.Time         `json:"time"`
+	Command           string            `json:"command"`
+	Error             string            `json:"error"`
+	Caller            map[string]any    `json:"caller,omitempty"`
+	Context           map[string]any    `json:"context,omitempty"`
+	CorrelationID     string            `json:"correlation_id,omitempty"`
+	APIVersion        string            `json:"api_version,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	ServiceResolution string            `json:"service_resolution,omitempty"`
+	// Count is the number of consecutive identical entries the record
+	// represents (omitted if there's only one), the last at LastTime.
+	Count    int        `json:"count,omitempty"`
+	LastTime *time.Time `json:"last_time,omitempty"`
+}
+
+// PersistJSON persists recorded log entries to disk as JSON lines (one
+// JSONLogRecord per entry), for consumption by tooling.
+//
+// NOTE: The command line, error, label values and (string) context values are
+// passed through FilterSecrets.
+func (l LogEntries) PersistJSON(logPath string, args []string) error {
+	if len(l) == 0 {
+		return nil
+	}
+
+	f, release, err := openLogFile(logPath)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	var labels map[string]string
+	if len(Labels) > 0 {
+		labels = make(map[string]string, len(Labels))
+		for k, v := range Labels {
+			labels[k] = FilterSecrets(v)
+		}
+	}
 
+	enc := json.NewEncoder(f)
+	for _, group := range l.collapse() {
+		record := JSONLogRecord{
+			Time:              group.Time,
+			Command:           FilterSecrets("fastly " + strings.Join(args, " ")),
+			Error:             group.redactedErr,
+			Caller:            group.Caller,
+			Context:           jsonLogContext(group.Context),
+			CorrelationID:     CorrelationID,
+			APIVersion:        APIVersion,
+			Labels:            labels,
+			ServiceResolution: ServiceResolution,
+		}
+		if group.Count > 1 {
+			record.Count = group.Count
+			record.LastTime = &group.LastTime
+		}
+		if err := enc.Encode(record); err != nil {
+			return &LogPersistError{Path: logPath, Err: err}
+		}
+	}
 	return nil
 }
 
+// logGroup is a run of consecutive identical log entries (see collapse),
+// represented by the first.
+type logGroup struct {
+	LogEntry
+	// Count is the number of entries in the group.
+	Count int
+	// LastTime is the timestamp of the last entry in the group.
+	LastTime time.Time
+
+	redactedErr string
+}
+
+// collapse groups consecutive identical entries (those with the same error,
+// once redacted by FilterSecrets, and the same Caller), so an error repeated
+// by a retry loop is only persisted once (with a count).
+//
+// NOTE: The redacted error is compared so that differing secrets (e.g. a
+// token) don't prevent the entries being grouped. The context of the first
+// entry in a group is kept.
+func (l LogEntries) collapse() []*logGroup {
+	var groups []*logGroup
+	for _, entry := range l {
+		var errStr string
+		if entry.Err != nil {
+			errStr = entry.Err.Error()
+		}
+		redacted := FilterSecrets(errStr)
+		if n := len(groups); n > 0 {
+			last := groups[n-1]
+			if last.redactedErr == redacted && reflect.DeepEqual(last.Caller, entry.Caller) {
+				last.Count++
+				last.LastTime = entry.Time
+				continue
+			}
+		}
+		groups = append(groups, &logGroup{
+			LogEntry:    entry,
+			Count:       1,
+			LastTime:    entry.Time,
+			redactedErr: redacted,
+		})
+	}
+	return groups
+}
+
+// jsonLogContext returns a copy of the context of a LogEntry in which each
+// value that can't be represented faithfully as JSON (e.g. an error) is
+// replaced with its string form, as displayed by Persist.
+func jsonLogContext(ctx map[string]any) map[string]any {
+	if len(ctx) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(ctx))
+	for k, v := range ctx {
+		switch v := v.(type) {
+		case nil, bool, int, int64, float64:
+			out[k] = v
+		case string:
+			out[k] = FilterSecrets(v)
+		default:
+			out[k] = FilterSecrets(fmt.Sprint(v))
+		}
+	}
+	return out
+}
+
 var (
 	// TokenRegEx matches a Token as part of the error output (https://regex101.com/r/ulIw1m/1)
 	TokenRegEx = regexp.MustCompile(`Token ([\w-]+)`)
-	// TokenFlagRegEx matches the token flag (https://regex101.com/r/YNr78Q/1)
-	TokenFlagRegEx = regexp.MustCompile(`(-t|--token)(\s*=?\s*['"]?)([\w-]+)(['"]?)`)
+	// TokenFlagRegEx matches the token flag (https://regex101.com/r/YNr78Q/1).
+	// The short form must start an argument, so another flag (e.g.
+	// --tls-client-key) isn't mistaken for it.
+	TokenFlagRegEx = regexp.MustCompile(`((?:^|\s)-t|--token)(\s*=?\s*['"]?)([\w-]+)(['"]?)`)
 )
 
-// FilterToken replaces any matched patterns with "REDACTED".
+// FilterToken replaces any matched patterns, and any secrets entered
+// interactively (see text.ReadSecret), with "REDACTED".
 //
 // EXAMPLE: https://go.dev/play/p/cT4BwIh9Asa
 func FilterToken(input string) (inputFiltered string) {
-	inputFiltered = TokenRegEx.ReplaceAllString(input, "Token REDACTED")
+	inputFiltered = text.RedactSecrets(input)
+	inputFiltered = TokenRegEx.ReplaceAllString(inputFiltered, "Token REDACTED")
 	inputFiltered = TokenFlagRegEx.ReplaceAllString(inputFiltered, "${1}${2}REDACTED${4}")
 	return inputFiltered
 }
@@ -165,8 +549,19 @@ func FilterToken(input string) (inputFiltered string) {
 // createLogEntry generates the boilerplate of a LogEntry.
 func createLogEntry(err error) LogEntry {
 	le := LogEntry{
-		Time: Now(),
-		Err:  err,
+		Time:    Now(),
+		Err:     err,
+		Context: DNSErrorContext(err),
+	}
+	for _, ctx := range []map[string]any{TimeoutContext(err), StatusContext(err), FailedRequestContext(err)} {
+		if ctx == nil {
+			continue
+		}
+		if le.Context == nil {
+			le.Context = ctx
+		} else {
+			maps.Copy(le.Context, ctx)
+		}
 	}
 
 	_, file, line, ok := runtime.Caller(2)
@@ -204,6 +599,59 @@ type Caller struct {
 // a lock before updating the LogEntries.
 var logMutex sync.Mutex
 
+// CorrelationID uniquely identifies the current CLI invocation.
+//
+// NOTE: It's assigned by the app package during initialisation and is written
+// into the header of each persisted error log record (if set).
+var CorrelationID string
+
+// APIVersion is the pinned Fastly API version (via --api-version or the
+// config) for the current CLI invocation.
+//
+// NOTE: It's assigned by the app package once the flags are parsed and is
+// written into the header of each persisted error log record (if set).
+var APIVersion string
+
+// Labels are the user-defined key=value annotations (via --label) for the
+// current CLI invocation.
+//
+// NOTE: It's assigned by the app package once the flags are parsed and is
+// written into the header of each persisted error log record (if set). Values
+// are passed through FilterSecrets before being persisted.
+var Labels map[string]string
+
+// ServiceResolution describes how the Service ID and Service Version the
+// command operated on were resolved (their sources and the candidates).
+//
+// NOTE: It's assigned by the argparser package (see ServiceDetails) and is
+// written into the header of each persisted error log record (if set).
+var ServiceResolution string
+
+// RetryBudget describes the use of the invocation's retry budget (see
+// --retry-budget) when it was exhausted.
+//
+// NOTE: It's assigned by the app package when the command fails and is
+// written into the header of each persisted error log record (if set).
+var RetryBudget string
+
+// AllowInstrumentation controls whether AddWithStack records a stack trace.
+//
+// NOTE: It's independent of telemetry (see FASTLY_DISABLE_TELEMETRY), as the
+// stack trace is only written to the local error log. Routine logs aren't
+// bloated by it, as AddWithStack is only used for unexpected failures.
+var AllowInstrumentation = true
+
+// MaxLogEntries is the number of entries a LogEntries retains in memory. Once
+// exceeded, the oldest entries are dropped so the most recent are persisted.
+// A value of zero (or less) disables the limit.
+var MaxLogEntries = 1000
+
+// DroppedLogEntries counts the entries dropped because MaxLogEntries was
+// exceeded. It's noted in the header of the persisted error log record.
+//
+// NOTE: It's guarded by logMutex.
+var DroppedLogEntries int
+
 // Now is exposed so that we may mock it from our test file.
 //
 // NOTE: The ideal way to deal with time is to inject it as a dependency and
@@ -212,14 +660,36 @@ var logMutex sync.Mutex
 // when that call can be handled internally by the .Add() method.
 var Now = time.Now
 
+// DefaultFileRotationSize is the default FileRotationSize.
+const DefaultFileRotationSize int64 = 5242880 // 5mb
+
 // FileRotationSize represents the size the log file needs to be before we
-// truncate it.
+// rotate it (i.e. move its contents to the previous log file, with a .1
+// suffix). A value of zero (or less) disables rotation by size.
 //
 // NOTE: To enable easier testing of the log rotation logic, we don't define
 // this as a constant but as a variable so the test file can mutate the value
 // to something much smaller, meaning we can commit a small test file as part
 // of the testing logic that will trigger a 'over the threshold' scenario.
-var FileRotationSize int64 = 5242880 // 5mb
+var FileRotationSize = DefaultFileRotationSize
+
+// FileRotationAge is how old the oldest entry in the log file can be before
+// it's rotated. A value of zero disables rotation by age.
+//
+// NOTE: FileRotationSize and FileRotationAge are assigned by the app package
+// once the flags are parsed (see --error-log-max-size and
+// --error-log-max-days, and the equivalent config).
+var FileRotationAge time.Duration
+
+// DefaultFileRotationCount is the default FileRotationCount.
+const DefaultFileRotationCount = 3
+
+// FileRotationCount is the number of rotated log files kept (errors.log.1 being
+// the most recent). A value of zero (or less) keeps none.
+//
+// NOTE: It's assigned by the app package once the flags are parsed (see
+// --error-log-backups, and the equivalent config).
+var FileRotationCount = DefaultFileRotationCount
 
 // ServiceVersion returns an integer regardless of whether the given argument
 // is a nil pointer or not. It helps to reduce the boilerplate found across the
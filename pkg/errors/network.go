@@ -0,0 +1,111 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"syscall"
+)
+
+// IsConnectionRefused indicates if the error was caused by the remote host
+// refusing the connection.
+func IsConnectionRefused(err error) bool {
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// IsDNSError indicates if the error was caused by a failure to resolve the
+// remote host.
+func IsDNSError(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+// DNSError returns the resolver error that caused err (if any).
+func DNSError(err error) (*net.DNSError, bool) {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr, true
+	}
+	return nil, false
+}
+
+// DNSErrorContext returns the resolver error detail to record in the error log
+// alongside err, or nil if err wasn't caused by a DNS failure.
+func DNSErrorContext(err error) map[string]any {
+	dnsErr, ok := DNSError(err)
+	if !ok {
+		return nil
+	}
+	ctx := map[string]any{
+		"DNS Host":      dnsErr.Name,
+		"DNS Error":     dnsErr.Err,
+		"DNS Not Found": dnsErr.IsNotFound,
+		"DNS Temporary": dnsErr.IsTemporary,
+		"DNS Timeout":   dnsErr.IsTimeout,
+	}
+	if dnsErr.Server != "" {
+		ctx["DNS Server"] = dnsErr.Server
+	}
+	return ctx
+}
+
+// IsUnreachable indicates if the error was caused by the remote host being
+// unreachable (i.e. the connection was refused or the host didn't resolve).
+func IsUnreachable(err error) bool {
+	return IsConnectionRefused(err) || IsDNSError(err)
+}
+
+// IsRetryable indicates if the request that caused the error is safe to retry
+// because it failed before reaching the remote host or failed transiently.
+//
+// NOTE: Every DNS failure is considered retryable (including "no such host")
+// as on a flaky network the resolver can fail for a valid host, and a retry
+// with backoff is cheap compared to failing the command.
+func IsRetryable(err error) bool {
+	if IsConnectionRefused(err) || IsDNSError(err) {
+		return true
+	}
+	if t, ok := err.(interface{ Temporary() bool }); ok && t.Temporary() {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// IsTimeout indicates if the error was caused by a deadline being exceeded,
+// either a context deadline (e.g. --timeout-per-item) or a HTTP client timeout.
+// A context.Canceled error (e.g. the user interrupted the command) isn't.
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// TimeoutContext returns the timeout detail to record in the error log
+// alongside err, or nil if err wasn't caused by an exceeded TimeoutError.
+func TimeoutContext(err error) map[string]any {
+	var te TimeoutError
+	if !errors.As(err, &te) {
+		return nil
+	}
+	return map[string]any{
+		"Timeout": te.Timeout.String(),
+	}
+}
+
+// UnreachableEndpoint returns the scheme and host of the request that caused
+// the error, or an empty string if it can't be determined.
+func UnreachableEndpoint(err error) string {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return ""
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
@@ -0,0 +1,136 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+var dnsError = &url.Error{
+	Op:  "Get",
+	URL: "https://api.example.com/service",
+	Err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "server misbehaving", Name: "api.example.com", Server: "10.0.0.1:53", IsTemporary: true}},
+}
+
+func TestIsRetryable(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input error
+		want  bool
+	}{
+		{
+			name:  "DNS failure",
+			input: fmt.Errorf("error listing services: %w", dnsError),
+			want:  true,
+		},
+		{
+			name:  "DNS host not found",
+			input: &net.DNSError{Err: "no such host", Name: "api.example.com", IsNotFound: true},
+			want:  true,
+		},
+		{
+			name:  "connection refused",
+			input: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)},
+			want:  true,
+		},
+		{
+			name:  "other error",
+			input: fmt.Errorf("boom"),
+			want:  false,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			testutil.AssertBool(t, testcase.want, errors.IsRetryable(testcase.input))
+		})
+	}
+}
+
+func TestDNSErrorContext(t *testing.T) {
+	testutil.AssertEqual(t, map[string]any(nil), errors.DNSErrorContext(fmt.Errorf("boom")))
+
+	want := map[string]any{
+		"DNS Host":      "api.example.com",
+		"DNS Error":     "server misbehaving",
+		"DNS Not Found": false,
+		"DNS Server":    "10.0.0.1:53",
+		"DNS Temporary": true,
+		"DNS Timeout":   false,
+	}
+	testutil.AssertEqual(t, want, errors.DNSErrorContext(dnsError))
+
+	// The resolver detail is recorded alongside any context from the caller.
+	le := new(errors.LogEntries)
+	ctx := map[string]any{"Service ID": "123"}
+	le.AddWithContext(dnsError, ctx)
+	le.Add(dnsError)
+
+	want["Service ID"] = "123"
+	testutil.AssertEqual(t, want, (*le)[0].Context)
+	testutil.AssertEqual(t, map[string]any{"Service ID": "123"}, ctx)
+	testutil.AssertEqual(t, "api.example.com", (*le)[1].Context["DNS Host"])
+}
+
+func TestIsTimeout(t *testing.T) {
+	for _, testcase := range []struct {
+		name  string
+		input error
+		want  bool
+	}{
+		{
+			name:  "wrapped deadline",
+			input: fmt.Errorf("error listing services: %w", context.DeadlineExceeded),
+			want:  true,
+		},
+		{
+			name:  "timeout error",
+			input: errors.TimeoutError{Timeout: time.Second, Err: context.DeadlineExceeded},
+			want:  true,
+		},
+		{
+			name:  "HTTP client timeout",
+			input: &url.Error{Op: "Get", URL: "https://api.example.com/service", Err: timeoutNetError{}},
+			want:  true,
+		},
+		{
+			name:  "canceled",
+			input: fmt.Errorf("error listing services: %w", context.Canceled),
+			want:  false,
+		},
+		{
+			name:  "generic error",
+			input: fmt.Errorf("boom"),
+			want:  false,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			testutil.AssertEqual(t, testcase.want, errors.IsTimeout(testcase.input))
+		})
+	}
+}
+
+func TestTimeoutContext(t *testing.T) {
+	testutil.AssertEqual(t, map[string]any(nil), errors.TimeoutContext(context.DeadlineExceeded))
+
+	err := fmt.Errorf("error deleting key: %w", errors.TimeoutError{Timeout: 1500 * time.Millisecond, Err: context.DeadlineExceeded})
+	testutil.AssertString(t, "error deleting key: operation timed out after 1.5s", err.Error())
+	testutil.AssertEqual(t, map[string]any{"Timeout": "1.5s"}, errors.TimeoutContext(err))
+
+	le := new(errors.LogEntries)
+	le.AddWithContext(err, map[string]any{"Service ID": "123"})
+	testutil.AssertEqual(t, map[string]any{"Service ID": "123", "Timeout": "1.5s"}, (*le)[0].Context)
+}
+
+// timeoutNetError is a net.Error that reports a timeout.
+type timeoutNetError struct{}
+
+func (timeoutNetError) Error() string   { return "Client.Timeout exceeded while awaiting headers" }
+func (timeoutNetError) Timeout() bool   { return true }
+func (timeoutNetError) Temporary() bool { return false }
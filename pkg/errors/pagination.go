@@ -0,0 +1,26 @@
+package errors
+
+import "fmt"
+
+// PaginationError indicates a paginated list request failed part way through,
+// after one or more pages had already been retrieved (and displayed).
+type PaginationError struct {
+	// Page is the page number that failed to be retrieved.
+	Page int
+	// Err is the underlying error.
+	Err error
+}
+
+// Unwrap returns the underlying error.
+func (e PaginationError) Unwrap() error {
+	return e.Err
+}
+
+// Error implements the error interface.
+func (e PaginationError) Error() string {
+	return fmt.Sprintf("pagination stopped early at page %d: %s", e.Page, e.Err)
+}
+
+// PaginationRemediation explains the displayed results are incomplete and how
+// to resume from the failed page.
+var PaginationRemediation = "The output above is incomplete and only contains the pages retrieved before the failure. Re-run the command (e.g. with --page %d) to fetch the remaining results."
@@ -3,33 +3,145 @@ package errors
 import (
 	"errors"
 	"io"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/fatih/color"
 
 	"github.com/fastly/cli/pkg/text"
 )
 
+// ReportFlags are the values of the global flags that affect how an error is
+// reported.
+type ReportFlags struct {
+	// ErrorLogJSON writes the error log as JSON lines.
+	ErrorLogJSON bool
+	// Explain prints structured guidance when a command fails.
+	Explain bool
+	// JSONErrorsOnly writes the error as JSON to the output, which is
+	// otherwise suppressed.
+	JSONErrorsOnly bool
+	// MaskIDs masks identifiers (e.g. a service ID) in the error.
+	MaskIDs bool
+	// QuietErrors silences notices about failing to write the error log.
+	QuietErrors bool
+}
+
+// Flags are the parsed values of the ReportFlags. They're assigned by the app
+// once the arguments are parsed, and are nil until then (e.g. when parsing
+// failed), in which case the flags are inspected in the raw arguments.
+var Flags *ReportFlags
+
+// flagSet reports whether the global flag called name is set, using the
+// parsed value when the arguments were parsed and otherwise the raw arguments
+// (where the flag can be given as --name or --name=<bool>).
+func flagSet(args []string, name string, parsed func(*ReportFlags) bool) bool {
+	if Flags != nil {
+		return parsed(Flags)
+	}
+	for _, a := range args {
+		if a == name {
+			return true
+		}
+		if v, ok := strings.CutPrefix(a, name+"="); ok {
+			b, err := strconv.ParseBool(v)
+			return err == nil && b
+		}
+	}
+	return false
+}
+
 // Process persists the error log to disk and deduces the error type.
 func Process(err error, args []string, out io.Writer) (skipExit bool) {
-	text.Break(out)
+	jsonErrorsOnly := flagSet(args, "--json-errors-only", func(f *ReportFlags) bool { return f.JSONErrorsOnly })
+	// NOTE: The error can contain a service ID, whether it's written to out or
+	// to stderr.
+	var stderr io.Writer = color.Error
+	if flagSet(args, "--mask-ids", func(f *ReportFlags) bool { return f.MaskIDs }) {
+		out = text.NewMaskWriter(out)
+		stderr = text.NewMaskWriter(stderr)
+	}
+	if !jsonErrorsOnly {
+		text.Break(out)
+	}
 
 	// NOTE: We persist any error log entries to disk before attempting to handle
 	// a possible error response from app.Run as there could be errors recorded
 	// during the execution flow but were otherwise handled without bubbling an
 	// error back the call stack, and so if the user still experiences something
 	// unexpected we will have a record of any errors that happened along the way.
-	logErr := Log.Persist(LogPath, args[1:])
-	if logErr != nil {
-		Deduce(logErr).Print(color.Error)
+	//
+	// Network errors are rewritten by Deduce into a friendlier message, so we
+	// record the original error (and the unreachable endpoint) for reference.
+	if IsUnreachable(err) {
+		Log.AddWithContext(err, map[string]any{
+			"Endpoint": UnreachableEndpoint(err),
+		})
 	}
 
-	// IMPORTANT: Deduce/Print needs to happen before checking for Skip.
-	// This is so the help output can be printed.
-	Deduce(err).Print(color.Error)
+	// NOTE: --quiet-errors only silences a failure to write the error log, never
+	// the command error.
+	logErr := PersistLog(args)
+	if logErr != nil && !flagSet(args, "--quiet-errors", func(f *ReportFlags) bool { return f.QuietErrors }) {
+		Deduce(logErr).Print(stderr)
+	}
 
 	exitError := SkipExitError{}
-	if errors.As(err, &exitError) {
+	isExitError := errors.As(err, &exitError)
+
+	// With --json-errors-only the error is written to out (where the command
+	// output is otherwise suppressed) as the only output of the CLI.
+	if jsonErrorsOnly {
+		if isExitError {
+			return exitError.Skip
+		}
+		if jsonErr := WriteExplainJSON(out, err); jsonErr != nil {
+			Deduce(jsonErr).Print(stderr)
+		}
+		return false
+	}
+
+	explain := !isExitError && flagSet(args, "--explain", func(f *ReportFlags) bool { return f.Explain })
+
+	if explain && (slices.Contains(args, "--json") || slices.Contains(args, "-j")) {
+		if jsonErr := WriteExplainJSON(stderr, err); jsonErr != nil {
+			Deduce(jsonErr).Print(stderr)
+		}
+	} else {
+		// IMPORTANT: Deduce/Print needs to happen before checking for Skip.
+		// This is so the help output can be printed.
+		Deduce(err).Print(stderr)
+		if explain {
+			Explain(err).Print(stderr)
+		}
+	}
+
+	if isExitError {
 		return exitError.Skip
 	}
+
+	if CorrelationID != "" {
+		text.Break(stderr)
+		text.Output(stderr, "Correlation ID: %s — include this when filing a support ticket.", CorrelationID)
+	}
 	return false
 }
+
+// PersistLog persists the recorded entries of Log to LogPath and, when
+// --error-log-json is set, also to JSONLogPath. It's called at the end of each
+// command's execution, so Log is then cleared (even if persisting failed) to
+// stop it growing across the commands of a long-running process.
+//
+// Each log is persisted even if the other can't be, and the errors of both
+// are returned.
+//
+// NOTE: The args include the program name.
+func PersistLog(args []string) error {
+	defer Log.Clear()
+	err := Log.Persist(LogPath, args[1:])
+	if flagSet(args, "--error-log-json", func(f *ReportFlags) bool { return f.ErrorLogJSON }) {
+		err = errors.Join(err, Log.PersistJSON(JSONLogPath, args[1:]))
+	}
+	return err
+}
@@ -0,0 +1,327 @@
+package errors_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+)
+
+func TestProcessQuietErrors(t *testing.T) {
+	originalLog, originalLogPath, originalStderr, originalID, originalFlags := errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags
+	defer func() {
+		errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags = originalLog, originalLogPath, originalStderr, originalID, originalFlags
+	}()
+	errors.CorrelationID = ""
+
+	// The log file can't be written as its directory doesn't exist.
+	errors.LogPath = filepath.Join(t.TempDir(), "missing", "errors.log")
+
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		flags      *errors.ReportFlags
+		wantNotice bool
+	}{
+		{
+			name:       "log write failure is displayed",
+			args:       []string{"fastly", "version"},
+			wantNotice: true,
+		},
+		{
+			name: "log write failure is silenced",
+			args: []string{"fastly", "version", "--quiet-errors"},
+		},
+		{
+			name:  "log write failure is silenced by the parsed flag",
+			args:  []string{"fastly", "version"},
+			flags: &errors.ReportFlags{QuietErrors: true},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			errors.Log = new(errors.LogEntries)
+			errors.Flags = testcase.flags
+			errors.Log.Add(fmt.Errorf("recorded"))
+
+			var stderr, stdout bytes.Buffer
+			color.Error = &stderr
+			errors.Process(fmt.Errorf("command failed"), testcase.args, &stdout)
+
+			// The command error must always be displayed.
+			testutil.AssertStringContains(t, stderr.String(), "command failed")
+			testutil.AssertBool(t, testcase.wantNotice, bytes.Contains(stderr.Bytes(), []byte("error accessing audit log file")))
+		})
+	}
+}
+
+func TestProcessExplain(t *testing.T) {
+	originalLog, originalLogPath, originalStderr, originalID, originalFlags := errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags
+	defer func() {
+		errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags = originalLog, originalLogPath, originalStderr, originalID, originalFlags
+	}()
+	errors.LogPath = filepath.Join(t.TempDir(), "errors.log")
+	errors.CorrelationID = ""
+
+	for _, testcase := range []struct {
+		name        string
+		args        []string
+		flags       *errors.ReportFlags
+		wantExplain bool
+	}{
+		{
+			name:        "raw flag",
+			args:        []string{"fastly", "version", "--explain"},
+			wantExplain: true,
+		},
+		{
+			name:        "raw flag with a value",
+			args:        []string{"fastly", "version", "--explain=true"},
+			wantExplain: true,
+		},
+		{
+			name: "raw flag set to false",
+			args: []string{"fastly", "version", "--explain=false"},
+		},
+		{
+			name:        "parsed flag",
+			args:        []string{"fastly", "version"},
+			flags:       &errors.ReportFlags{Explain: true},
+			wantExplain: true,
+		},
+		{
+			name:  "parsed flag takes precedence",
+			args:  []string{"fastly", "version", "--", "--explain"},
+			flags: &errors.ReportFlags{},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			errors.Log = new(errors.LogEntries)
+			errors.Flags = testcase.flags
+
+			var stderr, stdout bytes.Buffer
+			color.Error = &stderr
+			errors.Process(fmt.Errorf("command failed"), testcase.args, &stdout)
+
+			testutil.AssertStringContains(t, stderr.String(), "command failed")
+			testutil.AssertBool(t, testcase.wantExplain, bytes.Contains(stderr.Bytes(), []byte("Category:")))
+		})
+	}
+}
+
+func TestProcessMaskIDs(t *testing.T) {
+	originalLog, originalLogPath, originalStderr, originalID, originalFlags := errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags
+	defer func() {
+		errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags = originalLog, originalLogPath, originalStderr, originalID, originalFlags
+	}()
+	errors.LogPath = filepath.Join(t.TempDir(), "errors.log")
+	errors.CorrelationID = ""
+	text.ResetIDs()
+	defer text.ResetIDs()
+	text.RegisterID(text.IDKindService, "abc123")
+
+	for _, testcase := range []struct {
+		name     string
+		args     []string
+		flags    *errors.ReportFlags
+		wantMask bool
+	}{
+		{
+			name:     "raw flag",
+			args:     []string{"fastly", "service", "describe", "--mask-ids"},
+			wantMask: true,
+		},
+		{
+			name: "raw flag set to false",
+			args: []string{"fastly", "service", "describe", "--mask-ids=false"},
+		},
+		{
+			name:     "parsed flag",
+			args:     []string{"fastly", "service", "describe"},
+			flags:    &errors.ReportFlags{MaskIDs: true},
+			wantMask: true,
+		},
+		{
+			name:  "parsed flag takes precedence",
+			args:  []string{"fastly", "service", "describe", "--", "--mask-ids"},
+			flags: &errors.ReportFlags{},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			errors.Log = new(errors.LogEntries)
+			errors.Flags = testcase.flags
+
+			var stderr, stdout bytes.Buffer
+			color.Error = &stderr
+			errors.Process(fmt.Errorf("service abc123 not found"), testcase.args, &stdout)
+
+			output := stdout.String() + stderr.String()
+			testutil.AssertBool(t, !testcase.wantMask, strings.Contains(output, "abc123"))
+		})
+	}
+}
+
+func TestFilterTokenRedactsSecrets(t *testing.T) {
+	text.RegisterSecret("s3cr3t-entered-value")
+	testutil.AssertString(t, "error: invalid secret REDACTED", errors.FilterToken("error: invalid secret s3cr3t-entered-value"))
+}
+
+func TestProcessJSONErrorsOnly(t *testing.T) {
+	originalLog, originalLogPath, originalStderr, originalID, originalFlags := errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags
+	defer func() {
+		errors.Log, errors.LogPath, color.Error, errors.CorrelationID, errors.Flags = originalLog, originalLogPath, originalStderr, originalID, originalFlags
+	}()
+	errors.LogPath = filepath.Join(t.TempDir(), "errors.log")
+	errors.CorrelationID = "abc"
+
+	for _, testcase := range []struct {
+		name     string
+		args     []string
+		flags    *errors.ReportFlags
+		wantJSON bool
+	}{
+		{
+			name:     "raw flag",
+			args:     []string{"fastly", "kv-store-entry", "delete", "--all", "--json-errors-only"},
+			wantJSON: true,
+		},
+		{
+			name: "raw flag set to false",
+			args: []string{"fastly", "kv-store-entry", "delete", "--all", "--json-errors-only=false"},
+		},
+		{
+			name:     "parsed flag",
+			args:     []string{"fastly", "kv-store-entry", "delete", "--all"},
+			flags:    &errors.ReportFlags{JSONErrorsOnly: true},
+			wantJSON: true,
+		},
+		{
+			name:  "parsed flag takes precedence",
+			args:  []string{"fastly", "kv-store-entry", "delete", "--all", "--", "--json-errors-only"},
+			flags: &errors.ReportFlags{},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			errors.Log = new(errors.LogEntries)
+			errors.Flags = testcase.flags
+
+			var stderr, stdout bytes.Buffer
+			color.Error = &stderr
+			err := fmt.Errorf("error deleting keys: %w", errors.BulkError{Action: "delete", Noun: "keys", IDs: []string{"foo"}, Failed: 1, Succeeded: 2, Total: 3})
+			errors.Process(err, testcase.args, &stdout)
+
+			if !testcase.wantJSON {
+				testutil.AssertStringContains(t, stderr.String(), "error deleting keys")
+				return
+			}
+			testutil.AssertString(t, "", stderr.String())
+
+			var have errors.ExplainedError
+			if err := json.Unmarshal(stdout.Bytes(), &have); err != nil {
+				t.Fatal(err)
+			}
+			testutil.AssertString(t, err.Error(), have.Error)
+			testutil.AssertEqual(t, errors.ExitCodeBulkPartial, have.ExitCode)
+			testutil.AssertString(t, "abc", have.CorrelationID)
+			testutil.AssertEqual(t, &errors.BulkError{Action: "delete", Noun: "keys", IDs: []string{"foo"}, Failed: 1, Succeeded: 2, Total: 3}, have.Bulk)
+		})
+	}
+}
+
+func TestPersistLogClearsLog(t *testing.T) {
+	originalLog, originalLogPath, originalDropped := errors.Log, errors.LogPath, errors.DroppedLogEntries
+	defer func() {
+		errors.Log, errors.LogPath, errors.DroppedLogEntries = originalLog, originalLogPath, originalDropped
+	}()
+	errors.Log = new(errors.LogEntries)
+	errors.LogPath = filepath.Join(t.TempDir(), "errors.log")
+	errors.DroppedLogEntries = 2
+
+	errors.Log.Add(fmt.Errorf("first"))
+	err := errors.PersistLog([]string{"fastly", "version"})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 0, len(*errors.Log))
+	testutil.AssertEqual(t, 0, errors.DroppedLogEntries)
+
+	// Only the entries recorded since the last command are persisted.
+	errors.Log.Add(fmt.Errorf("second"))
+	err = errors.PersistLog([]string{"fastly", "version"})
+	testutil.AssertNoError(t, err)
+
+	have, err := os.ReadFile(errors.LogPath)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, 1, bytes.Count(have, []byte("ERROR:\nfirst")))
+	testutil.AssertEqual(t, 1, bytes.Count(have, []byte("ERROR:\nsecond")))
+}
+
+func TestPersistLogJSON(t *testing.T) {
+	originalLog, originalLogPath, originalJSONLogPath, originalFlags := errors.Log, errors.LogPath, errors.JSONLogPath, errors.Flags
+	defer func() {
+		errors.Log, errors.LogPath, errors.JSONLogPath, errors.Flags = originalLog, originalLogPath, originalJSONLogPath, originalFlags
+	}()
+
+	for _, testcase := range []struct {
+		name     string
+		args     []string
+		flags    *errors.ReportFlags
+		wantJSON bool
+	}{
+		{
+			name: "not requested",
+			args: []string{"fastly", "version"},
+		},
+		{
+			name:     "raw flag",
+			args:     []string{"fastly", "version", "--error-log-json"},
+			wantJSON: true,
+		},
+		{
+			name:     "parsed flag",
+			args:     []string{"fastly", "version"},
+			flags:    &errors.ReportFlags{ErrorLogJSON: true},
+			wantJSON: true,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			errors.Log = new(errors.LogEntries)
+			errors.LogPath = filepath.Join(dir, "errors.log")
+			errors.JSONLogPath = filepath.Join(dir, "errors.jsonl")
+			errors.Flags = testcase.flags
+
+			errors.Log.Add(fmt.Errorf("recorded"))
+			testutil.AssertNoError(t, errors.PersistLog(testcase.args))
+
+			_, err := os.Stat(errors.JSONLogPath)
+			testutil.AssertBool(t, testcase.wantJSON, err == nil)
+		})
+	}
+}
+
+func TestPersistLogJSONWhenLogFails(t *testing.T) {
+	originalLog, originalLogPath, originalJSONLogPath := errors.Log, errors.LogPath, errors.JSONLogPath
+	defer func() {
+		errors.Log, errors.LogPath, errors.JSONLogPath = originalLog, originalLogPath, originalJSONLogPath
+	}()
+	dir := t.TempDir()
+	errors.Log = new(errors.LogEntries)
+	// The text log can't be written as its path is a directory.
+	errors.LogPath = dir
+	errors.JSONLogPath = filepath.Join(dir, "errors.jsonl")
+
+	errors.Log.Add(fmt.Errorf("recorded"))
+	err := errors.PersistLog([]string{"fastly", "version", "--error-log-json"})
+	testutil.AssertErrorContains(t, err, dir)
+
+	have, err := os.ReadFile(errors.JSONLogPath)
+	testutil.AssertNoError(t, err)
+	testutil.AssertBool(t, true, bytes.Contains(have, []byte("recorded")))
+}
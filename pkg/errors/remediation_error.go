@@ -59,12 +59,48 @@ var AuthRemediation = fmt.Sprintf(strings.Join([]string{
 	"Verify that the token is still valid via `fastly whoami`.",
 }, " "), env.APIToken)
 
+// PermissionRemediation suggests checking the scopes of the provided --token.
+var PermissionRemediation = strings.Join([]string{
+	"This error may be caused by an API token that doesn't have the scope (e.g. global or purge_select)",
+	"or the user role required for this operation, or that is limited to other services.",
+	"Check the token's scopes and services via `fastly whoami` or `fastly auth-token describe`.",
+}, " ")
+
+// NotFoundRemediation suggests verifying the service, version and resource
+// name.
+var NotFoundRemediation = strings.Join([]string{
+	"This error may be caused by an incorrect service ID, service version, or resource name.",
+	"Verify the service via `fastly service list`, its versions via `fastly service-version list`,",
+	"and that the resource exists in the selected version.",
+}, " ")
+
 // NetworkRemediation suggests, somewhat unhelpfully, to try again later.
 var NetworkRemediation = strings.Join([]string{
 	"This error may be caused by transient network issues.",
 	"Please verify your network connection and DNS configuration, and try again.",
 }, " ")
 
+// DNSRemediation suggests checking the DNS resolver and the configured API
+// endpoint, as a DNS failure may be transient.
+var DNSRemediation = fmt.Sprintf(strings.Join([]string{
+	"Check your network connection and DNS resolver configuration (a transient DNS failure may succeed if the command is re-run),",
+	"or the API endpoint hostname set via --api or the %s environment variable.",
+}, " "), env.APIEndpoint)
+
+// UnreachableRemediation suggests checking the network and the configured
+// API endpoint.
+var UnreachableRemediation = fmt.Sprintf(strings.Join([]string{
+	"Check your network connection and DNS configuration,",
+	"or the API endpoint set via --api or the %s environment variable.",
+}, " "), env.APIEndpoint)
+
+// TimeoutRemediation suggests re-running the command, or raising the timeout,
+// as the operation didn't complete in time.
+var TimeoutRemediation = strings.Join([]string{
+	"The operation didn't complete in time, which may be caused by a slow network or a busy API.",
+	"Re-run the command, or increase the timeout (e.g. --timeout-per-item) if it consistently times out.",
+}, " ")
+
 // HostRemediation suggests there might be an issue with the local host.
 var HostRemediation = strings.Join([]string{
 	"This error may be caused by a problem with your host environment, for example",
@@ -170,3 +206,7 @@ var InvalidStaticConfigRemediation = strings.Join([]string{
 var TokenExpirationRemediation = strings.Join([]string{
 	"Run 'fastly --profile <NAME> sso' to refresh the token.",
 }, " ")
+
+// FailOnWarningRemediation suggests resolving warnings escalated by the
+// --fail-on-warning flag.
+var FailOnWarningRemediation = "Resolve the warnings above, or re-run the command without --fail-on-warning."
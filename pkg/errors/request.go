@@ -0,0 +1,81 @@
+package errors
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+)
+
+// failedRequest is the most recent API request that failed (see
+// RecordFailedRequest).
+var failedRequest struct {
+	mu     sync.Mutex
+	method string
+	path   string
+	status int
+	// sizes are the request and response body sizes (in bytes), if known.
+	sizes []int64
+}
+
+// RecordFailedRequest records the method and path of an API request that
+// failed with the given status code (zero if no response was received), so
+// that it can be attached to the error log entry of the resulting API error.
+//
+// NOTE: It's called by the API client's transport (see api.Transport), which
+// is wired up by the app package. Only the path is recorded, as the query
+// string can contain secrets.
+func RecordFailedRequest(method, path string, status int) {
+	failedRequest.mu.Lock()
+	defer failedRequest.mu.Unlock()
+	failedRequest.method = method
+	failedRequest.path = path
+	failedRequest.status = status
+	failedRequest.sizes = nil
+}
+
+// RecordFailedRequestSizes records the request and response body sizes of the
+// failed API request recorded via RecordFailedRequest, once its response body
+// has been read.
+//
+// NOTE: The sizes are ignored if a different request was recorded since.
+func RecordFailedRequestSizes(method, path string, status int, request, response int64) {
+	failedRequest.mu.Lock()
+	defer failedRequest.mu.Unlock()
+	if failedRequest.method != method || failedRequest.path != path || failedRequest.status != status {
+		return
+	}
+	failedRequest.sizes = []int64{request, response}
+}
+
+// ResetFailedRequest forgets the recorded failed request.
+func ResetFailedRequest() {
+	RecordFailedRequest("", "", 0)
+}
+
+// FailedRequestContext returns the method and path of the API request that
+// caused err, or nil if err isn't an API error or the request is unknown.
+//
+// NOTE: Only the most recent failed request is recorded, so it's only used if
+// its status code matches the error's (in case requests were made
+// concurrently and a different one failed last).
+func FailedRequestContext(err error) map[string]any {
+	var httpErr *fastly.HTTPError
+	if !errors.As(err, &httpErr) {
+		return nil
+	}
+	failedRequest.mu.Lock()
+	defer failedRequest.mu.Unlock()
+	if failedRequest.path == "" || failedRequest.status != httpErr.StatusCode {
+		return nil
+	}
+	ctx := map[string]any{
+		"API Method": failedRequest.method,
+		"API Path":   failedRequest.path,
+	}
+	if failedRequest.sizes != nil {
+		ctx["API Request Bytes"] = failedRequest.sizes[0]
+		ctx["API Response Bytes"] = failedRequest.sizes[1]
+	}
+	return ctx
+}
@@ -0,0 +1,44 @@
+package errors_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestLogAddFailedRequest(t *testing.T) {
+	defer errors.ResetFailedRequest()
+	errors.RecordFailedRequest(http.MethodGet, "/service/123/version/1/logging/cloudfiles/logs", http.StatusNotFound)
+
+	le := new(errors.LogEntries)
+	le.AddWithContext(&fastly.HTTPError{StatusCode: http.StatusNotFound}, map[string]any{"Service ID": "123"})
+	le.Add(&fastly.HTTPError{StatusCode: http.StatusInternalServerError}) // a different request failed
+	le.Add(fmt.Errorf("not an API error"))
+
+	testutil.AssertEqual(t, map[string]any{
+		"API Method": http.MethodGet,
+		"API Path":   "/service/123/version/1/logging/cloudfiles/logs",
+		"API Status": http.StatusNotFound,
+		"Service ID": "123",
+	}, (*le)[0].Context)
+	testutil.AssertEqual(t, map[string]any{"API Status": http.StatusInternalServerError}, (*le)[1].Context)
+	testutil.AssertEqual(t, map[string]any(nil), (*le)[2].Context)
+
+	// The body sizes are only attached to the request they were recorded for.
+	errors.RecordFailedRequestSizes(http.MethodGet, "/service/123", http.StatusNotFound, 10, 20)
+	errors.RecordFailedRequestSizes(http.MethodGet, "/service/123/version/1/logging/cloudfiles/logs", http.StatusNotFound, 0, 42)
+	le = new(errors.LogEntries)
+	le.Add(&fastly.HTTPError{StatusCode: http.StatusNotFound})
+	testutil.AssertEqual(t, map[string]any{
+		"API Method":         http.MethodGet,
+		"API Path":           "/service/123/version/1/logging/cloudfiles/logs",
+		"API Request Bytes":  int64(0),
+		"API Response Bytes": int64(42),
+		"API Status":         http.StatusNotFound,
+	}, (*le)[0].Context)
+}
@@ -0,0 +1,83 @@
+package errors
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/fastly/cli/pkg/text"
+)
+
+// SecretPattern redacts the text matched by Pattern, replacing each match with
+// Replacement (which can refer to submatches, e.g. "${1}REDACTED").
+type SecretPattern struct {
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// secretPatterns are the redactors applied by FilterSecrets (in order), in
+// addition to those applied by FilterToken.
+var secretPatterns = struct {
+	mu       sync.Mutex
+	patterns []SecretPattern
+}{
+	patterns: []SecretPattern{
+		// A PEM encoded private key (e.g. a TLS private key).
+		{
+			Pattern:     regexp.MustCompile(`(?s)(-----BEGIN [A-Z ]*PRIVATE KEY-----).*?(-----END [A-Z ]*PRIVATE KEY-----)`),
+			Replacement: "${1}REDACTED${2}",
+		},
+		// A sensitive field (see text.SensitiveFields), as a query parameter
+		// (access_key=...), JSON field ("access_key": "...") or struct field
+		// (AccessKey:...).
+		{
+			Pattern:     text.SensitiveValueRegexp,
+			Replacement: "${1}REDACTED",
+		},
+		// An AWS access key ID.
+		{
+			Pattern:     regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+			Replacement: "REDACTED",
+		},
+		// A bearer authorization header (or the Fastly-Key header).
+		{
+			Pattern:     regexp.MustCompile(`(?i)(Bearer\s+|Fastly-Key:\s*)[\w.~+/=-]+`),
+			Replacement: "${1}REDACTED",
+		},
+		// A sensitive flag, in either the --flag=value or --flag value form
+		// (e.g. --access-key abc123).
+		{
+			Pattern:     text.SensitiveFlagRegexp,
+			Replacement: "${1}${2}REDACTED",
+		},
+		// The customer ID flag.
+		{
+			Pattern:     regexp.MustCompile(`(--customer-id)(\s*=?\s*['"]?)([\w-]+)(['"]?)`),
+			Replacement: "${1}${2}REDACTED${4}",
+		},
+	},
+}
+
+// RegisterSecretPattern adds a redactor applied by FilterSecrets, replacing
+// the text matched by pattern with replacement (which can refer to submatches,
+// e.g. "${1}REDACTED").
+func RegisterSecretPattern(pattern *regexp.Regexp, replacement string) {
+	secretPatterns.mu.Lock()
+	defer secretPatterns.mu.Unlock()
+	secretPatterns.patterns = append(secretPatterns.patterns, SecretPattern{
+		Pattern:     pattern,
+		Replacement: replacement,
+	})
+}
+
+// FilterSecrets replaces the secrets redacted by FilterToken, and any matched
+// by the registered SecretPatterns (e.g. private keys, access keys and bearer
+// tokens), with "REDACTED".
+func FilterSecrets(input string) string {
+	output := FilterToken(input)
+	secretPatterns.mu.Lock()
+	defer secretPatterns.mu.Unlock()
+	for _, p := range secretPatterns.patterns {
+		output = p.Pattern.ReplaceAllString(output, p.Replacement)
+	}
+	return output
+}
@@ -0,0 +1,66 @@
+package errors
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+)
+
+// StatusClass classifies an API error by the HTTP status code the API
+// responded with, for the most common (and self-explanatory) failures.
+type StatusClass int
+
+const (
+	// StatusClassNone is an error that isn't classified.
+	StatusClassNone StatusClass = iota
+	// StatusClassAuth is a 401 Unauthorized response (e.g. an invalid token).
+	StatusClassAuth
+	// StatusClassPermission is a 403 Forbidden response (e.g. a token without
+	// the required scope).
+	StatusClassPermission
+	// StatusClassNotFound is a 404 Not Found response (e.g. an incorrect
+	// service ID, version or resource name).
+	StatusClassNotFound
+)
+
+// statusClasses describes each StatusClass.
+var statusClasses = map[StatusClass]struct {
+	message     string
+	remediation string
+	exitCode    int
+}{
+	StatusClassAuth:       {"authentication failed", AuthRemediation, ExitCodeAuth},
+	StatusClassPermission: {"permission denied", PermissionRemediation, ExitCodePermission},
+	StatusClassNotFound:   {"not found", NotFoundRemediation, ExitCodeNotFound},
+}
+
+// ClassifyStatus returns the StatusClass of err, which is StatusClassNone
+// unless err is (or wraps) a Fastly API error with a classified status code.
+func ClassifyStatus(err error) StatusClass {
+	var httpError *fastly.HTTPError
+	if !errors.As(err, &httpError) {
+		return StatusClassNone
+	}
+	switch httpError.StatusCode {
+	case http.StatusUnauthorized:
+		return StatusClassAuth
+	case http.StatusForbidden:
+		return StatusClassPermission
+	case http.StatusNotFound:
+		return StatusClassNotFound
+	}
+	return StatusClassNone
+}
+
+// StatusContext returns the status code of the API error err, or nil if err
+// isn't an API error.
+func StatusContext(err error) map[string]any {
+	var httpError *fastly.HTTPError
+	if !errors.As(err, &httpError) {
+		return nil
+	}
+	return map[string]any{
+		"API Status": httpError.StatusCode,
+	}
+}
@@ -0,0 +1,138 @@
+package errors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"sync"
+)
+
+const (
+	// stdinCaptureBytes is the number of bytes retained from the start (and
+	// the end) of the input for the summary.
+	stdinCaptureBytes = 4096
+	// stdinSummaryLines is the number of lines from the start (and the end) of
+	// the input included in the summary.
+	stdinSummaryLines = 3
+	// stdinSummaryLineWidth is the number of characters of a line included in
+	// the summary before it's truncated.
+	stdinSummaryLineWidth = 200
+)
+
+// Stdin is the capture of the input the command read from stdin (see
+// --log-stdin). Its summary is written into the header of each persisted error
+// log record (if set).
+//
+// NOTE: It's assigned by the app package, which passes the capture to the
+// command in place of stdin.
+var Stdin *StdinCapture
+
+// StdinCapture is an io.Reader that records a summary of the data read from
+// the wrapped reader: its size, SHA-256 hash, and the first and last lines.
+//
+// Only the start and end of the data are retained, so large input doesn't
+// increase memory usage.
+type StdinCapture struct {
+	// OmitLines excludes the hash and the first and last lines from the
+	// summary, leaving only the size (e.g. when the input can contain a
+	// secret, whose hash could be used to confirm a guess).
+	OmitLines bool
+
+	r io.Reader
+
+	mu   sync.Mutex
+	hash hash.Hash
+	head []byte
+	tail []byte
+	size int64
+}
+
+// NewStdinCapture returns a StdinCapture wrapping r.
+func NewStdinCapture(r io.Reader) *StdinCapture {
+	return &StdinCapture{r: r, hash: sha256.New()}
+}
+
+// Read implements the io.Reader interface.
+func (c *StdinCapture) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if n > 0 {
+		c.record(p[:n])
+	}
+	return n, err
+}
+
+func (c *StdinCapture) record(b []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.size += int64(len(b))
+	_, _ = c.hash.Write(b)
+	if room := stdinCaptureBytes - len(c.head); room > 0 {
+		c.head = append(c.head, b[:min(room, len(b))]...)
+	}
+	c.tail = append(c.tail, b...)
+	if len(c.tail) > stdinCaptureBytes {
+		c.tail = c.tail[len(c.tail)-stdinCaptureBytes:]
+	}
+}
+
+// Summary describes the data read so far (see OmitLines), e.g.
+//
+//	Size: 1024 bytes
+//	SHA-256: 9f86d08...
+//	First lines: ...
+//
+// The values of sensitive fields (e.g. a token) are redacted from the lines.
+// An empty string is returned if nothing was read.
+func (c *StdinCapture) Summary() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.size == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Size: %d bytes\n", c.size)
+	if c.OmitLines {
+		return strings.TrimSuffix(b.String(), "\n")
+	}
+	fmt.Fprintf(&b, "SHA-256: %s\n", hex.EncodeToString(c.hash.Sum(nil)))
+
+	// NOTE: A line cut off by the retained bytes is dropped.
+	truncated := int64(len(c.head)) < c.size
+	head := summaryLines(string(c.head))
+	if truncated && len(head) > 1 {
+		head = head[:len(head)-1]
+	}
+	b.WriteString("First lines:\n")
+	writeSummaryLines(&b, head[:min(len(head), stdinSummaryLines)])
+
+	if truncated || len(head) > stdinSummaryLines {
+		tail := summaryLines(string(c.tail))
+		if truncated && len(tail) > 1 {
+			tail = tail[1:]
+		}
+		b.WriteString("Last lines:\n")
+		writeSummaryLines(&b, tail[max(0, len(tail)-stdinSummaryLines):])
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// summaryLines splits s into lines, ignoring a trailing line break.
+func summaryLines(s string) []string {
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// writeSummaryLines writes the redacted lines, truncating long lines.
+func writeSummaryLines(b *strings.Builder, lines []string) {
+	for _, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		line = FilterSecrets(line)
+		if r := []rune(line); len(r) > stdinSummaryLineWidth {
+			line = string(r[:stdinSummaryLineWidth-1]) + "…"
+		}
+		fmt.Fprintf(b, "  %s\n", line)
+	}
+}
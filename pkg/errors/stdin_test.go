@@ -0,0 +1,111 @@
+package errors_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestStdinCapture(t *testing.T) {
+	scenarios := []struct {
+		name            string
+		input           string
+		wantSummary     string
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name: "nothing read",
+		},
+		{
+			name:  "short input",
+			input: "line 1\nline 2\n",
+			wantSummary: "Size: 14 bytes\n" +
+				"SHA-256: 9060554863a62b9db5f726216876654e561896071d2e6480f2048b70e0fdadb9\n" +
+				"First lines:\n  line 1\n  line 2",
+		},
+		{
+			name:         "long input",
+			input:        strings.Repeat("row\n", 5000) + "last\n",
+			wantContains: []string{"Size: 20005 bytes\n", "First lines:\n  row\n  row\n  row\nLast lines:\n  row\n  row\n  last"},
+		},
+		{
+			name:            "sensitive values",
+			input:           `{"name": "example", "token": "abc123"}` + "\npassword=hunter2\n",
+			wantContains:    []string{`{"name": "example", "token": "REDACTED"}`, "password=REDACTED"},
+			wantNotContains: []string{"abc123", "hunter2"},
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			c := errors.NewStdinCapture(strings.NewReader(testcase.input))
+			data, err := io.ReadAll(c)
+			testutil.AssertNoError(t, err)
+			testutil.AssertString(t, testcase.input, string(data))
+
+			summary := c.Summary()
+			if testcase.wantContains == nil {
+				testutil.AssertString(t, testcase.wantSummary, summary)
+			}
+			for _, want := range testcase.wantContains {
+				testutil.AssertStringContains(t, summary, want)
+			}
+			for _, notWant := range testcase.wantNotContains {
+				testutil.AssertStringDoesntContain(t, summary, notWant)
+			}
+		})
+	}
+}
+
+func TestStdinCaptureOmitLines(t *testing.T) {
+	c := errors.NewStdinCapture(strings.NewReader("s3cr3t-value\n"))
+	c.OmitLines = true
+	_, err := io.ReadAll(c)
+	testutil.AssertNoError(t, err)
+
+	summary := c.Summary()
+	testutil.AssertString(t, "Size: 13 bytes", summary)
+	testutil.AssertStringDoesntContain(t, summary, "s3cr3t-value")
+	testutil.AssertStringDoesntContain(t, summary, "SHA-256")
+	testutil.AssertStringDoesntContain(t, summary, "First lines")
+}
+
+func TestLogPersistStdin(t *testing.T) {
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: string(""), Dst: "errors.log"},
+		},
+	})
+	path := filepath.Join(rootdir, "errors.log")
+	defer os.RemoveAll(rootdir)
+
+	errors.Stdin = errors.NewStdinCapture(strings.NewReader("hello\n"))
+	defer func() {
+		errors.Stdin = nil
+	}()
+	if _, err := io.ReadAll(errors.Stdin); err != nil {
+		t.Fatal(err)
+	}
+
+	le := new(errors.LogEntries)
+	le.Add(fmt.Errorf("foo"))
+
+	err := le.Persist(path, []string{"command"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	have, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertStringContains(t, string(have), "STDIN:\nSize: 6 bytes\nSHA-256: 5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03\nFirst lines:\n  hello\n\n")
+}
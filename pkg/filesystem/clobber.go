@@ -0,0 +1,79 @@
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+)
+
+// NoClobber prevents commands from overwriting an existing output file.
+//
+// NOTE: It's assigned by the app package once the flags are parsed (see
+// --no-clobber) and is honoured via CheckClobber and CreateFile.
+var NoClobber bool
+
+// CheckClobber returns an error if NoClobber is set and the output file at
+// path already exists. Commands should call it before doing any work, so
+// nothing is changed if the file would have been overwritten.
+func CheckClobber(path string) error {
+	if !NoClobber {
+		return nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return clobberError(path)
+}
+
+// CreateFile opens the output file at path for writing, creating it with perm
+// or truncating it if it exists.
+//
+// If NoClobber is set the file is created exclusively (O_EXCL), so a file that
+// was created since CheckClobber was called still isn't overwritten.
+func CreateFile(path string, perm fs.FileMode) (*os.File, error) {
+	flag := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if NoClobber {
+		flag = os.O_CREATE | os.O_WRONLY | os.O_EXCL
+	}
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as the path is provided by the user.
+	/* #nosec */
+	f, err := os.OpenFile(path, flag, perm)
+	if NoClobber && errors.Is(err, fs.ErrExist) {
+		return nil, clobberError(path)
+	}
+	return f, err
+}
+
+// WriteFile writes data to the output file at path, like os.WriteFile, but
+// honouring NoClobber (see CreateFile).
+//
+// Unlike os.WriteFile, the mode of an existing file is changed to perm, so a
+// file containing secrets isn't left readable by others.
+func WriteFile(path string, data []byte, perm fs.FileMode) error {
+	f, err := CreateFile(path, perm)
+	if err != nil {
+		return err
+	}
+	// NOTE: The mode of an existing file isn't changed by OpenFile.
+	if err := f.Chmod(perm); err != nil {
+		_ = f.Close()
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func clobberError(path string) error {
+	return fsterr.RemediationError{
+		Inner:       fmt.Errorf("refusing to overwrite existing file '%s' (--no-clobber)", path),
+		Remediation: "Remove or rename the existing file, or run the command again without --no-clobber.",
+	}
+}
@@ -0,0 +1,52 @@
+package filesystem_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/fastly/cli/pkg/filesystem"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestWriteFileNoClobber(t *testing.T) {
+	defer func() { filesystem.NoClobber = false }()
+	path := filepath.Join(t.TempDir(), "output.json")
+
+	// Without --no-clobber an existing file is replaced.
+	testutil.AssertNoError(t, filesystem.WriteFile(path, []byte("first"), 0o600))
+	testutil.AssertNoError(t, filesystem.WriteFile(path, []byte("second"), 0o600))
+	data, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "second", string(data))
+
+	// With --no-clobber an existing file isn't replaced, but a new one is
+	// created.
+	filesystem.NoClobber = true
+	err = filesystem.WriteFile(path, []byte("third"), 0o600)
+	testutil.AssertErrorContains(t, err, "refusing to overwrite existing file")
+	data, err = os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "second", string(data))
+
+	created := filepath.Join(filepath.Dir(path), "created.json")
+	testutil.AssertNoError(t, filesystem.WriteFile(created, []byte("new"), 0o600))
+	data, err = os.ReadFile(created)
+	testutil.AssertNoError(t, err)
+	testutil.AssertString(t, "new", string(data))
+}
+
+func TestWriteFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes aren't supported on Windows")
+	}
+	path := filepath.Join(t.TempDir(), "export.json")
+	testutil.AssertNoError(t, os.WriteFile(path, []byte("existing"), 0o644))
+
+	// The mode of an existing file is replaced, not only its content.
+	testutil.AssertNoError(t, filesystem.WriteFile(path, []byte("secret"), 0o600))
+	fi, err := os.Stat(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, os.FileMode(0o600), fi.Mode().Perm())
+}
@@ -3,14 +3,19 @@ package global
 import (
 	"fmt"
 	"io"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/auth"
+	"github.com/fastly/cli/pkg/backoff"
 	"github.com/fastly/cli/pkg/config"
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/github"
 	"github.com/fastly/cli/pkg/lookup"
 	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/trace"
 )
 
 // DefaultAPIEndpoint is the default Fastly API endpoint.
@@ -26,6 +31,18 @@ const DefaultAccountEndpoint = "https://accounts.fastly.com"
 // interface via MockClient.
 type APIClientFactory func(token, apiEndpoint string, debugMode bool) (api.Interface, error)
 
+// ClientsFactory constructs the Fastly API clients (see Data.SetClientsFactory).
+type ClientsFactory func() (api.Interface, api.RealtimeStatsInterface, error)
+
+// lazyClients constructs the Fastly API clients at most once.
+type lazyClients struct {
+	once    sync.Once
+	factory ClientsFactory
+	api     api.Interface
+	rts     api.RealtimeStatsInterface
+	err     error
+}
+
 // Versioners represents all supported versioner types.
 type Versioners struct {
 	CLI       github.AssetVersioner
@@ -60,6 +77,13 @@ type Data struct {
 	Config config.File
 	// ConfigPath is the path to the CLI's application configuration.
 	ConfigPath string
+	// CorrelationID uniquely identifies the current CLI invocation.
+	// It's sent to the Fastly API with every request and recorded in the error log.
+	CorrelationID string
+	// Deprecations are the notices of the deprecated flags and commands used,
+	// displayed once the output mode is known (see
+	// argparser.DisplayDeprecations).
+	Deprecations []string
 	// Env is all the data that is provided by the environment.
 	Env config.Environment
 	// ErrLog provides an interface for recording errors to disk.
@@ -78,32 +102,93 @@ type Data struct {
 	Opener func(string) error
 	// Output is the output for displaying information (typically os.Stdout)
 	Output io.Writer
+	// RateLimit is the most recent rate limit reported by the Fastly API. It's
+	// used to cap the concurrency of bulk operations (see argparser.PoolSize).
+	RateLimit *api.RateLimit
+	// RetryBudget caps the time spent retrying across the invocation (see
+	// --retry-budget). It's nil (and unlimited) unless the flag is set.
+	RetryBudget *backoff.Budget
 	// RTSClient is a Fastly API client instance for the Real Time Stats endpoints.
 	RTSClient api.RealtimeStatsInterface
 	// SkipAuthPrompt is used to indicate to the `sso` command that the
 	// interactive prompt can be skipped. This is for scenarios where the command
 	// is executed directly by the user.
 	SkipAuthPrompt bool
+	// Tracer records the steps of the invocation (see --trace-file). It's nil
+	// (and records nothing) unless the flag is set.
+	Tracer *trace.Tracer
 	// Versioners contains multiple software versioning checkers.
 	// e.g. Check for latest CLI or Viceroy version.
 	Versioners Versioners
+	// Warnings are the warnings about the flag values used, displayed once the
+	// output mode is known (see argparser.DisplayWarnings).
+	Warnings []string
+
+	// clients lazily constructs APIClient and RTSClient (guarded by clientsMu).
+	clients   *lazyClients
+	clientsMu sync.Mutex
 }
 
-// Profile identifies the current profile (if any).
-func (d *Data) Profile() (string, *config.Profile, error) {
-	var (
-		profileData       *config.Profile
-		found             bool
-		name, profileName string
-	)
+// SetClientsFactory registers the function used to construct the Fastly API
+// clients on first use (see Clients). Any clients already constructed are
+// discarded.
+func (d *Data) SetClientsFactory(factory ClientsFactory) {
+	d.clientsMu.Lock()
+	defer d.clientsMu.Unlock()
+	d.clients = &lazyClients{factory: factory}
+}
+
+// Clients yields the Fastly API clients, constructing them on first use from
+// the factory registered with SetClientsFactory. It's safe for concurrent use:
+// the factory is called at most once and every caller receives the same
+// clients (or error). The APIClient and RTSClient fields are populated too,
+// and can be read directly once Clients has returned.
+//
+// If no factory is registered, the APIClient and RTSClient fields are
+// returned as-is (e.g. where a test assigns a mock client directly).
+func (d *Data) Clients() (api.Interface, api.RealtimeStatsInterface, error) {
+	d.clientsMu.Lock()
+	c := d.clients
+	if c == nil {
+		defer d.clientsMu.Unlock()
+		return d.APIClient, d.RTSClient, nil
+	}
+	d.clientsMu.Unlock()
+
+	c.once.Do(func() {
+		c.api, c.rts, c.err = c.factory()
+		if c.err != nil {
+			return
+		}
+		d.clientsMu.Lock()
+		defer d.clientsMu.Unlock()
+		d.APIClient, d.RTSClient = c.api, c.rts
+	})
+	return c.api, c.rts, c.err
+}
+
+// ProfileName yields the name of the profile requested via the --profile
+// flag, or the `profile` field in fastly.toml (reported as lookup.SourceFile).
+// Otherwise "default" is returned, meaning the default profile should be used.
+func (d *Data) ProfileName() (string, lookup.Source) {
 	switch {
 	case d.Flags.Profile != "": // --profile
-		profileName = d.Flags.Profile
+		return d.Flags.Profile, lookup.SourceFlag
 	case d.Manifest.File.Profile != "": // `profile` field in fastly.toml
-		profileName = d.Manifest.File.Profile
+		return d.Manifest.File.Profile, lookup.SourceFile
 	default:
-		profileName = "default" // fallback to locating the default profile
+		return "default", lookup.SourceDefault // fallback to locating the default profile
 	}
+}
+
+// Profile identifies the current profile (if any).
+func (d *Data) Profile() (string, *config.Profile, error) {
+	var (
+		profileData *config.Profile
+		found       bool
+		name        string
+	)
+	profileName, _ := d.ProfileName()
 	for name, profileData = range d.Config.Profiles {
 		if (profileName == "default" && profileData.Default) || name == profileName {
 			// Once we find the default profile we can update the variable to be the
@@ -130,22 +215,39 @@ func (d *Data) Profile() (string, *config.Profile, error) {
 //   - The --profile flag's associated token.
 //   - The `profile` manifest field's associated profile token.
 //   - The 'default' profile associated token (if there is one).
+//
+// NOTE: Leading and trailing whitespace (e.g. a newline pasted along with the
+// token) is trimmed, as the API would otherwise reject the token.
 func (d *Data) Token() (string, lookup.Source) {
+	token, source, _ := d.resolveToken()
+	return token, source
+}
+
+// TokenTrimmed indicates if whitespace was trimmed from the token (see Token).
+func (d *Data) TokenTrimmed() bool {
+	_, _, trimmed := d.resolveToken()
+	return trimmed
+}
+
+// resolveToken yields the (trimmed) Fastly API token, and whether it was
+// trimmed.
+func (d *Data) resolveToken() (token string, source lookup.Source, trimmed bool) {
 	// --token
-	if d.Flags.Token != "" {
-		return d.Flags.Token, lookup.SourceFlag
+	if t := strings.TrimSpace(d.Flags.Token); t != "" {
+		return t, lookup.SourceFlag, t != d.Flags.Token
 	}
 
 	// FASTLY_API_TOKEN
-	if d.Env.APIToken != "" {
-		return d.Env.APIToken, lookup.SourceEnvironment
+	if t := strings.TrimSpace(d.Env.APIToken); t != "" {
+		return t, lookup.SourceEnvironment, t != d.Env.APIToken
 	}
 
 	// --profile
 	if d.Flags.Profile != "" {
 		for k, v := range d.Config.Profiles {
 			if k == d.Flags.Profile {
-				return v.Token, lookup.SourceFile
+				t := strings.TrimSpace(v.Token)
+				return t, lookup.SourceFile, t != v.Token
 			}
 		}
 	}
@@ -154,7 +256,8 @@ func (d *Data) Token() (string, lookup.Source) {
 	if d.Manifest.File.Profile != "" {
 		for k, v := range d.Config.Profiles {
 			if k == d.Manifest.File.Profile {
-				return v.Token, lookup.SourceFile
+				t := strings.TrimSpace(v.Token)
+				return t, lookup.SourceFile, t != v.Token
 			}
 		}
 	}
@@ -162,11 +265,12 @@ func (d *Data) Token() (string, lookup.Source) {
 	// [profile] section in app config
 	for _, v := range d.Config.Profiles {
 		if v.Default {
-			return v.Token, lookup.SourceFile
+			t := strings.TrimSpace(v.Token)
+			return t, lookup.SourceFile, t != v.Token
 		}
 	}
 
-	return "", lookup.SourceUndefined
+	return "", lookup.SourceUndefined, false
 }
 
 // Verbose yields the verbose flag, which can only be set via flags.
@@ -191,6 +295,55 @@ func (d *Data) APIEndpoint() (string, lookup.Source) {
 	return DefaultAPIEndpoint, lookup.SourceDefault // this method should not fail
 }
 
+// APIVersion yields the pinned Fastly API version (an empty string means
+// unpinned), preferring the --api-version flag over the config file.
+func (d *Data) APIVersion() (string, lookup.Source) {
+	if d.Flags.APIVersion != "" {
+		return d.Flags.APIVersion, lookup.SourceFlag
+	}
+
+	if d.Config.CLI.APIVersion != "" {
+		return d.Config.CLI.APIVersion, lookup.SourceFile
+	}
+
+	return "", lookup.SourceUndefined
+}
+
+// ErrorLogRotation yields the size (in bytes) and age at which the error log is
+// rotated, and the number of rotated logs kept, preferring the
+// --error-log-max-size, --error-log-max-days and --error-log-backups flags
+// over the config file.
+//
+// A value that's set (even to zero) replaces the default: a zero size or age
+// means it's never rotated by size or age, and a zero count keeps no rotated
+// logs.
+func (d *Data) ErrorLogRotation() (size int64, age time.Duration, count int) {
+	size = fsterr.DefaultFileRotationSize
+	if v := firstSet(d.Flags.ErrorLogMaxSize, d.Config.CLI.ErrorLogMaxSize); v != nil {
+		size = *v
+	}
+
+	if v := firstSet(d.Flags.ErrorLogMaxDays, d.Config.CLI.ErrorLogMaxDays); v != nil && *v > 0 {
+		age = time.Duration(*v) * 24 * time.Hour
+	}
+
+	count = fsterr.DefaultFileRotationCount
+	if v := firstSet(d.Flags.ErrorLogBackups, d.Config.CLI.ErrorLogBackups); v != nil {
+		count = *v
+	}
+	return size, age, count
+}
+
+// firstSet returns the first of the values that's set (i.e. not nil).
+func firstSet[T any](values ...*T) *T {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
 // AccountEndpoint yields the Accounts endpoint.
 func (d *Data) AccountEndpoint() (string, lookup.Source) {
 	if d.Flags.AccountEndpoint != "" {
@@ -221,20 +374,92 @@ type Flags struct {
 	AccountEndpoint string
 	// APIEndpoint is the Fastly API address.
 	APIEndpoint string
+	// APIVersion pins the Fastly API version used for requests.
+	APIVersion string
 	// AutoYes auto-resolves Yes/No prompts by answering "Yes".
 	AutoYes bool
+	// CompressRequests gzips large API request bodies.
+	CompressRequests bool
 	// Debug enables the CLI's debug mode.
 	Debug bool
+	// EnvFile is a dotenv-style file to load FASTLY_* variables from.
+	EnvFile string
+	// ErrorLogBackups is the number of rotated error logs kept (nil if unset).
+	ErrorLogBackups *int
+	// ErrorLogJSON writes the error log as JSON lines.
+	ErrorLogJSON bool
+	// ErrorLogMaxDays is the age (in days) at which the error log is rotated
+	// (nil if unset).
+	ErrorLogMaxDays *int
+	// ErrorLogMaxSize is the size (in bytes) at which the error log is rotated
+	// (nil if unset).
+	ErrorLogMaxSize *int64
+	// Explain prints structured guidance when a command fails.
+	Explain bool
+	// FailOnWarning escalates emitted warnings to an error.
+	FailOnWarning bool
+	// Full displays long values in full, rather than truncated.
+	Full bool
+	// JSONCompact renders --json output on a single line.
+	JSONCompact bool
+	// JSONErrorsOnly suppresses the command output, reporting only a failure
+	// (as a JSON error object).
+	JSONErrorsOnly bool
+	// JSONPointer is an RFC 6901 JSON Pointer to the single value of the --json
+	// output to display.
+	JSONPointer string
+	// JSONPretty renders --json output indented.
+	JSONPretty bool
+	// Labels are user-defined key=value annotations for the invocation.
+	Labels []string
+	// LocalTime displays timestamps in the local time zone (in a terminal).
+	LocalTime bool
+	// LogStdin records a summary of the input read from stdin in the error log.
+	LogStdin bool
+	// MaskIDs replaces identifiers in the output with stable placeholders.
+	MaskIDs bool
+	// MaxValueWidth is the number of characters of a value displayed before
+	// it's truncated (zero fits values to the terminal width).
+	MaxValueWidth int
+	// NoClobber refuses to overwrite an existing output file.
+	NoClobber bool
+	// NoColor disables colored output.
+	NoColor bool
+	// NoUpdateCheck disables the background check for a newer CLI version.
+	NoUpdateCheck bool
 	// NonInteractive auto-resolves all prompts.
 	NonInteractive bool
+	// OutputFile is the path of the file the structured output of the command
+	// is written to (instead of stdout).
+	OutputFile string
+	// PrintCurl prints a (redacted) curl command equivalent to every API
+	// request to stderr.
+	PrintCurl bool
 	// Profile indicates the profile to use (consequently the 'token' used).
 	Profile string
 	// Quiet silences all output except direct command output.
 	Quiet bool
+	// QuietErrors silences notices about failing to write the error log.
+	QuietErrors bool
+	// RawResponse prints the (redacted) body of every API response to stderr.
+	RawResponse bool
+	// RedactOutput masks sensitive fields (e.g. access keys) in the output.
+	RedactOutput bool
+	// RetryBudget is the total time that can be spent retrying failed
+	// operations (zero means unlimited). Only the commands that retry failed
+	// requests (log-tail and kv-store-entry create) are affected.
+	RetryBudget time.Duration
+	// SlowThreshold is how long an API request can take before a warning is
+	// displayed.
+	SlowThreshold time.Duration
 	// SSO enables SSO authentication tokens for the current profile.
 	SSO bool
 	// Token is an override for a profile (when passed SSO is disabled).
 	Token string
+	// TraceFile is where a trace of the invocation's steps is written.
+	TraceFile string
+	// ValidateResponses warns when the API omits fields it always populates.
+	ValidateResponses bool
 	// Verbose prints additional output.
 	Verbose bool
 }
@@ -0,0 +1,196 @@
+package global_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fastly/go-fastly/v9/fastly"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/global"
+	"github.com/fastly/cli/pkg/lookup"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+// TestClientsConcurrentFirstUse validates the clients are constructed exactly
+// once when their first use is concurrent (run with -race to detect unsafe
+// access).
+func TestClientsConcurrentFirstUse(t *testing.T) {
+	var (
+		calls int32
+		d     global.Data
+	)
+	d.SetClientsFactory(func() (api.Interface, api.RealtimeStatsInterface, error) {
+		atomic.AddInt32(&calls, 1)
+		return &mock.API{}, nil, nil
+	})
+
+	const goroutines = 50
+	clients := make([]api.Interface, goroutines)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c, _, err := d.Clients()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			clients[i] = c
+		}(i)
+	}
+	wg.Wait()
+
+	testutil.AssertEqual(t, int32(1), atomic.LoadInt32(&calls))
+	for i, c := range clients {
+		if c != clients[0] {
+			t.Errorf("goroutine %d received a different client", i)
+		}
+	}
+	if d.APIClient != clients[0] {
+		t.Error("want the APIClient field to be populated")
+	}
+}
+
+func TestClientsError(t *testing.T) {
+	errTest := errors.New("fixture error")
+	var (
+		calls int32
+		d     global.Data
+	)
+	d.SetClientsFactory(func() (api.Interface, api.RealtimeStatsInterface, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, nil, errTest
+	})
+
+	for i := 0; i < 2; i++ {
+		_, _, err := d.Clients()
+		testutil.AssertErrorContains(t, err, errTest.Error())
+	}
+	testutil.AssertEqual(t, int32(1), atomic.LoadInt32(&calls))
+	if d.APIClient != nil {
+		t.Error("want the APIClient field to be unset")
+	}
+}
+
+func TestClientsWithoutFactory(t *testing.T) {
+	client := &mock.API{}
+	d := global.Data{APIClient: client}
+	c, _, err := d.Clients()
+	testutil.AssertNoError(t, err)
+	if c != client {
+		t.Error("want the APIClient field to be returned")
+	}
+}
+
+func TestTokenTrimsWhitespace(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		data        *global.Data
+		wantSource  lookup.Source
+		wantTrimmed bool
+	}{
+		{
+			name:        "flag",
+			data:        &global.Data{Flags: global.Flags{Token: " 123 \n"}},
+			wantSource:  lookup.SourceFlag,
+			wantTrimmed: true,
+		},
+		{
+			name:        "environment",
+			data:        &global.Data{Env: config.Environment{APIToken: "123\r\n"}},
+			wantSource:  lookup.SourceEnvironment,
+			wantTrimmed: true,
+		},
+		{
+			name: "profile",
+			data: &global.Data{
+				Config:   config.File{Profiles: config.Profiles{"user": {Default: true, Token: "\t123"}}},
+				Manifest: &manifest.Data{},
+			},
+			wantSource:  lookup.SourceFile,
+			wantTrimmed: true,
+		},
+		{
+			name:       "no whitespace",
+			data:       &global.Data{Flags: global.Flags{Token: "123"}},
+			wantSource: lookup.SourceFlag,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			token, source := tc.data.Token()
+			testutil.AssertString(t, "123", token)
+			testutil.AssertEqual(t, tc.wantSource, source)
+			testutil.AssertBool(t, tc.wantTrimmed, tc.data.TokenTrimmed())
+		})
+	}
+
+	// A whitespace-only flag falls back to the next source.
+	d := global.Data{Flags: global.Flags{Token: " \n"}, Env: config.Environment{APIToken: "456"}}
+	token, source := d.Token()
+	testutil.AssertString(t, "456", token)
+	testutil.AssertEqual(t, lookup.SourceEnvironment, source)
+}
+
+func TestErrorLogRotation(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		data      *global.Data
+		wantSize  int64
+		wantAge   time.Duration
+		wantCount int
+	}{
+		{
+			name:      "defaults",
+			data:      &global.Data{},
+			wantSize:  fsterr.DefaultFileRotationSize,
+			wantCount: fsterr.DefaultFileRotationCount,
+		},
+		{
+			name: "config",
+			data: &global.Data{
+				Config: config.File{CLI: config.CLI{ErrorLogBackups: fastly.ToPointer(5), ErrorLogMaxDays: fastly.ToPointer(7), ErrorLogMaxSize: fastly.ToPointer[int64](1024)}},
+			},
+			wantSize:  1024,
+			wantAge:   7 * 24 * time.Hour,
+			wantCount: 5,
+		},
+		{
+			name: "flags override config",
+			data: &global.Data{
+				Config: config.File{CLI: config.CLI{ErrorLogBackups: fastly.ToPointer(5), ErrorLogMaxDays: fastly.ToPointer(7), ErrorLogMaxSize: fastly.ToPointer[int64](1024)}},
+				Flags:  global.Flags{ErrorLogBackups: fastly.ToPointer(1), ErrorLogMaxDays: fastly.ToPointer(1), ErrorLogMaxSize: fastly.ToPointer[int64](2048)},
+			},
+			wantSize:  2048,
+			wantAge:   24 * time.Hour,
+			wantCount: 1,
+		},
+		{
+			name: "zero flags override config",
+			data: &global.Data{
+				Config: config.File{CLI: config.CLI{ErrorLogBackups: fastly.ToPointer(5), ErrorLogMaxDays: fastly.ToPointer(7), ErrorLogMaxSize: fastly.ToPointer[int64](1024)}},
+				Flags:  global.Flags{ErrorLogBackups: fastly.ToPointer(0), ErrorLogMaxDays: fastly.ToPointer(0), ErrorLogMaxSize: fastly.ToPointer[int64](0)},
+			},
+		},
+		{
+			name: "zero config overrides defaults",
+			data: &global.Data{
+				Config: config.File{CLI: config.CLI{ErrorLogBackups: fastly.ToPointer(0), ErrorLogMaxSize: fastly.ToPointer[int64](0)}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			size, age, count := tc.data.ErrorLogRotation()
+			testutil.AssertEqual(t, tc.wantSize, size)
+			testutil.AssertEqual(t, tc.wantAge, age)
+			testutil.AssertEqual(t, tc.wantCount, count)
+		})
+	}
+}
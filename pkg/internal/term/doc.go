@@ -0,0 +1,4 @@
+// Package term centralises terminal detection (TTY, width and color support)
+// so that all of the CLI's output code makes consistent decisions, and piped
+// output is reliably plain.
+package term
@@ -0,0 +1,81 @@
+package term
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/term"
+
+	"github.com/fastly/cli/pkg/sync"
+)
+
+// DefaultWidth is the width returned by Width when stdout isn't a terminal.
+const DefaultWidth = 120
+
+// NoColor disables colored output regardless of the environment.
+//
+// NOTE: It's assigned by the app package when the --no-color flag is set.
+var NoColor bool
+
+// Stdout is the file inspected by Width and ColorEnabled.
+//
+// NOTE: It's exposed so that we may mock it from our test file.
+var Stdout = os.Stdout
+
+// Unwrapper is implemented by a writer that wraps another writer (e.g. one
+// masking identifiers, see --mask-ids), so IsTerminal can inspect the wrapped
+// writer.
+type Unwrapper interface {
+	Unwrap() io.Writer
+}
+
+// IsTerminal reports whether fd is a terminal.
+//
+// The fd is typically an *os.File (e.g. os.Stdin, os.Stdout) but a
+// sync.Writer or Unwrapper wrapping an *os.File is also unwrapped. Any other
+// type (e.g. a bytes.Buffer) is never a terminal.
+func IsTerminal(fd any) bool {
+	for {
+		if s, ok := fd.(*sync.Writer); ok {
+			// STDOUT is commonly wrapped in a sync.Writer, so here
+			// we unwrap it to gain access to the underlying Writer/STDOUT.
+			fd = s.W
+			continue
+		}
+		if u, ok := fd.(Unwrapper); ok {
+			fd = u.Unwrap()
+			continue
+		}
+		break
+	}
+	if f, ok := fd.(*os.File); ok {
+		return term.IsTerminal(int(f.Fd()))
+	}
+	return false
+}
+
+// Width returns the number of columns of the terminal attached to stdout, or
+// DefaultWidth if stdout isn't a terminal.
+func Width() int {
+	if !IsTerminal(Stdout) {
+		return DefaultWidth
+	}
+	w, _, err := term.GetSize(int(Stdout.Fd()))
+	if err != nil || w <= 0 {
+		return DefaultWidth
+	}
+	return w
+}
+
+// ColorEnabled reports whether colored output should be used, i.e. color is
+// allowed (see ColorAllowed) and stdout is a terminal.
+func ColorEnabled() bool {
+	return ColorAllowed() && IsTerminal(Stdout)
+}
+
+// ColorAllowed reports whether the user permits colored output. Color is
+// disallowed by the --no-color flag, the NO_COLOR environment variable
+// (https://no-color.org) or TERM=dumb.
+func ColorAllowed() bool {
+	return !NoColor && os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb"
+}
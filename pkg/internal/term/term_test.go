@@ -0,0 +1,114 @@
+package term_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/fastly/cli/pkg/internal/term"
+	"github.com/fastly/cli/pkg/sync"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestIsTerminal(t *testing.T) {
+	var buf bytes.Buffer
+	testutil.AssertBool(t, false, term.IsTerminal(&buf))
+	testutil.AssertBool(t, false, term.IsTerminal(sync.NewWriter(&buf)))
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	testutil.AssertBool(t, false, term.IsTerminal(w))
+	testutil.AssertBool(t, false, term.IsTerminal(sync.NewWriter(w)))
+}
+
+// wrapper is a writer wrapping another writer (see term.Unwrapper).
+type wrapper struct {
+	io.Writer
+}
+
+func (w wrapper) Unwrap() io.Writer {
+	return w.Writer
+}
+
+func TestIsTerminalUnwrap(t *testing.T) {
+	// NOTE: The pseudo-terminal master is a terminal.
+	f, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skip("a pseudo-terminal isn't available")
+	}
+	defer f.Close()
+
+	testutil.AssertBool(t, true, term.IsTerminal(f))
+	testutil.AssertBool(t, true, term.IsTerminal(wrapper{f}))
+	testutil.AssertBool(t, true, term.IsTerminal(sync.NewWriter(wrapper{f})))
+	testutil.AssertBool(t, true, term.IsTerminal(wrapper{sync.NewWriter(f)}))
+
+	var buf bytes.Buffer
+	testutil.AssertBool(t, false, term.IsTerminal(wrapper{&buf}))
+}
+
+func TestWidth(t *testing.T) {
+	_, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	orig := term.Stdout
+	term.Stdout = w
+	defer func() {
+		term.Stdout = orig
+	}()
+
+	testutil.AssertEqual(t, term.DefaultWidth, term.Width())
+}
+
+func TestColorEnabled(t *testing.T) {
+	_, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	orig := term.Stdout
+	term.Stdout = w
+	defer func() {
+		term.Stdout = orig
+	}()
+
+	// Even when color is allowed, a pipe is never colored.
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "xterm-256color")
+	testutil.AssertBool(t, true, term.ColorAllowed())
+	testutil.AssertBool(t, false, term.ColorEnabled())
+}
+
+func TestColorAllowed(t *testing.T) {
+	for _, testcase := range []struct {
+		name    string
+		noColor string
+		term    string
+		flag    bool
+		want    bool
+	}{
+		{name: "allowed", term: "xterm-256color", want: true},
+		{name: "NO_COLOR", noColor: "1", term: "xterm-256color"},
+		{name: "TERM=dumb", term: "dumb"},
+		{name: "--no-color", term: "xterm-256color", flag: true},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", testcase.noColor)
+			t.Setenv("TERM", testcase.term)
+			term.NoColor = testcase.flag
+			defer func() {
+				term.NoColor = false
+			}()
+			testutil.AssertBool(t, testcase.want, term.ColorAllowed())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package manifest
 
 import (
 	"os"
+	"strings"
 
 	"github.com/fastly/cli/pkg/env"
 )
@@ -50,18 +51,30 @@ func (d *Data) Name() (string, Source) {
 }
 
 // ServiceID yields a ServiceID.
+//
+// The --service-id flag takes precedence over the FASTLY_SERVICE_ID
+// environment variable, which takes precedence over the manifest file. Empty
+// (or whitespace only) values are ignored.
 func (d *Data) ServiceID() (string, Source) {
-	if d.Flag.ServiceID != "" {
-		return d.Flag.ServiceID, SourceFlag
-	}
-
-	if sid := os.Getenv(env.ServiceID); sid != "" {
-		return sid, SourceEnv
+	for _, s := range []Source{SourceFlag, SourceEnv, SourceFile} {
+		if sid := d.ServiceIDFrom(s); sid != "" {
+			return sid, s
+		}
 	}
+	return "", SourceUndefined
+}
 
-	if d.File.ServiceID != "" {
-		return d.File.ServiceID, SourceFile
+// ServiceIDFrom yields the ServiceID defined by the given source, regardless
+// of whether a higher priority source also defines one.
+func (d *Data) ServiceIDFrom(s Source) string {
+	switch s {
+	case SourceFlag:
+		return strings.TrimSpace(d.Flag.ServiceID)
+	case SourceEnv:
+		return strings.TrimSpace(os.Getenv(env.ServiceID))
+	case SourceFile:
+		return strings.TrimSpace(d.File.ServiceID)
+	case SourceUndefined:
 	}
-
-	return "", SourceUndefined
+	return ""
 }
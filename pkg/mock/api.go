@@ -30,6 +30,7 @@ type API struct {
 	DeactivateVersionFn func(*fastly.DeactivateVersionInput) (*fastly.Version, error)
 	LockVersionFn       func(*fastly.LockVersionInput) (*fastly.Version, error)
 	LatestVersionFn     func(*fastly.LatestVersionInput) (*fastly.Version, error)
+	ValidateVersionFn   func(*fastly.ValidateVersionInput) (bool, string, error)
 
 	CreateDomainFn       func(*fastly.CreateDomainInput) (*fastly.Domain, error)
 	ListDomainsFn        func(*fastly.ListDomainsInput) ([]*fastly.Domain, error)
@@ -496,6 +497,11 @@ func (m API) LatestVersion(i *fastly.LatestVersionInput) (*fastly.Version, error
 	return m.LatestVersionFn(i)
 }
 
+// ValidateVersion implements Interface.
+func (m API) ValidateVersion(i *fastly.ValidateVersionInput) (bool, string, error) {
+	return m.ValidateVersionFn(i)
+}
+
 // CreateDomain implements Interface.
 func (m API) CreateDomain(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
 	return m.CreateDomainFn(i)
@@ -9,6 +9,8 @@ type AssetVersioner struct {
 	DownloadOK      bool
 	DownloadedFile  string
 	InstallFilePath string
+	// LatestVersionError is returned by LatestVersion when set.
+	LatestVersionError error
 }
 
 // BinaryName implements github.Versioner interface.
@@ -41,7 +43,7 @@ func (av AssetVersioner) URL() (string, error) {
 
 // LatestVersion implements github.Versioner interface.
 func (av AssetVersioner) LatestVersion() (string, error) {
-	return av.AssetVersion, nil
+	return av.AssetVersion, av.LatestVersionError
 }
 
 // RequestedVersion implements github.Versioner interface.
@@ -0,0 +1,236 @@
+package profile
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/fastly/cli/pkg/config"
+)
+
+// ExportFormat identifies a profile export file.
+const ExportFormat = "fastly-cli-profile"
+
+// ExportVersion is the version of the export file format.
+const ExportVersion = 1
+
+// The scrypt parameters used to derive the key that encrypts the credentials.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 16
+)
+
+// The bounds of the scrypt parameters accepted when decrypting, so a crafted
+// export can't make the key derivation use excessive memory or CPU (it uses
+// 128*N*R bytes of memory).
+const (
+	minScryptN = 1 << 14
+	maxScryptN = 1 << 18
+	maxScryptR = 8
+	maxScryptP = 4
+)
+
+// ErrIncorrectPassphrase is returned when the credentials of an export can't
+// be decrypted.
+var ErrIncorrectPassphrase = errors.New("the passphrase is incorrect (or the file is corrupted)")
+
+// Export is the portable (JSON) representation of a profile, written by
+// `profile export` and read by `profile import`.
+//
+// The credentials are omitted unless explicitly requested, in which case they
+// are either included as-is or encrypted with a passphrase.
+type Export struct {
+	// Format is always ExportFormat.
+	Format string `json:"format"`
+	// Version is the version of the file format.
+	Version int `json:"version"`
+	// Name is the name of the exported profile.
+	Name string `json:"name"`
+	// CustomerID is the customer ID associated with the profile.
+	CustomerID string `json:"customer_id,omitempty"`
+	// CustomerName is the customer name associated with the profile.
+	CustomerName string `json:"customer_name,omitempty"`
+	// Email is the email address associated with the token.
+	Email string `json:"email,omitempty"`
+	// Credentials are the (unencrypted) tokens of the profile.
+	Credentials *Credentials `json:"credentials,omitempty"`
+	// EncryptedCredentials are the tokens of the profile, encrypted with a
+	// passphrase.
+	EncryptedCredentials *EncryptedCredentials `json:"encrypted_credentials,omitempty"`
+}
+
+// Credentials are the tokens of a profile.
+type Credentials struct {
+	AccessToken         string `json:"access_token,omitempty"`
+	AccessTokenCreated  int64  `json:"access_token_created,omitempty"`
+	AccessTokenTTL      int    `json:"access_token_ttl,omitempty"`
+	RefreshToken        string `json:"refresh_token,omitempty"`
+	RefreshTokenCreated int64  `json:"refresh_token_created,omitempty"`
+	RefreshTokenTTL     int    `json:"refresh_token_ttl,omitempty"`
+	Token               string `json:"token"`
+}
+
+// EncryptedCredentials are Credentials encrypted with AES-256-GCM, using a key
+// derived from a passphrase with scrypt.
+type EncryptedCredentials struct {
+	KDF        string `json:"kdf"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// NewExport returns the export of the named profile, without its credentials.
+func NewExport(name string, p *config.Profile) Export {
+	return Export{
+		Format:       ExportFormat,
+		Version:      ExportVersion,
+		Name:         name,
+		CustomerID:   p.CustomerID,
+		CustomerName: p.CustomerName,
+		Email:        p.Email,
+	}
+}
+
+// IncludeCredentials adds the credentials of p to the export, encrypted with
+// the passphrase unless it's empty.
+func (e *Export) IncludeCredentials(p *config.Profile, passphrase string) error {
+	c := Credentials{
+		AccessToken:         p.AccessToken,
+		AccessTokenCreated:  p.AccessTokenCreated,
+		AccessTokenTTL:      p.AccessTokenTTL,
+		RefreshToken:        p.RefreshToken,
+		RefreshTokenCreated: p.RefreshTokenCreated,
+		RefreshTokenTTL:     p.RefreshTokenTTL,
+		Token:               p.Token,
+	}
+	if passphrase == "" {
+		e.Credentials = &c
+		return nil
+	}
+	ec, err := encryptCredentials(c, passphrase)
+	if err != nil {
+		return err
+	}
+	e.EncryptedCredentials = ec
+	return nil
+}
+
+// Profile returns the profile represented by the export. The passphrase is
+// required if the credentials are encrypted.
+func (e Export) Profile(passphrase string) (*config.Profile, error) {
+	if e.Format != ExportFormat {
+		return nil, fmt.Errorf("not a profile export (unexpected format '%s')", e.Format)
+	}
+	if e.Version != ExportVersion {
+		return nil, fmt.Errorf("unsupported profile export version %d (expected %d)", e.Version, ExportVersion)
+	}
+	p := &config.Profile{
+		CustomerID:   e.CustomerID,
+		CustomerName: e.CustomerName,
+		Email:        e.Email,
+	}
+	c := e.Credentials
+	if e.EncryptedCredentials != nil {
+		var err error
+		if c, err = decryptCredentials(*e.EncryptedCredentials, passphrase); err != nil {
+			return nil, err
+		}
+	}
+	if c != nil {
+		p.AccessToken = c.AccessToken
+		p.AccessTokenCreated = c.AccessTokenCreated
+		p.AccessTokenTTL = c.AccessTokenTTL
+		p.RefreshToken = c.RefreshToken
+		p.RefreshTokenCreated = c.RefreshTokenCreated
+		p.RefreshTokenTTL = c.RefreshTokenTTL
+		p.Token = c.Token
+	}
+	return p, nil
+}
+
+func encryptCredentials(c Credentials, passphrase string) (*EncryptedCredentials, error) {
+	plaintext, err := json.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding credentials: %w", err)
+	}
+	ec := &EncryptedCredentials{
+		KDF:  "scrypt",
+		N:    scryptN,
+		R:    scryptR,
+		P:    scryptP,
+		Salt: make([]byte, saltLen),
+	}
+	if _, err := rand.Read(ec.Salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+	gcm, err := newGCM(*ec, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	ec.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(ec.Nonce); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+	ec.Ciphertext = gcm.Seal(nil, ec.Nonce, plaintext, nil)
+	return ec, nil
+}
+
+func decryptCredentials(ec EncryptedCredentials, passphrase string) (*Credentials, error) {
+	if ec.KDF != "scrypt" {
+		return nil, fmt.Errorf("unsupported key derivation function '%s'", ec.KDF)
+	}
+	if err := validateScryptParams(ec); err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(ec, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if len(ec.Nonce) != gcm.NonceSize() {
+		return nil, ErrIncorrectPassphrase
+	}
+	plaintext, err := gcm.Open(nil, ec.Nonce, ec.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrIncorrectPassphrase
+	}
+	var c Credentials
+	if err := json.Unmarshal(plaintext, &c); err != nil {
+		return nil, fmt.Errorf("error decoding credentials: %w", err)
+	}
+	return &c, nil
+}
+
+// validateScryptParams returns an error if the scrypt parameters of ec are
+// outside the accepted bounds.
+func validateScryptParams(ec EncryptedCredentials) error {
+	validN := ec.N >= minScryptN && ec.N <= maxScryptN && ec.N&(ec.N-1) == 0
+	if !validN || ec.R < 1 || ec.R > maxScryptR || ec.P < 1 || ec.P > maxScryptP {
+		return fmt.Errorf("unsupported scrypt parameters (n=%d, r=%d, p=%d)", ec.N, ec.R, ec.P)
+	}
+	return nil
+}
+
+// newGCM returns the cipher keyed by the passphrase (using the scrypt
+// parameters of ec).
+func newGCM(ec EncryptedCredentials, passphrase string) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), ec.Salt, ec.N, ec.R, ec.P, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
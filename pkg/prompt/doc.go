@@ -0,0 +1,4 @@
+// Package prompt contains interactive confirmations that read from the input
+// passed to a command's Exec. Unlike text.AskYesNo, a confirmation has a
+// default answer and an invalid answer is asked again.
+package prompt
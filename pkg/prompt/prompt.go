@@ -0,0 +1,83 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/internal/term"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Confirm asks a yes/no question, returning def if the answer is empty or the
+// input has ended. Any answer other than yes or no (or y/n) is asked again.
+func Confirm(w io.Writer, r io.Reader, question string, def bool) (bool, error) {
+	hint := "[y/N]"
+	if def {
+		hint = "[Y/n]"
+	}
+	for {
+		answer, err := ask(w, r, fmt.Sprintf("%s %s: ", question, hint))
+		if errors.Is(err, io.EOF) {
+			return def, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		switch strings.ToLower(answer) {
+		case "":
+			return def, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(w, "Please answer yes or no.")
+	}
+}
+
+// ask displays the prompt and reads the answer.
+//
+// NOTE: When r isn't a terminal (e.g. scripted input) the answer isn't echoed,
+// so a line break is written to keep the output readable.
+func ask(w io.Writer, r io.Reader, p string) (string, error) {
+	fmt.Fprint(w, text.Bold(p))
+	answer, err := readLine(r)
+	if !term.IsTerminal(r) {
+		fmt.Fprintln(w)
+	}
+	return answer, err
+}
+
+// readLine reads a single line from r, trimming whitespace. io.EOF is only
+// returned if r ended before anything was read.
+//
+// NOTE: The line is read a byte at a time (rather than via a buffered reader)
+// so that nothing beyond it is consumed, and so subsequent prompts reading
+// from r receive the following lines.
+func readLine(r io.Reader) (string, error) {
+	var (
+		b    [1]byte
+		line []byte
+	)
+	for {
+		n, err := r.Read(b[:])
+		if n > 0 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+		if errors.Is(err, io.EOF) {
+			if len(line) == 0 {
+				return "", io.EOF
+			}
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("error reading input: %w", err)
+		}
+	}
+	return strings.TrimSpace(string(line)), nil
+}
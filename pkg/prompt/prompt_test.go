@@ -0,0 +1,51 @@
+package prompt_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/prompt"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestConfirm(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		input      string
+		def        bool
+		want       bool
+		wantOutput string
+	}{
+		{name: "yes", input: "y\n", want: true, wantOutput: "Continue? [y/N]: \n"},
+		{name: "no", input: "No\n", def: true, want: false, wantOutput: "Continue? [Y/n]: \n"},
+		{name: "empty uses default", input: "\n", def: true, want: true},
+		{name: "end of input uses default", input: "", want: false},
+		{name: "asked again", input: "maybe\nyes\n", want: true, wantOutput: "Continue? [y/N]: \nPlease answer yes or no.\nContinue? [y/N]: \n"},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var out bytes.Buffer
+			got, err := prompt.Confirm(&out, strings.NewReader(testcase.input), "Continue?", testcase.def)
+			testutil.AssertNoError(t, err)
+			testutil.AssertBool(t, testcase.want, got)
+			if testcase.wantOutput != "" {
+				testutil.AssertString(t, testcase.wantOutput, out.String())
+			}
+		})
+	}
+}
+
+// TestScriptedInput validates that consecutive prompts reading from the same
+// (non-terminal) input each receive their own line.
+func TestScriptedInput(t *testing.T) {
+	in := strings.NewReader("n\ny\n")
+
+	first, err := prompt.Confirm(io.Discard, in, "Delete?", true)
+	testutil.AssertNoError(t, err)
+	second, err := prompt.Confirm(io.Discard, in, "Create?", false)
+	testutil.AssertNoError(t, err)
+
+	testutil.AssertBool(t, false, first)
+	testutil.AssertBool(t, true, second)
+}
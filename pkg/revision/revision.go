@@ -20,6 +20,10 @@ var (
 	// "unknown". Handled by goreleaser.
 	GitCommit string
 
+	// BuildDate is the RFC3339 date the binary was built, or "unknown".
+	// Handled by goreleaser.
+	BuildDate string
+
 	// GoVersion - Prefer letting the code handle this and set GoHostOS and
 	// GoHostArc instead. It can be set to the build host's `go version` output.
 	GoVersion string
@@ -48,6 +52,9 @@ func init() {
 	if GitCommit == "" {
 		GitCommit = "unknown"
 	}
+	if BuildDate == "" {
+		BuildDate = "unknown"
+	}
 	if GoHostOS == "" {
 		GoHostOS = "unknown"
 	}
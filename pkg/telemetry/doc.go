@@ -0,0 +1,2 @@
+// Package telemetry contains the opt-in anonymous command usage reporting.
+package telemetry
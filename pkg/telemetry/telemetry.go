@@ -0,0 +1,99 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/config"
+)
+
+// EndpointFromEnv returns the endpoint usage counts are sent to, i.e.
+// FASTLY_TELEMETRY_ENDPOINT.
+//
+// NOTE: There's no default endpoint, so nothing is sent unless one is set.
+func EndpointFromEnv(env config.Environment) string {
+	return env.TelemetryEndpoint
+}
+
+// Timeout is the maximum time spent sending a usage count.
+const Timeout = 2 * time.Second
+
+// Notice is displayed the first time telemetry is enabled.
+const Notice = "Anonymous usage reporting is enabled via FASTLY_TELEMETRY=1. Only the name of the command executed (e.g. 'service list') is sent, never arguments, flag values or any IDs. Run `fastly telemetry disable` to opt-out permanently."
+
+// Disabled is the master switch for all outbound telemetry. When set nothing
+// is sent, regardless of FASTLY_TELEMETRY or the config.
+//
+// NOTE: It's assigned by the app package from FASTLY_DISABLE_TELEMETRY (see
+// DisabledByEnv) before the command executes.
+var Disabled bool
+
+// DisabledByEnv indicates if all outbound telemetry is disabled via
+// FASTLY_DISABLE_TELEMETRY.
+func DisabledByEnv(env config.Environment) bool {
+	disabled, _ := strconv.ParseBool(env.DisableTelemetry)
+	return disabled
+}
+
+// Enabled indicates if usage reporting is enabled.
+//
+// Telemetry is off by default. It requires an explicit FASTLY_TELEMETRY=1 and
+// is always off once disabled via `fastly telemetry disable` or
+// FASTLY_DISABLE_TELEMETRY.
+func Enabled(env config.Environment, cfg config.CLI) bool {
+	if Disabled || DisabledByEnv(env) {
+		return false
+	}
+	return env.Telemetry == "1" && !cfg.TelemetryDisabled
+}
+
+// Payload is the data sent for each command execution.
+//
+// IMPORTANT: Only add fields that can't identify a user, a service or the
+// arguments provided to a command.
+type Payload struct {
+	// Command is the full command name (e.g. "service list").
+	Command string `json:"command"`
+}
+
+// Send reports the execution of the named command to endpoint.
+//
+// The request is made in the background (for at most Timeout), so neither the
+// command nor the CLI's exit waits for it. It's called before the command
+// executes, giving the request the command's duration to complete; if the CLI
+// exits first the count is dropped. Any error is ignored.
+func Send(client api.HTTPClient, endpoint, command string) {
+	if Disabled || client == nil || endpoint == "" || command == "" {
+		return
+	}
+	go func() {
+		_ = send(client, endpoint, command)
+	}()
+}
+
+func send(client api.HTTPClient, endpoint, command string) error {
+	body, err := json.Marshal(Payload{Command: command})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
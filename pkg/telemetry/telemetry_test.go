@@ -0,0 +1,133 @@
+package telemetry_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/telemetry"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestEnabled(t *testing.T) {
+	for _, testcase := range []struct {
+		name string
+		env  config.Environment
+		cfg  config.CLI
+		want bool
+	}{
+		{name: "off by default"},
+		{name: "opt-in", env: config.Environment{Telemetry: "1"}, want: true},
+		{name: "other values don't opt-in", env: config.Environment{Telemetry: "true"}},
+		{name: "disabled via config", env: config.Environment{Telemetry: "1"}, cfg: config.CLI{TelemetryDisabled: true}},
+		{name: "disabled via environment", env: config.Environment{Telemetry: "1", DisableTelemetry: "true"}},
+		{name: "invalid disable value is ignored", env: config.Environment{Telemetry: "1", DisableTelemetry: "nope"}, want: true},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			testutil.AssertBool(t, testcase.want, telemetry.Enabled(testcase.env, testcase.cfg))
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	const endpoint = "http://127.0.0.1/telemetry"
+	client := &recordingClient{requests: make(chan *http.Request, 1)}
+	telemetry.Send(client, endpoint, "service list")
+
+	// The request is sent in the background.
+	select {
+	case req := <-client.requests:
+		testutil.AssertString(t, endpoint, req.URL.String())
+
+		deadline, ok := req.Context().Deadline()
+		testutil.AssertBool(t, true, ok)
+		testutil.AssertBool(t, true, time.Until(deadline) <= telemetry.Timeout)
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatal(err)
+		}
+		testutil.AssertEqual(t, map[string]any{"command": "service list"}, payload)
+	case <-time.After(time.Second):
+		t.Fatal("want a telemetry request, have none")
+	}
+}
+
+// TestSendDoesntBlock validates Send returns without waiting for the request.
+func TestSendDoesntBlock(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	client := &blockingClient{release: release}
+
+	start := time.Now()
+	telemetry.Send(client, "http://127.0.0.1/telemetry", "service list")
+	if have := time.Since(start); have >= telemetry.Timeout {
+		t.Fatalf("want Send to return immediately, have waited %s", have)
+	}
+}
+
+func TestSendWithoutEndpoint(t *testing.T) {
+	client := &recordingClient{requests: make(chan *http.Request, 1)}
+	telemetry.Send(client, "", "service list")
+
+	select {
+	case req := <-client.requests:
+		t.Fatalf("want no telemetry request, have %s", req.URL)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestEndpointFromEnv(t *testing.T) {
+	testutil.AssertString(t, "", telemetry.EndpointFromEnv(config.Environment{}))
+	testutil.AssertString(t, "http://127.0.0.1/telemetry", telemetry.EndpointFromEnv(config.Environment{TelemetryEndpoint: "http://127.0.0.1/telemetry"}))
+}
+
+func TestSendDisabled(t *testing.T) {
+	telemetry.Disabled = true
+	defer func() {
+		telemetry.Disabled = false
+	}()
+	testutil.AssertBool(t, false, telemetry.Enabled(config.Environment{Telemetry: "1"}, config.CLI{}))
+
+	client := &recordingClient{requests: make(chan *http.Request, 1)}
+	telemetry.Send(client, "http://127.0.0.1/telemetry", "service list")
+
+	select {
+	case req := <-client.requests:
+		t.Fatalf("want no telemetry request, have %s", req.URL)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// blockingClient doesn't respond until release is closed.
+type blockingClient struct {
+	release chan struct{}
+}
+
+func (c *blockingClient) Do(req *http.Request) (*http.Response, error) {
+	select {
+	case <-c.release:
+		return nil, errors.New("released")
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+// recordingClient passes each request it receives to the requests channel.
+type recordingClient struct {
+	requests chan *http.Request
+}
+
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+	c.requests <- req
+	return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
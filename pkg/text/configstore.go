@@ -8,14 +8,12 @@ import (
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/segmentio/textio"
-
-	fsttime "github.com/fastly/cli/pkg/time"
 )
 
 // PrintConfigStoresTbl displays store data in a table format.
 func PrintConfigStoresTbl(out io.Writer, stores []*fastly.ConfigStore) {
 	tbl := NewTable(out)
-	tbl.AddHeader("Name", "ID", "Created (UTC)", "Updated (UTC)")
+	tbl.AddHeader("Name", "ID", "Created", "Updated")
 
 	if stores == nil {
 		tbl.Print()
@@ -25,7 +23,7 @@ func PrintConfigStoresTbl(out io.Writer, stores []*fastly.ConfigStore) {
 	for _, cs := range stores {
 		// avoid gosec loop aliasing check :/
 		cs := cs
-		tbl.AddLine(cs.Name, cs.StoreID, fmtConfigStoreTime(cs.CreatedAt), fmtConfigStoreTime(cs.UpdatedAt))
+		tbl.AddLine(cs.Name, cs.StoreID, fmtConfigStoreTime(out, cs.CreatedAt), fmtConfigStoreTime(out, cs.UpdatedAt))
 	}
 	tbl.Print()
 }
@@ -36,8 +34,8 @@ func PrintConfigStore(out io.Writer, cs *fastly.ConfigStore, csm *fastly.ConfigS
 
 	fmt.Fprintf(out, "Name: %s\n", cs.Name)
 	fmt.Fprintf(out, "ID: %s\n", cs.StoreID)
-	fmt.Fprintf(out, "Created (UTC): %s\n", fmtConfigStoreTime(cs.CreatedAt))
-	fmt.Fprintf(out, "Updated (UTC): %s\n", fmtConfigStoreTime(cs.UpdatedAt))
+	fmt.Fprintf(out, "Created: %s\n", fmtConfigStoreTime(out, cs.CreatedAt))
+	fmt.Fprintf(out, "Updated: %s\n", fmtConfigStoreTime(out, cs.UpdatedAt))
 	if csm != nil {
 		fmt.Fprintf(out, "Item Count: %d\n", csm.ItemCount)
 	}
@@ -57,17 +55,17 @@ func PrintConfigStoreServicesTbl(out io.Writer, s []*fastly.Service) {
 	tw.Print()
 }
 
-func fmtConfigStoreTime(t *time.Time) string {
+func fmtConfigStoreTime(out io.Writer, t *time.Time) string {
 	if t == nil {
 		return "n/a"
 	}
-	return t.UTC().Format(fsttime.Format)
+	return FormatTime(out, *t)
 }
 
 // PrintConfigStoreItemsTbl displays store item data in a table format.
 func PrintConfigStoreItemsTbl(out io.Writer, items []*fastly.ConfigStoreItem) {
 	tbl := NewTable(out)
-	tbl.AddHeader("Key", "Value", "Created (UTC)", "Updated (UTC)")
+	tbl.AddHeader("Key", "Value", "Created", "Updated")
 
 	if items == nil {
 		tbl.Print()
@@ -92,7 +90,7 @@ func PrintConfigStoreItemsTbl(out io.Writer, items []*fastly.ConfigStoreItem) {
 			value += " (truncated)"
 		}
 
-		tbl.AddLine(csi.Key, value, fmtConfigStoreTime(csi.CreatedAt), fmtConfigStoreTime(csi.UpdatedAt))
+		tbl.AddLine(csi.Key, value, fmtConfigStoreTime(out, csi.CreatedAt), fmtConfigStoreTime(out, csi.UpdatedAt))
 	}
 	tbl.Print()
 }
@@ -104,9 +102,9 @@ func PrintConfigStoreItem(out io.Writer, prefix string, csi *fastly.ConfigStoreI
 	fmt.Fprintf(out, "StoreID: %s\n", csi.StoreID)
 	fmt.Fprintf(out, "Key: %s\n", csi.Key)
 	fmt.Fprintf(out, "Value: %s\n", csi.Value)
-	fmt.Fprintf(out, "Created (UTC): %s\n", fmtConfigStoreTime(csi.CreatedAt))
-	fmt.Fprintf(out, "Updated (UTC): %s\n", fmtConfigStoreTime(csi.UpdatedAt))
+	fmt.Fprintf(out, "Created: %s\n", fmtConfigStoreTime(out, csi.CreatedAt))
+	fmt.Fprintf(out, "Updated: %s\n", fmtConfigStoreTime(out, csi.UpdatedAt))
 	if csi.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", fmtConfigStoreTime(csi.DeletedAt))
+		fmt.Fprintf(out, "Deleted: %s\n", fmtConfigStoreTime(out, csi.DeletedAt))
 	}
 }
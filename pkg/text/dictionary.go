@@ -6,8 +6,6 @@ import (
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/segmentio/textio"
-
-	"github.com/fastly/cli/pkg/time"
 )
 
 // PrintDictionary pretty prints a fastly.Dictionary structure in verbose
@@ -19,9 +17,9 @@ func PrintDictionary(out io.Writer, prefix string, d *fastly.Dictionary) {
 	fmt.Fprintf(out, "ID: %s\n", fastly.ToValue(d.DictionaryID))
 	fmt.Fprintf(out, "Name: %s\n", fastly.ToValue(d.Name))
 	fmt.Fprintf(out, "Write Only: %t\n", fastly.ToValue(d.WriteOnly))
-	fmt.Fprintf(out, "Created (UTC): %s\n", d.CreatedAt.UTC().Format(time.Format))
-	fmt.Fprintf(out, "Last edited (UTC): %s\n", d.UpdatedAt.UTC().Format(time.Format))
+	fmt.Fprintf(out, "Created: %s\n", FormatTime(out, *d.CreatedAt))
+	fmt.Fprintf(out, "Last edited: %s\n", FormatTime(out, *d.UpdatedAt))
 	if d.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", d.DeletedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Deleted: %s\n", FormatTime(out, *d.DeletedAt))
 	}
 }
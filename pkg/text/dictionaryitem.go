@@ -6,8 +6,6 @@ import (
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/segmentio/textio"
-
-	"github.com/fastly/cli/pkg/time"
 )
 
 // PrintDictionaryItem pretty prints a fastly.DictionaryInfo structure in verbose
@@ -20,13 +18,13 @@ func PrintDictionaryItem(out io.Writer, prefix string, d *fastly.DictionaryItem)
 	fmt.Fprintf(out, "Item Key: %s\n", fastly.ToValue(d.ItemKey))
 	fmt.Fprintf(out, "Item Value: %s\n", fastly.ToValue(d.ItemValue))
 	if d.CreatedAt != nil {
-		fmt.Fprintf(out, "Created (UTC): %s\n", d.CreatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Created: %s\n", FormatTime(out, *d.CreatedAt))
 	}
 	if d.UpdatedAt != nil {
-		fmt.Fprintf(out, "Last edited (UTC): %s\n", d.UpdatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Last edited: %s\n", FormatTime(out, *d.UpdatedAt))
 	}
 	if d.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", d.DeletedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Deleted: %s\n", FormatTime(out, *d.DeletedAt))
 	}
 }
 
@@ -6,8 +6,6 @@ import (
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/segmentio/textio"
-
-	"github.com/fastly/cli/pkg/time"
 )
 
 // PrintKVStore pretty prints a fastly.Dictionary structure in verbose
@@ -18,8 +16,8 @@ func PrintKVStore(out io.Writer, prefix string, k *fastly.KVStore) {
 
 	fmt.Fprintf(out, "\nID: %s\n", k.StoreID)
 	fmt.Fprintf(out, "Name: %s\n", k.Name)
-	fmt.Fprintf(out, "Created (UTC): %s\n", k.CreatedAt.UTC().Format(time.Format))
-	fmt.Fprintf(out, "Last edited (UTC): %s\n", k.UpdatedAt.UTC().Format(time.Format))
+	fmt.Fprintf(out, "Created: %s\n", FormatTime(out, *k.CreatedAt))
+	fmt.Fprintf(out, "Last edited: %s\n", FormatTime(out, *k.UpdatedAt))
 }
 
 // PrintKVStoreKeys pretty prints a list of kv store keys in verbose
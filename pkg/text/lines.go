@@ -4,6 +4,12 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fastly/cli/pkg/internal/term"
 )
 
 // Lines is the struct that is used by PrintLines.
@@ -12,13 +18,161 @@ type Lines map[string]any
 // PrintLines pretty prints a Lines struct with one item per line.
 // The map is sorted before printing and a newline is added at the beginning.
 func PrintLines(out io.Writer, lines Lines) {
-	keys := make([]string, 0, len(lines))
+	PrintLinesInOrder(out, nil, lines)
+}
+
+// PrintLinesInOrder is like PrintLines, but prints the items of the given
+// keys first (in that order). Any other items follow, sorted, and keys
+// without an item are ignored.
+func PrintLinesInOrder(out io.Writer, keys []string, lines Lines) {
+	ordered := make([]string, 0, len(lines))
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if _, ok := lines[k]; ok && !seen[k] {
+			ordered = append(ordered, k)
+			seen[k] = true
+		}
+	}
+	rest := make([]string, 0, len(lines)-len(ordered))
 	for k := range lines {
-		keys = append(keys, k)
+		if !seen[k] {
+			rest = append(rest, k)
+		}
 	}
-	sort.Strings(keys)
+	sort.Strings(rest)
+	ordered = append(ordered, rest...)
+
 	fmt.Fprintf(out, "\n")
-	for _, k := range keys {
-		fmt.Fprintf(out, "%s: %+v\n", k, lines[k])
+	for _, k := range ordered {
+		fmt.Fprintf(out, "%s: %+v\n", lineKey(out, k), truncateValue(out, len(k)+2, formatValue(out, lines[k])))
+	}
+}
+
+// lineKey returns the key emboldened when out is a terminal that supports
+// color, otherwise the key is returned unmodified.
+func lineKey(out io.Writer, key string) string {
+	if term.ColorEnabled() && term.IsTerminal(out) {
+		return Bold(key)
+	}
+	return key
+}
+
+// Line is a single key/value pair rendered by PrintSections.
+type Line struct {
+	Key   string
+	Value any
+}
+
+// Section is a titled group of lines rendered by PrintSections.
+type Section struct {
+	// Title is displayed above the section's lines (omit for an untitled section).
+	Title string
+	// Lines are printed in the order given.
+	Lines []Line
+}
+
+// PrintSections pretty prints a list of sections with one item per line.
+// Lines within a titled section are indented beneath the title, while lines
+// within an untitled section are printed as-is. Sections are separated by an
+// empty line and a newline is added at the beginning.
+func PrintSections(out io.Writer, sections []Section) {
+	for _, s := range sections {
+		fmt.Fprintf(out, "\n")
+		var indent string
+		if s.Title != "" {
+			fmt.Fprintf(out, "%s:\n", s.Title)
+			indent = "  "
+		}
+		for _, l := range s.Lines {
+			fmt.Fprintf(out, "%s%s: %+v\n", indent, lineKey(out, l.Key), truncateValue(out, len(indent)+len(l.Key)+2, formatValue(out, l.Value)))
+		}
+	}
+}
+
+// MaxValueWidth is the number of characters of a value displayed by
+// PrintLines and PrintSections before it's truncated with an ellipsis. When
+// zero (or less), values are truncated to fit the width of the terminal, but
+// only when the output is a terminal.
+//
+// NOTE: It's assigned by the app package (see --max-value-width).
+var MaxValueWidth int
+
+// FullValues disables the truncation of long values (see MaxValueWidth).
+//
+// NOTE: It's assigned by the app package (see --full).
+var FullValues bool
+
+// minValueWidth is the fewest characters of a value displayed when fitting it
+// to the width of a (narrow) terminal.
+const minValueWidth = 20
+
+// truncateValue returns v truncated with an ellipsis if it's wider than
+// MaxValueWidth (or the remainder of the terminal width after the prefix
+// width, i.e. the key). Each line of a multiline value (e.g. a PEM encoded
+// key) is truncated separately. v is returned as-is if it fits.
+func truncateValue(out io.Writer, prefix int, v any) any {
+	if FullValues || v == nil {
+		return v
+	}
+	width := MaxValueWidth
+	if width <= 0 {
+		if !term.IsTerminal(out) {
+			return v
+		}
+		width = max(term.Width()-prefix, minValueWidth)
+	}
+
+	lines := strings.Split(fmt.Sprintf("%+v", v), "\n")
+	var truncated bool
+	for i, l := range lines {
+		if utf8.RuneCountInString(l) > width {
+			lines[i] = string([]rune(l)[:width-1]) + "…"
+			truncated = true
+		}
+	}
+	if !truncated {
+		return v
+	}
+	return strings.Join(lines, "\n")
+}
+
+// PrintLogfmt prints the lines on a single line in logfmt style (i.e.
+// `key=value key2=value2`), which is convenient for log-based monitoring.
+//
+// Whitespace, quotes and equals signs in a key are replaced with underscores,
+// and a value containing them (or any control character) is quoted.
+func PrintLogfmt(out io.Writer, lines []Line) {
+	pairs := make([]string, 0, len(lines))
+	for _, l := range lines {
+		pairs = append(pairs, logfmtKey(l.Key)+"="+logfmtValue(out, l.Value))
+	}
+	fmt.Fprintln(out, strings.Join(pairs, " "))
+}
+
+// logfmtKey returns the key with any characters that would end it replaced.
+func logfmtKey(key string) string {
+	key = strings.Map(func(r rune) rune {
+		if r == '=' || r == '"' || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return '_'
+		}
+		return r
+	}, key)
+	if key == "" {
+		return "_"
+	}
+	return key
+}
+
+// logfmtValue returns the value formatted for logfmt, quoted if necessary.
+func logfmtValue(out io.Writer, v any) string {
+	if v == nil {
+		return ""
+	}
+	s := fmt.Sprintf("%+v", formatValue(out, v))
+	if strings.ContainsFunc(s, func(r rune) bool {
+		return r == '=' || r == '"' || r == '\\' || unicode.IsSpace(r) || unicode.IsControl(r)
+	}) {
+		return strconv.Quote(s)
 	}
+	return s
 }
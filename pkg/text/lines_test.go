@@ -3,6 +3,7 @@ package text_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/cli/pkg/text"
@@ -29,6 +30,11 @@ func TestPrintLines(t *testing.T) {
 			mapItem:    text.Lines{"b": 2, "a": 1, "c": 3},
 			wantOutput: "\na: 1\nb: 2\nc: 3\n",
 		},
+		{
+			name:       "time",
+			mapItem:    text.Lines{"created": time.Date(2024, 1, 2, 15, 4, 5, 0, time.FixedZone("EST", -5*60*60))},
+			wantOutput: "\ncreated: 2024-01-02T20:04:05Z\n",
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			var buf bytes.Buffer
@@ -37,3 +43,128 @@ func TestPrintLines(t *testing.T) {
 		})
 	}
 }
+
+func TestPrintLinesInOrder(t *testing.T) {
+	lines := text.Lines{"b": 2, "a": 1, "d": 4, "c": 3}
+
+	var buf bytes.Buffer
+	text.PrintLinesInOrder(&buf, []string{"c", "missing", "a", "c"}, lines)
+	testutil.AssertString(t, "\nc: 3\na: 1\nb: 2\nd: 4\n", buf.String())
+}
+
+func TestPrintSections(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		sections   []text.Section
+		wantOutput string
+	}{
+		{
+			name: "untitled",
+			sections: []text.Section{
+				{Lines: []text.Line{{Key: "b", Value: 2}, {Key: "a", Value: 1}}},
+			},
+			wantOutput: "\nb: 2\na: 1\n",
+		},
+		{
+			name: "titled",
+			sections: []text.Section{
+				{Lines: []text.Line{{Key: "Name", Value: "logs"}}},
+				{Title: "Authentication", Lines: []text.Line{{Key: "User", Value: "username"}}},
+			},
+			wantOutput: "\nName: logs\n\nAuthentication:\n  User: username\n",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			text.PrintSections(&buf, testcase.sections)
+			testutil.AssertString(t, testcase.wantOutput, buf.String())
+		})
+	}
+}
+
+func TestMaxValueWidth(t *testing.T) {
+	defer func() {
+		text.MaxValueWidth = 0
+		text.FullValues = false
+	}()
+	sections := []text.Section{
+		{Lines: []text.Line{{Key: "Format", Value: "%h %l %u %t"}, {Key: "Period", Value: 3600}}},
+		{Title: "Auth", Lines: []text.Line{{Key: "Key", Value: "-----BEGIN-----\nabcdefghijkl\n-----END-----"}}},
+	}
+
+	// Values aren't truncated by default when the output isn't a terminal.
+	var buf bytes.Buffer
+	text.PrintSections(&buf, sections)
+	testutil.AssertString(t, "\nFormat: %h %l %u %t\nPeriod: 3600\n\nAuth:\n  Key: -----BEGIN-----\nabcdefghijkl\n-----END-----\n", buf.String())
+
+	text.MaxValueWidth = 8
+	buf.Reset()
+	text.PrintSections(&buf, sections)
+	testutil.AssertString(t, "\nFormat: %h %l %…\nPeriod: 3600\n\nAuth:\n  Key: -----BE…\nabcdefg…\n-----EN…\n", buf.String())
+
+	buf.Reset()
+	text.PrintLines(&buf, text.Lines{"format": "%h %l %u %t"})
+	testutil.AssertString(t, "\nformat: %h %l %…\n", buf.String())
+
+	// The logfmt output is never truncated.
+	buf.Reset()
+	text.PrintLogfmt(&buf, sections[0].Lines)
+	testutil.AssertString(t, "Format=\"%h %l %u %t\" Period=3600\n", buf.String())
+
+	text.FullValues = true
+	buf.Reset()
+	text.PrintLines(&buf, text.Lines{"format": "%h %l %u %t"})
+	testutil.AssertString(t, "\nformat: %h %l %u %t\n", buf.String())
+}
+
+func TestPrintLogfmt(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		lines      []text.Line
+		wantOutput string
+	}{
+		{
+			name:       "base",
+			lines:      []text.Line{{Key: "name", Value: "logs"}, {Key: "period", Value: 3600}, {Key: "enabled", Value: true}},
+			wantOutput: "name=logs period=3600 enabled=true\n",
+		},
+		{
+			name:       "quoted values",
+			lines:      []text.Line{{Key: "format", Value: `%h "%r"`}, {Key: "path", Value: "a=b"}, {Key: "note", Value: "line\nbreak"}},
+			wantOutput: `format="%h \"%r\"" path="a=b" note="line\nbreak"` + "\n",
+		},
+		{
+			name:       "empty values",
+			lines:      []text.Line{{Key: "condition", Value: ""}, {Key: "key", Value: nil}},
+			wantOutput: "condition= key=\n",
+		},
+		{
+			name:       "keys",
+			lines:      []text.Line{{Key: "Format version", Value: 2}, {Key: "", Value: 1}},
+			wantOutput: "Format_version=2 _=1\n",
+		},
+		{
+			name:       "time",
+			lines:      []text.Line{{Key: "created", Value: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)}},
+			wantOutput: "created=2024-01-02T15:04:05Z\n",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			text.PrintLogfmt(&buf, testcase.lines)
+			testutil.AssertString(t, testcase.wantOutput, buf.String())
+		})
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 15, 4, 5, 0, time.FixedZone("EST", -5*60*60))
+
+	var buf bytes.Buffer
+	testutil.AssertString(t, "2024-01-02T20:04:05Z", text.FormatTime(&buf, ts))
+
+	// Local time is only used when the output is a terminal.
+	text.LocalTime = true
+	defer func() { text.LocalTime = false }()
+	testutil.AssertString(t, "2024-01-02T20:04:05Z", text.FormatTime(&buf, ts))
+}
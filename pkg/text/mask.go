@@ -0,0 +1,128 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Identifier kinds, used as the prefix of the placeholder an identifier is
+// replaced with by MaskIDs (e.g. SERVICE_1).
+const (
+	IDKindGeneric  = "ID"
+	IDKindCustomer = "CUSTOMER"
+	IDKindService  = "SERVICE"
+)
+
+// idRegEx matches an unregistered identifier in the format used by the Fastly
+// API (a 20 to 22 character alphanumeric string). Only a match containing both
+// a letter and a digit is masked, so ordinary words aren't mangled.
+var idRegEx = regexp.MustCompile(`\b[0-9A-Za-z]{20,22}\b`)
+
+// ids are the identifiers replaced by MaskIDs, with their placeholders.
+var ids struct {
+	mu           sync.Mutex
+	placeholders map[string]string
+	counts       map[string]int
+}
+
+// RegisterID records an identifier of the given kind to be replaced by
+// MaskIDs. The same identifier is always given the same placeholder.
+func RegisterID(kind, id string) {
+	if id == "" {
+		return
+	}
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+	placeholder(kind, id)
+}
+
+// placeholder returns the placeholder for id, allocating the next one for the
+// kind if it hasn't been seen before. ids.mu must be held.
+func placeholder(kind, id string) string {
+	if p, ok := ids.placeholders[id]; ok {
+		return p
+	}
+	if ids.placeholders == nil {
+		ids.placeholders = map[string]string{}
+		ids.counts = map[string]int{}
+	}
+	ids.counts[kind]++
+	p := fmt.Sprintf("%s_%d", kind, ids.counts[kind])
+	ids.placeholders[id] = p
+	return p
+}
+
+// MaskIDs replaces the registered identifiers (see RegisterID), and any other
+// value that looks like a Fastly identifier, in s with stable placeholders
+// (e.g. SERVICE_1) so the output can be shared without revealing them.
+func MaskIDs(s string) string {
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+
+	// NOTE: An identifier is only replaced as a whole word, so a short one
+	// (e.g. a numeric ID) doesn't mangle unrelated values containing it. The
+	// longest identifiers are matched first in case one contains another.
+	if len(ids.placeholders) > 0 {
+		registered := make([]string, 0, len(ids.placeholders))
+		for id := range ids.placeholders {
+			registered = append(registered, regexp.QuoteMeta(id))
+		}
+		sort.Slice(registered, func(i, j int) bool {
+			return len(registered[i]) > len(registered[j])
+		})
+		re := regexp.MustCompile(`\b(?:` + strings.Join(registered, "|") + `)\b`)
+		s = re.ReplaceAllStringFunc(s, func(id string) string {
+			return ids.placeholders[id]
+		})
+	}
+
+	return idRegEx.ReplaceAllStringFunc(s, func(m string) string {
+		if !strings.ContainsFunc(m, unicode.IsDigit) || !strings.ContainsFunc(m, unicode.IsLetter) {
+			return m
+		}
+		return placeholder(IDKindGeneric, m)
+	})
+}
+
+// ResetIDs forgets the registered identifiers and their placeholders.
+func ResetIDs() {
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+	ids.placeholders = nil
+	ids.counts = nil
+}
+
+// maskWriter is an io.Writer that masks identifiers (see MaskIDs).
+type maskWriter struct {
+	w io.Writer
+}
+
+// NewMaskWriter returns a writer that masks identifiers (see MaskIDs) in
+// everything written to w (see --mask-ids).
+//
+// NOTE: Each write is masked separately, so an identifier split across writes
+// isn't masked. The CLI writes whole lines (or tables), so this isn't a
+// problem in practice.
+func NewMaskWriter(w io.Writer) io.Writer {
+	return maskWriter{w: w}
+}
+
+// Unwrap returns the wrapped writer, so whether it's a terminal can still be
+// determined (see term.IsTerminal).
+func (m maskWriter) Unwrap() io.Writer {
+	return m.w
+}
+
+// Write implements io.Writer. The length of p is returned (rather than the
+// length of the masked output) as callers expect.
+func (m maskWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(m.w, MaskIDs(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,78 @@
+package text_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/fastly/cli/pkg/internal/term"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+)
+
+func TestMaskIDs(t *testing.T) {
+	text.ResetIDs()
+	defer text.ResetIDs()
+
+	text.RegisterID(text.IDKindService, "123")
+	text.RegisterID(text.IDKindService, "456")
+	text.RegisterID(text.IDKindService, "123")
+	text.RegisterID(text.IDKindCustomer, "abc")
+	text.RegisterID(text.IDKindService, "")
+
+	for _, testcase := range []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "registered",
+			input: "Service ID: 123\nOther: 456\nCustomer: abc",
+			want:  "Service ID: SERVICE_1\nOther: SERVICE_2\nCustomer: CUSTOMER_1",
+		},
+		{
+			name:  "whole words only",
+			input: "Access key: 1234, version 12",
+			want:  "Access key: 1234, version 12",
+		},
+		{
+			name:  "JSON structure is kept",
+			input: `{"ServiceID": "123", "Name": "logs"}`,
+			want:  `{"ServiceID": "SERVICE_1", "Name": "logs"}`,
+		},
+		{
+			name:  "unregistered Fastly IDs",
+			input: "SU1Z0isxPaozGVKXdv0eY and 7i6HN3TK9wS159v2gPAZ8A then SU1Z0isxPaozGVKXdv0eY",
+			want:  "ID_1 and ID_2 then ID_1",
+		},
+		{
+			name:  "words and numbers aren't IDs",
+			input: "internationalizations 12345678901234567890",
+			want:  "internationalizations 12345678901234567890",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			testutil.AssertString(t, testcase.want, text.MaskIDs(testcase.input))
+		})
+	}
+}
+
+func TestMaskWriter(t *testing.T) {
+	text.ResetIDs()
+	defer text.ResetIDs()
+
+	text.RegisterID(text.IDKindService, "123")
+
+	var buf bytes.Buffer
+	w := text.NewMaskWriter(&buf)
+	n, err := fmt.Fprintf(w, "Service ID: %s\n", "123")
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, len("Service ID: 123\n"), n)
+	testutil.AssertString(t, "Service ID: SERVICE_1\n", buf.String())
+
+	// The wrapped writer is exposed, so a terminal is still detected.
+	u, ok := w.(term.Unwrapper)
+	testutil.AssertBool(t, true, ok)
+	testutil.AssertBool(t, true, u.Unwrap() == io.Writer(&buf))
+}
@@ -6,8 +6,6 @@ import (
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/segmentio/textio"
-
-	"github.com/fastly/cli/pkg/time"
 )
 
 // PrintResource pretty prints a fastly.Resource structure in verbose
@@ -27,12 +25,12 @@ func PrintResource(out io.Writer, prefix string, r *fastly.Resource) {
 	fmt.Fprintf(out, "Resource Type: %s\n", fastly.ToValue(r.ResourceType))
 
 	if r.CreatedAt != nil {
-		fmt.Fprintf(out, "Created (UTC): %s\n", r.CreatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Created: %s\n", FormatTime(out, *r.CreatedAt))
 	}
 	if r.UpdatedAt != nil {
-		fmt.Fprintf(out, "Last edited (UTC): %s\n", r.UpdatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Last edited: %s\n", FormatTime(out, *r.UpdatedAt))
 	}
 	if r.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", r.DeletedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Deleted: %s\n", FormatTime(out, *r.DeletedAt))
 	}
 }
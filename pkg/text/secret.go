@@ -0,0 +1,83 @@
+package text
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+
+	fstterm "github.com/fastly/cli/pkg/internal/term"
+)
+
+// minSecretLength is the shortest secret that is redacted. Shorter values are
+// ignored as redacting them would mangle unrelated output.
+const minSecretLength = 4
+
+// ReadPassword and IsTerminal are exposed so that we may mock them from our
+// test file.
+var (
+	ReadPassword = term.ReadPassword
+	IsTerminal   = fstterm.IsTerminal
+)
+
+// secrets are the values entered via ReadSecret.
+var secrets struct {
+	mu     sync.Mutex
+	values []string
+}
+
+// ReadSecret prints the prompt to w and reads a single line from in, trimming
+// whitespace. When in is a terminal the input isn't echoed back (the terminal
+// is put into raw mode), otherwise (e.g. piped input) the line is read as-is.
+//
+// The secret is registered with RedactSecrets so it can be removed from any
+// output that is displayed or persisted afterwards (e.g. the error log).
+func ReadSecret(w io.Writer, prompt string, in io.Reader) (string, error) {
+	fmt.Fprint(w, Bold(prompt))
+
+	var secret string
+	if f, ok := in.(interface{ Fd() uintptr }); ok && IsTerminal(in) {
+		// #nosec G115 (CWE-190) the file descriptor will fit in an int.
+		p, err := ReadPassword(int(f.Fd()))
+		// NOTE: The newline typed by the user isn't echoed either.
+		fmt.Fprintln(w)
+		if err != nil {
+			return "", fmt.Errorf("error reading secret: %w", err)
+		}
+		secret = string(p)
+	} else {
+		line, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return "", fmt.Errorf("error reading secret: %w", err)
+		}
+		secret = line
+	}
+
+	secret = strings.TrimSpace(secret)
+	RegisterSecret(secret)
+	return secret, nil
+}
+
+// RegisterSecret records a value to be removed by RedactSecrets.
+func RegisterSecret(v string) {
+	if len(v) < minSecretLength {
+		return
+	}
+	secrets.mu.Lock()
+	defer secrets.mu.Unlock()
+	secrets.values = append(secrets.values, v)
+}
+
+// RedactSecrets replaces any registered secrets in s with "REDACTED".
+func RedactSecrets(s string) string {
+	secrets.mu.Lock()
+	defer secrets.mu.Unlock()
+	for _, v := range secrets.values {
+		s = strings.ReplaceAll(s, v, "REDACTED")
+	}
+	return s
+}
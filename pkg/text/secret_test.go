@@ -0,0 +1,65 @@
+package text_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+)
+
+func TestReadSecret(t *testing.T) {
+	t.Run("non-TTY", func(t *testing.T) {
+		var out bytes.Buffer
+		secret, err := text.ReadSecret(&out, "Secret: ", strings.NewReader("  piped-secret-value \nnext line\n"))
+		testutil.AssertNoError(t, err)
+		testutil.AssertString(t, "piped-secret-value", secret)
+		testutil.AssertString(t, "Secret: ", out.String())
+
+		// Input without a trailing newline is accepted.
+		secret, err = text.ReadSecret(&out, "Secret: ", strings.NewReader("no-newline-secret"))
+		testutil.AssertNoError(t, err)
+		testutil.AssertString(t, "no-newline-secret", secret)
+
+		_, err = text.ReadSecret(&out, "Secret: ", strings.NewReader(""))
+		testutil.AssertErrorContains(t, err, "error reading secret")
+	})
+
+	t.Run("TTY", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+		defer w.Close()
+
+		originalReadPassword, originalIsTerminal := text.ReadPassword, text.IsTerminal
+		defer func() {
+			text.ReadPassword, text.IsTerminal = originalReadPassword, originalIsTerminal
+		}()
+		var fd int
+		text.IsTerminal = func(any) bool { return true }
+		text.ReadPassword = func(f int) ([]byte, error) {
+			fd = f
+			return []byte("tty-secret-value\n"), nil
+		}
+
+		var out bytes.Buffer
+		secret, err := text.ReadSecret(&out, "Secret: ", r)
+		testutil.AssertNoError(t, err)
+		testutil.AssertString(t, "tty-secret-value", secret)
+		testutil.AssertEqual(t, int(r.Fd()), fd)
+		// The secret isn't echoed, only the newline the user typed.
+		testutil.AssertString(t, "Secret: \n", out.String())
+	})
+
+	// Secrets read are redacted from any later output.
+	testutil.AssertString(t, "token=REDACTED other=REDACTED", text.RedactSecrets("token=tty-secret-value other=piped-secret-value"))
+}
+
+func TestRegisterSecretIgnoresShortValues(t *testing.T) {
+	text.RegisterSecret("abc")
+	testutil.AssertString(t, "abc abcdef", text.RedactSecrets("abc abcdef"))
+}
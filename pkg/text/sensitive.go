@@ -0,0 +1,72 @@
+package text
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SensitiveFields are the names of the fields (e.g. of an API request or
+// response, or a flag) whose values are secrets or credentials that shouldn't
+// be displayed or persisted. A field is sensitive if its name is, or ends
+// with, one of them, in any case and with or without separators (see
+// IsSensitiveField).
+//
+// NOTE: This is the one list used for all redaction (e.g. --raw-response,
+// --print-curl, --redact-output and the error log). Add new names here.
+var SensitiveFields = []string{
+	"access_key",
+	"api_key",
+	"client_key",
+	"credentials",
+	"passphrase",
+	"password",
+	"private_key",
+	"secret",
+	"secret_key",
+	"token",
+}
+
+// IsSensitiveField reports whether the field called name holds a secret (see
+// SensitiveFields), e.g. "access_key", "AccessKey", "access-key" and
+// "tls_client_key" all do.
+//
+// NOTE: Only the end of the name is matched, so a field that refers to a
+// secret (e.g. "secret_store_id" or "token_id") isn't sensitive itself.
+func IsSensitiveField(name string) bool {
+	name = normaliseFieldName(name)
+	for _, s := range SensitiveFields {
+		if strings.HasSuffix(name, normaliseFieldName(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+func normaliseFieldName(name string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(name))
+}
+
+// sensitiveFieldPattern matches any of the SensitiveFields, with or without a
+// separator between the words of the name.
+func sensitiveFieldPattern() string {
+	names := make([]string, 0, len(SensitiveFields))
+	for _, s := range SensitiveFields {
+		names = append(names, strings.ReplaceAll(regexp.QuoteMeta(s), "_", "[_-]?"))
+	}
+	return "(?:" + strings.Join(names, "|") + ")"
+}
+
+// SensitiveValueRegexp matches the value of a sensitive field (see
+// IsSensitiveField) written as a query parameter (access_key=...), JSON field
+// ("access_key": "...") or struct field (AccessKey:...). The first submatch is
+// everything before the value, so it can be replaced with "${1}REDACTED".
+var SensitiveValueRegexp = regexp.MustCompile(`(?i)([\w-]*` + sensitiveFieldPattern() + `["']?\s*[:=]\s*["']?)[^\s"',&]+`)
+
+// SensitiveFlagRegexp matches the value of a flag whose name ends with one of
+// the SensitiveFields (e.g. --access-key abc or --access-key=abc). The first
+// two submatches are everything before the value, so it can be replaced with
+// "${1}${2}REDACTED".
+//
+// NOTE: A quoted value is matched in full, and a following flag isn't taken
+// for the value.
+var SensitiveFlagRegexp = regexp.MustCompile(`(?i)(--[\w-]*` + sensitiveFieldPattern() + `)(\s*=\s*|\s+)('[^']*'|"[^"]*"|[^\s'"-][^\s'"]*)`)
@@ -0,0 +1,54 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+)
+
+func TestIsSensitiveField(t *testing.T) {
+	for name, want := range map[string]bool{
+		"access_key":      true,
+		"AccessKey":       true,
+		"access-key":      true,
+		"tls_client_key":  true,
+		"TLSClientKey":    true,
+		"private_key":     true,
+		"password":        true,
+		"client_secret":   true,
+		"SecretKey":       true,
+		"api_token":       true,
+		"name":            false,
+		"secret_store_id": false,
+		"SecretStoreID":   false,
+		"token_id":        false,
+		"public_key":      false,
+		"bucket_name":     false,
+		"address":         false,
+	} {
+		t.Run(name, func(t *testing.T) {
+			testutil.AssertBool(t, want, text.IsSensitiveField(name))
+		})
+	}
+}
+
+func TestSensitiveRegexps(t *testing.T) {
+	testutil.AssertString(t,
+		`{"tls_client_key": "REDACTED", "name": "example"} password=REDACTED`,
+		text.SensitiveValueRegexp.ReplaceAllString(`{"tls_client_key": "abc", "name": "example"} password=def`, "${1}REDACTED"),
+	)
+	testutil.AssertString(t,
+		"--tls-client-key REDACTED --api-key=REDACTED --include-token --json",
+		text.SensitiveFlagRegexp.ReplaceAllString("--tls-client-key abc --api-key=def --include-token --json", "${1}${2}REDACTED"),
+	)
+
+	// A field that refers to a secret isn't redacted.
+	testutil.AssertString(t,
+		`{"secret_store_id": "abc", "token_id": "def"} --secret-store-id ghi`,
+		text.SensitiveFlagRegexp.ReplaceAllString(
+			text.SensitiveValueRegexp.ReplaceAllString(`{"secret_store_id": "abc", "token_id": "def"} --secret-store-id ghi`, "${1}REDACTED"),
+			"${1}${2}REDACTED",
+		),
+	)
+}
@@ -7,8 +7,6 @@ import (
 
 	"github.com/fastly/go-fastly/v9/fastly"
 	"github.com/segmentio/textio"
-
-	"github.com/fastly/cli/pkg/time"
 )
 
 // PrintService pretty prints a fastly.Service structure in verbose format
@@ -33,13 +31,13 @@ func PrintService(out io.Writer, prefix string, s *fastly.Service) {
 		fmt.Fprintf(out, "Customer ID: %s\n", fastly.ToValue(s.CustomerID))
 	}
 	if s.CreatedAt != nil {
-		fmt.Fprintf(out, "Created (UTC): %s\n", s.CreatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Created: %s\n", FormatTime(out, *s.CreatedAt))
 	}
 	if s.UpdatedAt != nil {
-		fmt.Fprintf(out, "Last edited (UTC): %s\n", s.UpdatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Last edited: %s\n", FormatTime(out, *s.UpdatedAt))
 	}
 	if s.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", s.DeletedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Deleted: %s\n", FormatTime(out, *s.DeletedAt))
 	}
 	if s.ActiveVersion != nil {
 		fmt.Fprintf(out, "Active version: %d\n", fastly.ToValue(s.ActiveVersion))
@@ -82,13 +80,13 @@ func PrintVersion(out io.Writer, indent string, v *fastly.Version) {
 		fmt.Fprintf(out, "Testing: %v\n", fastly.ToValue(v.Testing))
 	}
 	if v.CreatedAt != nil {
-		fmt.Fprintf(out, "Created (UTC): %s\n", v.CreatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Created: %s\n", FormatTime(out, *v.CreatedAt))
 	}
 	if v.UpdatedAt != nil {
-		fmt.Fprintf(out, "Last edited (UTC): %s\n", v.UpdatedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Last edited: %s\n", FormatTime(out, *v.UpdatedAt))
 	}
 	if v.DeletedAt != nil {
-		fmt.Fprintf(out, "Deleted (UTC): %s\n", v.DeletedAt.UTC().Format(time.Format))
+		fmt.Fprintf(out, "Deleted: %s\n", FormatTime(out, *v.DeletedAt))
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 	"text/tabwriter"
+	"unicode/utf8"
 )
 
 var (
@@ -15,27 +16,32 @@ var (
 // Table wraps an instance of a tabwriter and provides helper methods to easily
 // create a table, add a header, add rows and print to the writer.
 type Table struct {
+	out    io.Writer
 	writer *tabwriter.Writer
 }
 
 // NewTable constructs a new Table.
 func NewTable(w io.Writer) *Table {
 	return &Table{
+		out:    w,
 		writer: tabwriter.NewWriter(w, 0, 2, 2, ' ', 0),
 	}
 }
 
-// AddLine writes a new row to the table.
+// AddLine writes a new row to the table. Timestamps are formatted via
+// FormatTime.
 func (t *Table) AddLine(args ...any) {
 	var b strings.Builder
+	values := make([]any, len(args))
 	for i := range args {
+		values[i] = formatValue(t.out, args[i])
 		_, _ = b.WriteString(lineStyle(`%v`))
 		if i+1 != len(args) {
 			_, _ = b.WriteString("\t")
 		}
 	}
 	_, _ = b.WriteString("\n")
-	fmt.Fprintf(t.writer, b.String(), args...)
+	fmt.Fprintf(t.writer, b.String(), values...)
 }
 
 // AddHeader writes a table header line.
@@ -55,3 +61,85 @@ func (t *Table) AddHeader(args ...any) {
 func (t *Table) Print() {
 	_ = t.writer.Flush()
 }
+
+// MaxColumnWidth is the number of characters of a cell displayed by
+// PrintTable before it's truncated with an ellipsis. It's overridden by
+// MaxValueWidth (if set), and zero (or less) disables the limit.
+var MaxColumnWidth = 50
+
+// TableOptions controls how PrintTable displays a table.
+type TableOptions struct {
+	// Separator draws a line under the headers.
+	Separator bool
+}
+
+// PrintTable writes the rows as a table, with the columns aligned and the
+// headers (if any) as the first line.
+//
+// A cell wider than MaxColumnWidth is truncated with an ellipsis (unless
+// FullValues is set), as is a cell containing a line break so that each row
+// remains on a single line.
+func PrintTable(out io.Writer, headers []string, rows [][]string, opts TableOptions) {
+	width := MaxColumnWidth
+	if MaxValueWidth > 0 {
+		width = MaxValueWidth
+	}
+	if FullValues {
+		width = 0
+	}
+
+	cells := make([][]any, len(rows))
+	var widths []int
+	measure := func(i int, s string) {
+		for len(widths) <= i {
+			widths = append(widths, 0)
+		}
+		widths[i] = max(widths[i], utf8.RuneCountInString(s))
+	}
+	for i, h := range headers {
+		measure(i, h)
+	}
+	for r, row := range rows {
+		cells[r] = make([]any, len(row))
+		for i, c := range row {
+			c = truncateCell(c, width)
+			measure(i, c)
+			cells[r][i] = c
+		}
+	}
+
+	t := NewTable(out)
+	if len(headers) > 0 {
+		args := make([]any, len(headers))
+		for i, h := range headers {
+			args[i] = h
+		}
+		t.AddHeader(args...)
+		if opts.Separator {
+			separators := make([]any, len(widths))
+			for i, w := range widths {
+				separators[i] = strings.Repeat("-", w)
+			}
+			t.AddLine(separators...)
+		}
+	}
+	for _, row := range cells {
+		t.AddLine(row...)
+	}
+	t.Print()
+}
+
+// truncateCell returns the first line of s, truncated with an ellipsis if
+// it's wider than width (or there were further lines).
+func truncateCell(s string, width int) string {
+	first, _, multiline := strings.Cut(strings.TrimRight(s, "\r\n"), "\n")
+	first = strings.TrimSuffix(first, "\r")
+	r := []rune(first)
+	if width > 0 && len(r) >= width && (len(r) > width || multiline) {
+		return string(r[:width-1]) + "…"
+	}
+	if multiline {
+		return first + "…"
+	}
+	return first
+}
@@ -0,0 +1,44 @@
+package text_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+)
+
+func TestPrintTable(t *testing.T) {
+	defer func() {
+		text.MaxColumnWidth = 50
+		text.MaxValueWidth = 0
+		text.FullValues = false
+	}()
+	headers := []string{"NAME", "FORMAT"}
+	rows := [][]string{
+		{"logs", "%h %l %u %t"},
+		{"multiline", "line 1\nline 2"},
+		{"trailing-break", "value\n"},
+	}
+
+	var buf bytes.Buffer
+	text.PrintTable(&buf, headers, rows, text.TableOptions{})
+	testutil.AssertString(t, "NAME            FORMAT\nlogs            %h %l %u %t\nmultiline       line 1…\ntrailing-break  value\n", buf.String())
+
+	text.MaxColumnWidth = 6
+	buf.Reset()
+	text.PrintTable(&buf, headers, rows, text.TableOptions{})
+	testutil.AssertString(t, "NAME    FORMAT\nlogs    %h %l…\nmulti…  line …\ntrail…  value\n", buf.String())
+
+	// MaxValueWidth takes precedence, and FullValues disables the limit (but
+	// not the truncation of a multiline cell).
+	text.MaxValueWidth = 10
+	buf.Reset()
+	text.PrintTable(&buf, headers, rows[:2], text.TableOptions{})
+	testutil.AssertString(t, "NAME       FORMAT\nlogs       %h %l %u …\nmultiline  line 1…\n", buf.String())
+
+	text.FullValues = true
+	buf.Reset()
+	text.PrintTable(&buf, headers, rows[:2], text.TableOptions{Separator: true})
+	testutil.AssertString(t, "NAME       FORMAT\n---------  -----------\nlogs       %h %l %u %t\nmultiline  line 1…\n", buf.String())
+}
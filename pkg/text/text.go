@@ -9,9 +9,8 @@ import (
 	"syscall"
 
 	"github.com/mitchellh/go-wordwrap"
-	"golang.org/x/term"
 
-	"github.com/fastly/cli/pkg/sync"
+	fstterm "github.com/fastly/cli/pkg/internal/term"
 )
 
 // DefaultTextWidth is the width that should be passed to Wrap for most
@@ -130,47 +129,27 @@ func IsStdin(r io.Reader) bool {
 // Provide STDOUT as a way to determine whether formatting and/or
 // prompting is acceptable output.
 func IsTTY(fd any) bool {
-	if s, ok := fd.(*sync.Writer); ok {
-		// STDOUT is commonly wrapped in a sync.Writer, so here
-		// we unwrap it to gain access to the underlying Writer/STDOUT.
-		fd = s.W
-	}
-	if f, ok := fd.(*os.File); ok {
-		return term.IsTerminal(int(f.Fd()))
-	}
-	return false
+	return fstterm.IsTerminal(fd)
 }
 
 // InputSecure is like Input but doesn't echo input back to the terminal,
-// if and only if r is os.Stdin.
+// if and only if r is a terminal (see ReadSecret). In either case the value
+// is registered with RedactSecrets.
 func InputSecure(w io.Writer, prefix string, r io.Reader, validators ...func(string) error) (string, error) {
-	if !IsStdin(r) {
-		return Input(w, prefix, r, validators...)
-	}
-
-	read := func() (string, error) {
-		fmt.Fprint(w, Bold(prefix))
-		// IMPORTANT: Windows will fail if you remove the `int()` conversion.
-		//
-		// cannot use syscall.Stdin (variable of type syscall.Handle) as int value in argument to term.ReadPassword)
-		//
-		// This is because on *nix systems syscall.Stdin is already an int.
-		// But on Windows it's a Handle type:
-		// https://github.com/golang/go/blob/8d2eb290f83bca7d3b5154c6a7b3ac7546df5e8a/src/syscall/syscall_windows.go#L522
-		p, err := term.ReadPassword(int(syscall.Stdin))
-		if err != nil {
-			return "", err
+	if !IsTerminal(r) {
+		line, err := Input(w, prefix, r, validators...)
+		if err == nil {
+			RegisterSecret(line)
 		}
-		return string(p), nil
+		return line, err
 	}
 
 outer:
 	for {
-		line, err := read()
+		line, err := ReadSecret(w, prefix, r)
 		if err != nil {
 			return "", err
 		}
-		line = strings.TrimSpace(line)
 
 		for _, validate := range validators {
 			if err := validate(line); err != nil {
@@ -216,8 +195,11 @@ func BreakN(w io.Writer, n int) {
 }
 
 // Deprecated is a wrapper for fmt.Fprintf with a bold red "DEPRECATED: " prefix.
+//
+// The notice is also recorded in the Warnings accumulator.
 func Deprecated(w io.Writer, format string, args ...any) {
 	prefix, suffix, txt := ParseBreaks(format)
+	Warnings.Add(fmt.Sprintf(txt, args...))
 	if suffix == 0 {
 		suffix++
 	}
@@ -261,8 +243,11 @@ func Success(w io.Writer, format string, args ...any) {
 }
 
 // Warning is a wrapper for fmt.Fprintf with a bold yellow "WARNING: " prefix.
+//
+// The warning is also recorded in the Warnings accumulator.
 func Warning(w io.Writer, format string, args ...any) {
 	prefix, suffix, txt := ParseBreaks(format)
+	Warnings.Add(fmt.Sprintf(txt, args...))
 	if suffix == 0 {
 		suffix++
 	}
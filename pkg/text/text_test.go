@@ -311,3 +311,14 @@ func TestParseBreaks(t *testing.T) {
 		})
 	}
 }
+
+func TestWarningsRecorded(t *testing.T) {
+	text.Warnings.Reset()
+	defer text.Warnings.Reset()
+
+	var buf bytes.Buffer
+	text.Warning(&buf, "token expires in %d days", 3)
+	text.Warning(&buf, "\nflag --foo is deprecated\n\n")
+
+	testutil.AssertEqual(t, []string{"token expires in 3 days", "flag --foo is deprecated"}, text.Warnings.Messages())
+}
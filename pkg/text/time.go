@@ -0,0 +1,39 @@
+package text
+
+import (
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/internal/term"
+	fsttime "github.com/fastly/cli/pkg/time"
+)
+
+// LocalTime displays timestamps in the local time zone when the output is a
+// terminal.
+//
+// NOTE: It's assigned by the app package when the --local-time flag is set.
+var LocalTime bool
+
+// FormatTime formats t for display on out. Timestamps are displayed in UTC
+// (as RFC 3339) unless LocalTime is set and out is a terminal, so output that
+// is piped or captured doesn't depend on the user's time zone.
+func FormatTime(out io.Writer, t time.Time) string {
+	if LocalTime && term.IsTerminal(out) {
+		return t.Local().Format(fsttime.LocalFormat)
+	}
+	return t.UTC().Format(fsttime.Format)
+}
+
+// formatValue formats a value displayed by PrintLines and PrintSections,
+// rendering timestamps via FormatTime.
+func formatValue(out io.Writer, v any) any {
+	switch t := v.(type) {
+	case time.Time:
+		return FormatTime(out, t)
+	case *time.Time:
+		if t != nil {
+			return FormatTime(out, *t)
+		}
+	}
+	return v
+}
@@ -0,0 +1,34 @@
+package text
+
+import "sync"
+
+// WarningLog records the warnings emitted via Warning so they can be inspected
+// once a command has finished executing (e.g. to support --fail-on-warning).
+type WarningLog struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+// Add records a warning message.
+func (l *WarningLog) Add(msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, msg)
+}
+
+// Messages returns a copy of the recorded warning messages.
+func (l *WarningLog) Messages() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.messages...)
+}
+
+// Reset discards all recorded warning messages.
+func (l *WarningLog) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = nil
+}
+
+// Warnings is the accumulator for all warnings emitted via Warning.
+var Warnings = &WarningLog{}
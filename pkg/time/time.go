@@ -1,5 +1,11 @@
 package time
 
-// Format is a format string for time.Format that reflects what the Fastly web
-// UI uses.
-const Format = "2006-01-02 15:04"
+import "time"
+
+// Format is a format string for time.Format used when displaying timestamps.
+// RFC 3339 (in UTC) is unambiguous and independent of the user's locale.
+const Format = time.RFC3339
+
+// LocalFormat is a format string for time.Format used when displaying
+// timestamps in the local time zone (see --local-time).
+const LocalFormat = "2006-01-02 15:04:05 MST"
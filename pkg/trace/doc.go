@@ -0,0 +1,3 @@
+// Package trace records an ordered trace of the internal steps of a CLI
+// invocation (see --trace-file), for attaching to support tickets.
+package trace
@@ -0,0 +1,137 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/filesystem"
+)
+
+// Tracer records the steps (spans) of a CLI invocation. It's safe for
+// concurrent use.
+//
+// NOTE: A nil *Tracer is valid and records nothing, so that tracing costs
+// (next to) nothing when --trace-file isn't set.
+type Tracer struct {
+	mu    sync.Mutex
+	start time.Time
+	spans []Span
+}
+
+// Span is a single step of the trace.
+type Span struct {
+	// Name identifies the step (e.g. "api request").
+	Name string `json:"name"`
+	// Start is when the step started.
+	Start time.Time `json:"start"`
+	// Offset is when the step started relative to the start of the trace.
+	Offset Duration `json:"offset"`
+	// Duration is how long the step took.
+	Duration Duration `json:"duration"`
+	// Attributes describe the step (e.g. the request path).
+	Attributes map[string]any `json:"attributes,omitempty"`
+	// Error is the error the step failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Duration is a time.Duration that's marshalled in its string form (e.g.
+// "1.5ms") so the trace is readable.
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// File is the document written to the --trace-file.
+type File struct {
+	// Command is the command that was executed (e.g. "service list").
+	Command string `json:"command"`
+	// Start is when the trace started.
+	Start time.Time `json:"start"`
+	// Spans are the recorded steps, ordered by when they started.
+	Spans []Span `json:"spans"`
+}
+
+// New returns a Tracer whose trace starts now.
+func New() *Tracer {
+	return &Tracer{start: time.Now()}
+}
+
+// Start records the start of a step, returning the function to call with the
+// step's error (or nil) once it's complete. The step isn't recorded until then.
+func (t *Tracer) Start(name string, attrs map[string]any) func(err error) {
+	if t == nil {
+		return func(error) {}
+	}
+	start := time.Now()
+	return func(err error) {
+		t.Record(name, start, time.Since(start), attrs, err)
+	}
+}
+
+// Record records a step that has already completed.
+func (t *Tracer) Record(name string, start time.Time, elapsed time.Duration, attrs map[string]any, err error) {
+	if t == nil {
+		return
+	}
+	s := Span{
+		Name:       name,
+		Start:      start,
+		Offset:     Duration(start.Sub(t.start)),
+		Duration:   Duration(elapsed),
+		Attributes: attrs,
+	}
+	if err != nil {
+		s.Error = err.Error()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = append(t.spans, s)
+}
+
+// Spans returns a copy of the recorded steps, ordered by when they started.
+func (t *Tracer) Spans() []Span {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	spans := append([]Span(nil), t.spans...)
+	t.mu.Unlock()
+
+	// NOTE: A step is recorded once it completes, so the steps are sorted by
+	// when they started (e.g. an API request started within a step completes
+	// before it).
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].Start.Before(spans[j].Start)
+	})
+	return spans
+}
+
+// Write writes the trace of the command to path as JSON.
+//
+// The trace is redacted (see errors.FilterSecrets) as it's written, so that
+// secrets registered at any point of the invocation are removed.
+func (t *Tracer) Write(path, command string) error {
+	if t == nil {
+		return nil
+	}
+	data, err := json.MarshalIndent(File{
+		Command: command,
+		Start:   t.start,
+		Spans:   t.Spans(),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding the trace: %w", err)
+	}
+	data = []byte(fsterr.FilterSecrets(string(data)) + "\n")
+
+	if err := filesystem.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("error writing the trace file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package trace_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/trace"
+)
+
+func TestNilTracer(t *testing.T) {
+	var tr *trace.Tracer
+	tr.Start("step", nil)(nil)
+	tr.Record("step", time.Now(), time.Second, nil, nil)
+	testutil.AssertEqual(t, 0, len(tr.Spans()))
+	testutil.AssertNoError(t, tr.Write(filepath.Join(t.TempDir(), "trace.json"), "version"))
+}
+
+func TestSpansOrder(t *testing.T) {
+	tr := trace.New()
+	end := tr.Start("outer", map[string]any{"command": "service list"})
+	tr.Record("inner", time.Now(), time.Millisecond, nil, errors.New("whoops"))
+	end(nil)
+
+	spans := tr.Spans()
+	testutil.AssertEqual(t, 2, len(spans))
+	testutil.AssertString(t, "outer", spans[0].Name)
+	testutil.AssertEqual(t, map[string]any{"command": "service list"}, spans[0].Attributes)
+	testutil.AssertString(t, "", spans[0].Error)
+	testutil.AssertString(t, "inner", spans[1].Name)
+	testutil.AssertString(t, "whoops", spans[1].Error)
+	testutil.AssertBool(t, true, spans[1].Offset >= spans[0].Offset)
+}
+
+func TestWrite(t *testing.T) {
+	secret := "trace-test-secret-value"
+	text.RegisterSecret(secret)
+
+	tr := trace.New()
+	tr.Record("api request", time.Now(), 1500*time.Microsecond, map[string]any{
+		"header": "Token abc123",
+		"value":  secret,
+	}, nil)
+
+	path := filepath.Join(t.TempDir(), "trace.json")
+	testutil.AssertNoError(t, tr.Write(path, "service list"))
+
+	data, err := os.ReadFile(path)
+	testutil.AssertNoError(t, err)
+	for _, s := range []string{secret, "abc123"} {
+		if strings.Contains(string(data), s) {
+			t.Errorf("trace contains %q:\n%s", s, data)
+		}
+	}
+
+	var f struct {
+		Command string `json:"command"`
+		Spans   []struct {
+			Name     string `json:"name"`
+			Duration string `json:"duration"`
+		} `json:"spans"`
+	}
+	testutil.AssertNoError(t, json.Unmarshal(data, &f))
+	testutil.AssertString(t, "service list", f.Command)
+	testutil.AssertEqual(t, 1, len(f.Spans))
+	testutil.AssertString(t, "api request", f.Spans[0].Name)
+	testutil.AssertString(t, "1.5ms", f.Spans[0].Duration)
+}
+
+func BenchmarkNilTracer(b *testing.B) {
+	var tr *trace.Tracer
+	for range b.N {
+		tr.Start("step", nil)(nil)
+	}
+}
@@ -0,0 +1,3 @@
+// Package versionlock implements the .fastly-version lockfile, which pins the
+// service version used by the commands run within a directory.
+package versionlock
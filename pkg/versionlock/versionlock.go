@@ -0,0 +1,82 @@
+package versionlock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	toml "github.com/pelletier/go-toml"
+)
+
+// FileName is the name of the lockfile.
+const FileName = ".fastly-version"
+
+// Path is the location of the lockfile, relative to the working directory.
+//
+// NOTE: It's a package level variable so the test suite can replace it.
+var Path = FileName
+
+// header is written at the top of the lockfile.
+const header = `# This file pins the Fastly service version used by commands run in this
+# directory (unless --version is set). It's updated by 'fastly service-version
+# pin' and when the pinned service is activated via 'fastly service-version
+# activate'.
+`
+
+// Lock is the content of the lockfile.
+//
+// The pinned version is only used for the service the lockfile was written
+// for. The service itself is still resolved from the --service-id and
+// --service-name flags, the FASTLY_SERVICE_ID environment variable or the
+// fastly.toml manifest (in that order).
+type Lock struct {
+	ServiceID string `toml:"service_id"`
+	Version   int    `toml:"version"`
+}
+
+// Read loads the lockfile at path. The returned bool is false if the file
+// doesn't exist.
+func Read(path string) (Lock, bool, error) {
+	var l Lock
+
+	// G304 (CWE-22): Potential file inclusion via variable.
+	// Disabling as the lockfile is read from the user's working directory.
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return l, false, nil
+		}
+		return l, false, fmt.Errorf("error reading %s: %w", path, err)
+	}
+	if err := toml.Unmarshal(data, &l); err != nil {
+		return l, false, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if l.ServiceID == "" || l.Version < 1 {
+		return l, false, fmt.Errorf("error parsing %s: a service_id and a version (greater than zero) are required", path)
+	}
+	return l, true, nil
+}
+
+// Exists reports whether there's a lockfile at path (which may still be
+// invalid, see Read).
+func Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// Write persists the lockfile to path.
+func Write(path string, l Lock) error {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("\n")
+	if err := toml.NewEncoder(&buf).Encode(l); err != nil {
+		return fmt.Errorf("error encoding %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil { // #nosec G306
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package versionlock_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/versionlock"
+)
+
+func TestReadWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), versionlock.FileName)
+
+	_, ok, err := versionlock.Read(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertBool(t, false, ok)
+
+	want := versionlock.Lock{ServiceID: "123", Version: 3}
+	testutil.AssertNoError(t, versionlock.Write(path, want))
+
+	got, ok, err := versionlock.Read(path)
+	testutil.AssertNoError(t, err)
+	testutil.AssertBool(t, true, ok)
+	testutil.AssertEqual(t, want, got)
+}
+
+func TestReadInvalid(t *testing.T) {
+	for _, content := range []string{
+		"service_id = ",
+		`service_id = "123"`,
+		`version = 3`,
+		"service_id = \"123\"\nversion = 0",
+	} {
+		path := filepath.Join(t.TempDir(), versionlock.FileName)
+		testutil.AssertNoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		_, ok, err := versionlock.Read(path)
+		testutil.AssertErrorContains(t, err, "error parsing")
+		testutil.AssertBool(t, false, ok)
+	}
+}